@@ -3,8 +3,6 @@ package array
 import (
 	"log"
 	"math"
-
-	"github.com/ichbinfrog/statistics/pkg/dist"
 )
 
 const (
@@ -44,6 +42,16 @@ const (
 	g1_1 = .459e0
 )
 
+// normalCDF returns the cumulative distribution function of N(0, 1)
+func normalCDF(x float64) float64 {
+	return .5 + .5*math.Erf(x/math.Sqrt2)
+}
+
+// normalQuantile returns the p-th quantile of N(0, 1)
+func normalQuantile(p float64) float64 {
+	return math.Sqrt2 * math.Erfinv(2*p-1)
+}
+
 func swilkFirstPolynomial(n, u float64) float64 {
 	return p1_0*math.Pow(u, 5) + p1_1*math.Pow(u, 4) + p1_2*math.Pow(u, 3) + p1_3*math.Pow(u, 2) + p1_4*u + n
 }
@@ -65,9 +73,6 @@ func ShapiroWilkSignificance(n float64, W float64) float64 {
 	y := math.Log(1 - W)
 	xx := math.Log(n)
 
-	d := dist.Normal{}
-	d.Init(0, 1)
-
 	if n <= 11.0 {
 		gm := g1_0 + g1_1*n
 		if y >= gm {
@@ -80,7 +85,7 @@ func ShapiroWilkSignificance(n float64, W float64) float64 {
 		m = c3_0 + c3_1*xx + c3_2*math.Pow(xx, 2) + c3_3*math.Pow(xx, 3)
 		s = math.Exp(c4_0 + c4_1*xx + c4_2*math.Pow(xx, 2))
 	}
-	return 1 - d.CDF((y-m)/s)
+	return 1 - normalCDF((y-m)/s)
 }
 
 // ShapiroWilkStatistic implements AS R94 in Golang to compute the shapiro wilk statistic of a given data array
@@ -89,8 +94,6 @@ func (a *Arrayf64) ShapiroWilkStatistic() float64 {
 	// ROYSTON, Patrick. Remark AS R94: A remark on algorithm AS 181: The W-test for normality. Journal of the Royal Statistical Society. Series C (Applied Statistics), 1995, vol. 44, no 4, p. 547-551.
 	n := int(a.Length)
 	m := make([]float64, n)
-	d := dist.Normal{}
-	d.Init(0, 1)
 
 	if a.Length < 3 {
 		return math.NaN()
@@ -98,7 +101,7 @@ func (a *Arrayf64) ShapiroWilkStatistic() float64 {
 
 	sum := 0.0
 	for i := 0; i < n; i++ {
-		m[i] = d.Quantile(((float64(i + 1)) - (3.0 / 8.0)) / (float64(n) + .25))
+		m[i] = normalQuantile(((float64(i + 1)) - (3.0 / 8.0)) / (float64(n) + .25))
 		sum += math.Pow(m[i], 2)
 	}
 	sqrtSum := math.Sqrt(sum)
@@ -0,0 +1,354 @@
+package dist
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// Affine represents the distribution of the affine transform aX + b of a
+// random variable X
+// Probability distribution function as follows:
+//		Y = aX + b, a != 0
+//
+//		f_Y(y) = f_X((y-b)/a) / |a| for continuous X
+//		P(Y = y) = P(X = (y-b)/a) for discrete X, a and b integers
+//
+// Discrete distributions being supported by the integers, their transforms
+// are restricted to integer coefficients.
+type Affine struct {
+	source
+	Dist Distribution
+	A, B float64
+}
+
+// Init intialises the distribution of aX + b, where X follows d
+func (a *Affine) Init(d Distribution, scale, shift float64) error {
+	if d == nil || scale == 0 || math.IsNaN(scale) || math.IsInf(scale, 0) || math.IsNaN(shift) || math.IsInf(shift, 0) {
+		return util.ErrAffineParam
+	}
+	if IsDiscrete(d) && (!isInteger(scale) || !isInteger(shift)) {
+		return util.ErrAffineParam
+	}
+	a.Dist, a.A, a.B = d, scale, shift
+	return nil
+}
+
+// AffineTransform returns the distribution of aX + b, where X follows d.
+// Location-scale families, and scale families when b = 0, are
+// transformed exactly into a distribution of the same family, the other
+// distributions being wrapped by Affine.
+func AffineTransform(d Distribution, a, b float64) (Distribution, error) {
+	res := &Affine{}
+	if err := res.Init(d, a, b); err != nil {
+		return nil, err
+	}
+	if a == 1 && b == 0 {
+		return d, nil
+	}
+
+	s := math.Abs(a)
+	switch v := d.(type) {
+	case *Normal:
+		return &Normal{Mu: a*v.Mu + b, Sigma: s * v.Sigma}, nil
+	case *Cauchy:
+		return &Cauchy{Mu: a*v.Mu + b, Sigma: s * v.Sigma}, nil
+	case *Laplace:
+		return &Laplace{Mu: a*v.Mu + b, Sigma: s * v.Sigma}, nil
+	case *Logistic:
+		return &Logistic{Mu: a*v.Mu + b, Sigma: s * v.Sigma}, nil
+	case *StudentT:
+		return &LocationScaleT{Nu: v.Nu, Mu: b, Sigma: s}, nil
+	case *LocationScaleT:
+		return &LocationScaleT{Nu: v.Nu, Mu: a*v.Mu + b, Sigma: s * v.Sigma}, nil
+	case *Uniform:
+		lo, hi := a*v.A+b, a*v.B+b
+		if a < 0 {
+			lo, hi = hi, lo
+		}
+		return &Uniform{A: lo, B: hi}, nil
+	case *Triangular:
+		lo, hi := a*v.A+b, a*v.B+b
+		if a < 0 {
+			lo, hi = hi, lo
+		}
+		return &Triangular{A: lo, B: hi, C: a*v.C + b}, nil
+	case *Gumbel:
+		if a < 0 {
+			return &GumbelMin{Mu: a*v.Mu + b, Sigma: s * v.Sigma}, nil
+		}
+		return &Gumbel{Mu: a*v.Mu + b, Sigma: s * v.Sigma}, nil
+	case *GumbelMin:
+		if a < 0 {
+			return &Gumbel{Mu: a*v.Mu + b, Sigma: s * v.Sigma}, nil
+		}
+		return &GumbelMin{Mu: a*v.Mu + b, Sigma: s * v.Sigma}, nil
+	case *Weibull:
+		if a > 0 {
+			return &Weibull{K: v.K, Lambda: a * v.Lambda, Theta: a*v.Theta + b}, nil
+		}
+	case *Exponential:
+		if a > 0 && b == 0 {
+			return &Exponential{Lambda: v.Lambda / a}, nil
+		}
+	case *Gamma:
+		if a > 0 && b == 0 {
+			return &Gamma{Alpha: v.Alpha, Beta: v.Beta / a}, nil
+		}
+	case *LogNormal:
+		if a > 0 && b == 0 {
+			return &LogNormal{Mu: v.Mu + math.Log(a), Sigma: v.Sigma}, nil
+		}
+	case *Pareto:
+		if a > 0 && b == 0 {
+			return &Pareto{Xm: a * v.Xm, Alpha: v.Alpha}, nil
+		}
+	case *Lomax:
+		if a > 0 && b == 0 {
+			return &Lomax{Alpha: v.Alpha, Lambda: a * v.Lambda}, nil
+		}
+	case *Affine:
+		return AffineTransform(v.Dist, a*v.A, a*v.B+b)
+	}
+	return res, nil
+}
+
+// Generate creates one sample of the transformed distribution
+func (a *Affine) Generate() float64 {
+	return a.Rand(a.rng())
+}
+
+// GenerateN creates n samples of the transformed distribution
+func (a *Affine) GenerateN(n int) []float64 {
+	return GenerateN(a, a.rng(), n)
+}
+
+// Fill fills dst with samples of the transformed distribution
+func (a *Affine) Fill(dst []float64) {
+	Fill(a, a.rng(), dst)
+}
+
+// Rand creates one sample of the transformed distribution using the given generator
+func (a *Affine) Rand(r *rand.Rand) float64 {
+	return a.A*a.Dist.Rand(r) + a.B
+}
+
+// fill fills dst with samples of the base distribution then transforms them
+func (a *Affine) fill(r *rand.Rand, dst []float64) {
+	Fill(a.Dist, r, dst)
+	for i := range dst {
+		dst[i] = a.A*dst[i] + a.B
+	}
+}
+
+// isInteger returns true when x is a finite integer
+func isInteger(x float64) bool {
+	return !math.IsInf(x, 0) && x == math.Trunc(x)
+}
+
+// inverse returns (y-b)/a, snapped to the closest integer for discrete
+// distributions when the difference is a rounding error
+func (a *Affine) inverse(y float64) float64 {
+	x := (y - a.B) / a.A
+	if k := math.Round(x); IsDiscrete(a.Dist) && math.Abs(x-k) <= 1e-9*math.Max(1, math.Abs(k)) {
+		return k
+	}
+	return x
+}
+
+// Domain returns the definition domain of the distribution
+func (a *Affine) Domain() (float64, float64) {
+	dbeg, dend := a.Dist.Domain()
+	if a.A < 0 {
+		return a.A*dend + a.B, a.A*dbeg + a.B
+	}
+	return a.A*dbeg + a.B, a.A*dend + a.B
+}
+
+// PDF returns the probability density function value of a given x
+func (a *Affine) PDF(x float64) float64 {
+	return math.Exp(a.LogPDF(x))
+}
+
+// LogPDF returns the log of the probability density function value of a given x
+func (a *Affine) LogPDF(x float64) float64 {
+	if IsDiscrete(a.Dist) {
+		return logDensity(a.Dist, a.inverse(x))
+	}
+	return logDensity(a.Dist, a.inverse(x)) - math.Log(math.Abs(a.A))
+}
+
+// PMF returns the probability mass function value of a given k
+func (a *Affine) PMF(k float64) float64 {
+	return a.PDF(k)
+}
+
+// LogPMF returns the log of the probability mass function value of a given k
+func (a *Affine) LogPMF(k float64) float64 {
+	return a.LogPDF(k)
+}
+
+// below returns the point just below x for discrete distributions, so that
+// the cdf of X at below(x) is P(X < x)
+func (a *Affine) below(x float64) float64 {
+	if IsDiscrete(a.Dist) {
+		return math.Nextafter(x, math.Inf(-1))
+	}
+	return x
+}
+
+// CDF returns the Cumulative distribution function value of a given x
+//		F_Y(y) = F_X((y-b)/a) for a > 0, P(X >= (y-b)/a) otherwise
+//
+func (a *Affine) CDF(x float64) float64 {
+	if a.A > 0 {
+		return a.Dist.CDF(a.inverse(x))
+	}
+	return a.Dist.Survival(a.below(a.inverse(x)))
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given x
+func (a *Affine) LogCDF(x float64) float64 {
+	if a.A > 0 {
+		return a.Dist.LogCDF(a.inverse(x))
+	}
+	return a.Dist.LogSurvival(a.below(a.inverse(x)))
+}
+
+// Survival returns the survival function value of a given x
+func (a *Affine) Survival(x float64) float64 {
+	if a.A > 0 {
+		return a.Dist.Survival(a.inverse(x))
+	}
+	return a.Dist.CDF(a.below(a.inverse(x)))
+}
+
+// LogSurvival returns the log of the survival function value of a given x
+func (a *Affine) LogSurvival(x float64) float64 {
+	if a.A > 0 {
+		return a.Dist.LogSurvival(a.inverse(x))
+	}
+	return a.Dist.LogCDF(a.below(a.inverse(x)))
+}
+
+// Quantile returns the p-th quantile of the distribution
+// Algorithm:
+//		a > 0 : Q_Y(p) = a Q_X(p) + b
+//		a < 0 : Q_Y(p) = a Q_X(1 - p) + b, the quantile of X being taken
+//		just above 1 - p for discrete distributions so that Q_Y is the
+//		smallest y such that F_Y(y) >= p
+//
+func (a *Affine) Quantile(p float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	if a.A > 0 {
+		return a.A*a.Dist.Quantile(p) + a.B
+	}
+	if p == 0 {
+		dbeg, _ := a.Domain()
+		return dbeg
+	}
+	q := 1 - p
+	if IsDiscrete(a.Dist) {
+		q = math.Nextafter(q, 1)
+	}
+	return a.A*a.Dist.Quantile(q) + a.B
+}
+
+// Mean returns the mean of the distribution
+func (a *Affine) Mean() float64 {
+	return a.A*a.Dist.Mean() + a.B
+}
+
+// Median returns the median of the distribution
+func (a *Affine) Median() float64 {
+	return a.Quantile(.5)
+}
+
+// Var returns the variance of the distribution
+func (a *Affine) Var() float64 {
+	return a.A * a.A * a.Dist.Var()
+}
+
+// Skewness returns the Pearson's moment coefficient of skewness of the distribution
+func (a *Affine) Skewness() float64 {
+	if s, ok := a.Dist.(interface{ Skewness() float64 }); ok {
+		return math.Copysign(1, a.A) * s.Skewness()
+	}
+	return math.NaN()
+}
+
+// Kurtosis returns the Kurtosis of the distribution
+func (a *Affine) Kurtosis() float64 {
+	if k, ok := a.Dist.(interface{ Kurtosis() float64 }); ok {
+		return k.Kurtosis()
+	}
+	return math.NaN()
+}
+
+// Entropy returns the Entropy of the distribution, the differential
+// entropy of continuous distributions being shifted by log|a|
+func (a *Affine) Entropy() float64 {
+	h, ok := a.Dist.(interface{ Entropy() float64 })
+	if !ok {
+		return math.NaN()
+	}
+	if IsDiscrete(a.Dist) {
+		return h.Entropy()
+	}
+	return h.Entropy() + math.Log(math.Abs(a.A))
+}
+
+// expectation returns E[g(Y)] = E[g(aX + b)]
+func (a *Affine) expectation(g func(float64) float64) float64 {
+	return expect(a.Dist, func(x float64) float64 { return g(a.A*x + a.B) })
+}
+
+// centralMoment returns E[(Y - E[Y])^k] = a^k E[(X - E[X])^k]
+func (a *Affine) centralMoment(k int) float64 {
+	return math.Pow(a.A, float64(k)) * CentralMoment(a.Dist, k)
+}
+
+// cumulant returns the k-th cumulant, κ_1 = a κ_1(X) + b, κ_k = a^k κ_k(X)
+func (a *Affine) cumulant(k int) float64 {
+	if k == 1 {
+		return a.Mean()
+	}
+	return math.Pow(a.A, float64(k)) * Cumulant(a.Dist, k)
+}
+
+// cf returns the characteristic function of the distribution at t
+//		φ_Y(t) = exp(itb) φ_X(at)
+//
+func (a *Affine) cf(t float64) complex128 {
+	return expi(t*a.B) * CF(a.Dist, a.A*t)
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (a *Affine) RawMoment(k int) float64 {
+	return RawMoment(a, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (a *Affine) CentralMoment(k int) float64 {
+	return CentralMoment(a, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (a *Affine) Cumulant(k int) float64 {
+	return Cumulant(a, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (a *Affine) CF(t float64) complex128 {
+	return CF(a, t)
+}
+
+// Summary returns the properties of the distribution
+func (a *Affine) Summary() *DistSummary {
+	s := summarise(a, "affine", Param{"a", a.A}, Param{"b", a.B})
+	s.Components = []*DistSummary{summaryOf(a.Dist)}
+	return s
+}
@@ -0,0 +1,163 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+func TestAffine(t *testing.T) {
+	// Exact transforms match the Affine wrapper
+	for _, tc := range []struct {
+		dist Continuous
+		a, b float64
+	}{
+		{&Normal{Mu: 1, Sigma: 2}, 3, -1},
+		{&Normal{Mu: 1, Sigma: 2}, -.5, 4},
+		{&Cauchy{Mu: 0, Sigma: 1}, -2, 1},
+		{&Laplace{Mu: 2, Sigma: .5}, 1.5, 0},
+		{&Logistic{Mu: -1, Sigma: 1}, -1, 0},
+		{&StudentT{Nu: 4}, 2, 3},
+		{&LocationScaleT{Nu: 3, Mu: 1, Sigma: 2}, -3, 1},
+		{&Uniform{A: 0, B: 1}, -2, 1},
+		{&Triangular{A: 0, B: 2, C: .5}, -1, 3},
+		{&Gumbel{Mu: 0, Sigma: 1}, -1, 2},
+		{&GumbelMin{Mu: 1, Sigma: 2}, 2, 0},
+		{&Weibull{K: 2, Lambda: 1, Theta: 1}, 3, 1},
+		{&Exponential{Lambda: 2}, 4, 0},
+		{&Gamma{Alpha: 3, Beta: 2}, .5, 0},
+		{&LogNormal{Mu: 0, Sigma: .5}, 2, 0},
+		{&Pareto{Xm: 1, Alpha: 3}, 2, 0},
+		{&Lomax{Alpha: 3, Lambda: 2}, 3, 0},
+	} {
+		t.Run(fmt.Sprintf("%T(%g,%g)", tc.dist, tc.a, tc.b), func(t *testing.T) {
+			exact, err := AffineTransform(tc.dist, tc.a, tc.b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, ok := exact.(*Affine); ok {
+				t.Fatalf("expected a closed form, got %T", exact)
+			}
+			wrapped := &Affine{}
+			if err := wrapped.Init(tc.dist, tc.a, tc.b); err != nil {
+				t.Fatal(err)
+			}
+			for _, p := range []float64{.01, .2, .5, .8, .99} {
+				x := wrapped.Quantile(p)
+				if v := exact.Quantile(p); math.Abs(v-x) > 1e-8*math.Max(1, math.Abs(x)) {
+					t.Errorf("Q(%f) = %f, expected %f", p, v, x)
+				}
+				if v, e := exact.CDF(x), wrapped.CDF(x); math.Abs(v-e) > 1e-10 {
+					t.Errorf("F(%f) = %f, expected %f", x, v, e)
+				}
+				if v, e := exact.(Continuous).PDF(x), wrapped.PDF(x); math.Abs(v-e) > 1e-10*math.Max(1, e) {
+					t.Errorf("f(%f) = %f, expected %f", x, v, e)
+				}
+			}
+			if m, v := wrapped.Mean(), wrapped.Var(); !math.IsNaN(m) && !math.IsInf(v, 0) {
+				if e := exact.Mean(); math.Abs(m-e) > 1e-9*math.Max(1, math.Abs(e)) {
+					t.Errorf("E[Y] = %f, expected %f", m, e)
+				}
+				if e := exact.Var(); math.Abs(v-e) > 1e-9*math.Max(1, e) {
+					t.Errorf("Var(Y) = %f, expected %f", v, e)
+				}
+			}
+		})
+	}
+
+	// Transforms of transforms are collapsed
+	twice, _ := AffineTransform(&Affine{Dist: &Poisson{Lambda: 3}, A: 2, B: 1}, -1, 1)
+	if a, ok := twice.(*Affine); !ok || a.A != -2 || a.B != 0 {
+		t.Errorf("expected -2X, got %+v", twice)
+	}
+	if same, _ := AffineTransform(&Poisson{Lambda: 3}, 1, 0); *same.(*Poisson) != (Poisson{Lambda: 3}) {
+		t.Errorf("expected the distribution itself, got %+v", same)
+	}
+	if _, err := AffineTransform(&Normal{Mu: 0, Sigma: 1}, 0, 1); err != util.ErrAffineParam {
+		t.Errorf("expected %v, got %v", util.ErrAffineParam, err)
+	}
+}
+
+func TestAffineDiscrete(t *testing.T) {
+	// Y = 1 - 2X, X ~ Poisson(3), is supported by {..., -3, -1, 1}
+	poisson := &Poisson{Lambda: 3}
+	dist := &Affine{}
+	if err := dist.Init(poisson, -2, 1); err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(dist.Summary())
+	if !IsDiscrete(dist) {
+		t.Errorf("expected a discrete distribution")
+	}
+	if lo, hi := dist.Domain(); !math.IsInf(lo, -1) || hi != 1 {
+		t.Errorf("domain [%f, %f], expected [-Inf, 1]", lo, hi)
+	}
+	for k := 0.0; k < 10; k++ {
+		y := 1 - 2*k
+		if v, e := dist.PMF(y), poisson.PMF(k); math.Abs(v-e) > 1e-15 {
+			t.Errorf("P(Y = %g) = %g, expected %g", y, v, e)
+		}
+		if v := dist.PMF(y + 1); v != 0 {
+			t.Errorf("P(Y = %g) = %g, expected 0", y+1, v)
+		}
+		if v, e := dist.CDF(y), poisson.Survival(k-1); math.Abs(v-e) > 1e-14 {
+			t.Errorf("F(%g) = %g, expected %g", y, v, e)
+		}
+		if v, e := dist.Survival(y), poisson.CDF(k-1); math.Abs(v-e) > 1e-14 {
+			t.Errorf("S(%g) = %g, expected %g", y, v, e)
+		}
+	}
+	// The quantile is the generalized inverse of the cdf
+	for _, p := range []float64{.01, .1, .3, .5, .7, .9, .99} {
+		q := dist.Quantile(p)
+		if dist.CDF(q) < p || dist.CDF(q-2) >= p {
+			t.Errorf("Q(%f) = %f, F(Q) = %f, F(Q - 2) = %f", p, q, dist.CDF(q), dist.CDF(q-2))
+		}
+	}
+	if m, v := dist.Mean(), dist.Var(); m != -5 || v != 12 {
+		t.Errorf("E[Y] = %f, Var(Y) = %f, expected -5, 12", m, v)
+	}
+	if s := dist.Skewness(); s != -poisson.Skewness() {
+		t.Errorf("skewness %f, expected %f", s, -poisson.Skewness())
+	}
+
+	a := dist.GenerateN(10000)
+	mean := 0.0
+	for _, v := range a {
+		mean += v / float64(len(a))
+		if math.Mod(v, 2) == 0 {
+			t.Fatalf("sample %f out of the support", v)
+		}
+	}
+	if math.Abs(mean+5) > .2 {
+		t.Errorf("sample mean %f, expected -5", mean)
+	}
+
+	// Transforms of discrete distributions off the integers are rejected
+	for _, c := range [][]float64{{.5, 0}, {2, .5}, {-1.5, 1}} {
+		if err := dist.Init(poisson, c[0], c[1]); err != util.ErrAffineParam {
+			t.Errorf("a = %g, b = %g: expected %v, got %v", c[0], c[1], util.ErrAffineParam, err)
+		}
+		if _, err := AffineTransform(poisson, c[0], c[1]); err != util.ErrAffineParam {
+			t.Errorf("a = %g, b = %g: expected %v, got %v", c[0], c[1], util.ErrAffineParam, err)
+		}
+	}
+	if IsDiscrete(&Affine{Dist: poisson, A: .5, B: 0}) {
+		t.Errorf("expected a transform off the integers not to be discrete")
+	}
+	// Truncating an integer transform keeps its lattice, Y = 3X - 2 on [1, 2]
+	// being 1 = 3 * 1 - 2
+	truncated := &Truncated{}
+	scaled := &Affine{}
+	if err := scaled.Init(&Poisson{Lambda: 6}, 3, -2); err != nil {
+		t.Fatal(err)
+	}
+	if err := truncated.Init(scaled, 1, 2); err != nil {
+		t.Fatal(err)
+	}
+	if m := truncated.Mean(); math.Abs(m-1) > 1e-12 {
+		t.Errorf("truncated mean %f, expected 1", m)
+	}
+}
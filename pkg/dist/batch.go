@@ -0,0 +1,108 @@
+package dist
+
+import (
+	"math/rand"
+	"runtime"
+	"sort"
+	"sync"
+
+	"github.com/ichbinfrog/statistics/pkg/array"
+)
+
+// parallelChunk is the number of samples drawn from each random stream
+// by FillParallel
+const parallelChunk = 1 << 14
+
+// filler is implemented by the distributions whose sampler depends on
+// constants worth computing once for a whole batch of samples
+type filler interface {
+	fill(r *rand.Rand, dst []float64)
+}
+
+// Fill fills dst with samples of the distribution drawn from r
+// Complexity: O(len(dst)) samples, the constants of the sampler being
+// computed once
+//
+func Fill(d Distribution, r *rand.Rand, dst []float64) {
+	if f, ok := d.(filler); ok {
+		f.fill(r, dst)
+		return
+	}
+	for i := range dst {
+		dst[i] = d.Rand(r)
+	}
+}
+
+// GenerateN returns n samples of the distribution drawn from r
+func GenerateN(d Distribution, r *rand.Rand, n int) []float64 {
+	res := make([]float64, n)
+	Fill(d, r, res)
+	return res
+}
+
+// streamSeed derives the seed of the i-th random stream from the seed
+// given to FillParallel with the SplitMix64 finaliser, so that
+// consecutive streams are decorrelated
+//
+// STEELE, Guy L., LEA, Doug, FLOOD, Christine H. Fast splittable pseudorandom number generators. 2014.
+//
+func streamSeed(seed int64, i int) int64 {
+	z := uint64(seed) + uint64(i+1)*0x9e3779b97f4a7c15
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return int64(z ^ (z >> 31))
+}
+
+// FillParallel fills dst with samples of the distribution using the given
+// number of goroutines (GOMAXPROCS when workers <= 0).
+// Algorithm:
+//		dst is split into chunks of 16384 samples, the i-th chunk being
+//		filled from its own generator seeded with streamSeed(seed, i)
+//		Each goroutine fills the chunks i = w, w + workers, ...
+//
+// The samples only depend on the seed, not on the number of goroutines.
+// The distribution must not be modified while it is being sampled.
+// Complexity: O(len(dst) / workers) samples per goroutine
+//
+func FillParallel(d Distribution, dst []float64, seed int64, workers int) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	chunks := (len(dst) + parallelChunk - 1) / parallelChunk
+	if workers > chunks {
+		workers = chunks
+	}
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			for i := w; i < chunks; i += workers {
+				lo, hi := i*parallelChunk, (i+1)*parallelChunk
+				if hi > len(dst) {
+					hi = len(dst)
+				}
+				Fill(d, rand.New(rand.NewSource(streamSeed(seed, i))), dst[lo:hi])
+			}
+			wg.Done()
+		}(w)
+	}
+	wg.Wait()
+}
+
+// GenerateArray returns an array holding n samples of the distribution
+// drawn in parallel as done by FillParallel
+// Algorithm: the samples are sorted before being inserted, so that each
+// insertion appends to the array instead of shifting it
+// Complexity: O(n log(n))
+//
+func GenerateArray(d Distribution, n int, seed int64, workers int, opt array.Optionf64) *array.Arrayf64 {
+	samples := make([]float64, n)
+	FillParallel(d, samples, seed, workers)
+	sort.Float64s(samples)
+
+	a := &array.Arrayf64{}
+	a.Init(opt)
+	a.InsertSlice(samples)
+	return a
+}
@@ -0,0 +1,117 @@
+package dist
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/ichbinfrog/statistics/pkg/array"
+)
+
+func TestFill(t *testing.T) {
+	testCases := []struct {
+		Name string
+		Dist Distribution
+	}{
+		{"binomial direct", &Binomial{N: 10, P: .7, Q: .3}},
+		{"binomial poisson", &Binomial{N: 100, P: .005, Q: .995}},
+		{"binomial rejection", &Binomial{N: 100, P: .4, Q: .6}},
+		{"poisson direct", &Poisson{Lambda: 5}},
+		{"poisson rejection", &Poisson{Lambda: 24}},
+		{"polya", &Polya{R: 5, P: .3, Q: .7}},
+		{"geometric", &Geometric{P: .3, Q: .7}},
+		{"chisq", &Chisq{Degree: 5}},
+		{"truncated rejection", &Truncated{Dist: &Normal{Mu: 0, Sigma: 1}, Lower: -1, Upper: 2}},
+		{"truncated inverse", &Truncated{Dist: &Normal{Mu: 0, Sigma: 1}, Lower: 2, Upper: 3}},
+		{"censored", &Censored{Dist: &Poisson{Lambda: 24}, Lower: 20, Upper: 30}},
+		{"normal", &Normal{Mu: 1, Sigma: 2}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			got := GenerateN(tc.Dist, rand.New(rand.NewSource(7)), 1000)
+			r := rand.New(rand.NewSource(7))
+			for i, v := range got {
+				if want := tc.Dist.Rand(r); v != want {
+					t.Fatalf("sample %d: got %f, want %f", i, v, want)
+				}
+			}
+		})
+	}
+}
+
+func TestFillMethods(t *testing.T) {
+	p := &Poisson{}
+	p.Init(24)
+	p.SetSource(rand.NewSource(3))
+	got := p.GenerateN(100)
+
+	q := &Poisson{}
+	q.Init(24)
+	q.SetSource(rand.NewSource(3))
+	want := make([]float64, 100)
+	q.Fill(want)
+	for i := range got {
+		if got[i] != want[i] {
+			t.Fatalf("sample %d: GenerateN %f, Fill %f", i, got[i], want[i])
+		}
+	}
+}
+
+func TestFillParallel(t *testing.T) {
+	d := &Binomial{N: 20, P: .4, Q: .6}
+	n := 5*parallelChunk + 123
+
+	ref := make([]float64, n)
+	FillParallel(d, ref, 11, 1)
+	for _, workers := range []int{0, 2, 3, 16} {
+		got := make([]float64, n)
+		FillParallel(d, got, 11, workers)
+		for i := range got {
+			if got[i] != ref[i] {
+				t.Fatalf("workers %d, sample %d: got %f, want %f", workers, i, got[i], ref[i])
+			}
+		}
+	}
+
+	other := make([]float64, n)
+	FillParallel(d, other, 12, 0)
+	same := 0
+	for i := range other {
+		if other[i] == ref[i] {
+			same++
+		}
+	}
+	if same == n {
+		t.Errorf("samples do not depend on the seed")
+	}
+
+	sum := 0.0
+	for _, v := range ref {
+		sum += v
+	}
+	if mean := sum / float64(n); math.Abs(mean-d.Mean()) > .05 {
+		t.Errorf("sample mean %f, expected %f", mean, d.Mean())
+	}
+
+	FillParallel(d, nil, 11, 4)
+}
+
+func TestGenerateArray(t *testing.T) {
+	d := &Normal{Mu: 3, Sigma: 2}
+	n := 3*parallelChunk + 7
+	a := GenerateArray(d, n, 5, 0, array.Optionf64{Degree: 2})
+	if int(a.Length) != n || len(a.Data) != n {
+		t.Fatalf("got %d samples, want %d", len(a.Data), n)
+	}
+	if !sort.Float64sAreSorted(a.Data) {
+		t.Errorf("samples are not sorted")
+	}
+	if math.Abs(a.Mean()-d.Mean()) > .05 {
+		t.Errorf("sample mean %f, expected %f", a.Mean(), d.Mean())
+	}
+	if math.Abs(a.Var()-d.Var()) > .1 {
+		t.Errorf("sample var %f, expected %f", a.Var(), d.Var())
+	}
+}
@@ -1,10 +1,10 @@
 package dist
 
 import (
-	"fmt"
 	"math"
 	"math/rand"
 
+	"github.com/ichbinfrog/statistics/pkg/array"
 	"github.com/ichbinfrog/statistics/pkg/util"
 )
 
@@ -17,6 +17,7 @@ import (
 //		}, k in [0 , 1]
 //
 type Bernoulli struct {
+	source
 	P float64
 	Q float64
 }
@@ -36,8 +37,26 @@ func (b *Bernoulli) Domain() (float64, float64) {
 }
 
 // Generate creates one sample of the Bernoulli distribution
-func (b *Bernoulli) Generate() bool {
-	return rand.Float64() < b.P
+func (b *Bernoulli) Generate() float64 {
+	return b.Rand(b.rng())
+}
+
+// GenerateN creates n samples of the Bernoulli distribution
+func (b *Bernoulli) GenerateN(n int) []float64 {
+	return GenerateN(b, b.rng(), n)
+}
+
+// Fill fills dst with samples of the Bernoulli distribution
+func (b *Bernoulli) Fill(dst []float64) {
+	Fill(b, b.rng(), dst)
+}
+
+// Rand creates one sample of the Bernoulli distribution using the given generator
+func (b *Bernoulli) Rand(r *rand.Rand) float64 {
+	if r.Float64() < b.P {
+		return 1
+	}
+	return 0
 }
 
 // PMF returns the probability mass function value of a given k
@@ -45,7 +64,10 @@ func (b *Bernoulli) PMF(k float64) float64 {
 	if k == 0 {
 		return b.Q
 	}
-	return b.P
+	if k == 1 {
+		return b.P
+	}
+	return 0
 }
 
 // CDF returns the Cumulative distribution function value of a given k
@@ -59,6 +81,43 @@ func (b *Bernoulli) CDF(k float64) float64 {
 	return b.Q
 }
 
+// LogPMF returns the log of the probability mass function value of a given k
+func (b *Bernoulli) LogPMF(k float64) float64 {
+	return math.Log(b.PMF(k))
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given k
+func (b *Bernoulli) LogCDF(k float64) float64 {
+	return math.Log(b.CDF(k))
+}
+
+// Survival returns the survival function value of a given k
+func (b *Bernoulli) Survival(k float64) float64 {
+	if k < 0 {
+		return 1
+	}
+	if k >= 1 {
+		return 0
+	}
+	return b.P
+}
+
+// LogSurvival returns the log of the survival function value of a given k
+func (b *Bernoulli) LogSurvival(k float64) float64 {
+	return math.Log(b.Survival(k))
+}
+
+// Quantile returns the p-th quantile of the distribution
+func (b *Bernoulli) Quantile(p float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	if p <= b.Q {
+		return 0
+	}
+	return 1
+}
+
 // Mean returns the mean of the distribution
 func (b *Bernoulli) Mean() float64 {
 	return b.P
@@ -100,23 +159,69 @@ func (b *Bernoulli) Moment(t float64) float64 {
 	return b.Q + b.P*math.Exp(t)
 }
 
+// rawMoment returns E[X^k] = p, X^k being X
+func (b *Bernoulli) rawMoment(k int) float64 {
+	return b.P
+}
+
+// cf returns the characteristic function of the distribution at t
+//		φ(t) = q + p exp(it)
+//
+func (b *Bernoulli) cf(t float64) complex128 {
+	return complex(b.Q, 0) + complex(b.P, 0)*expi(t)
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (b *Bernoulli) RawMoment(k int) float64 {
+	return RawMoment(b, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (b *Bernoulli) CentralMoment(k int) float64 {
+	return CentralMoment(b, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (b *Bernoulli) Cumulant(k int) float64 {
+	return Cumulant(b, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (b *Bernoulli) CF(t float64) complex128 {
+	return CF(b, t)
+}
+
 // FisherI returns the Fisher Information of the distribution
-func (b *Bernoulli) FisherI() float64 {
-	return 1 / (b.P * b.Q)
-}
-
-// Summary returns a string summarising basic info about the distribution
-func (b *Bernoulli) Summary() string {
-	dbeg, dend := b.Domain()
-	return fmt.Sprintf(`
-	X ~ B(%f)
-		Domain:		{ %f , %f }
-		Mean: 		%f
-		Median: 	%f
-		Var: 		%f
-		Skewness: 	%f
-		Kurtosis:	%f
-		Entropy:	%f
-		FisherInfo:	%f
-`, b.P, dbeg, dend, b.Mean(), b.Median(), b.Var(), b.Skewness(), b.Kurtosis(), b.Entropy(), b.FisherI())
+func (b *Bernoulli) FisherI() [][]float64 {
+	return [][]float64{
+		[]float64{1 / (b.P * b.Q)},
+	}
+}
+
+// Summary returns the properties of the distribution
+func (b *Bernoulli) Summary() *DistSummary {
+	return summarise(b, "bernoulli", Param{"p", b.P})
+}
+
+// FitBernoulli returns the maximum likelihood estimation of a Bernoulli
+// distribution from the given observations:
+//		p = E[X]
+//
+// Complexity: O(n)
+//
+func FitBernoulli(a *array.Arrayf64) (*Bernoulli, *FitResult, error) {
+	if err := checkSample(a, 1, 0, 1, true); err != nil {
+		return nil, nil, err
+	}
+	mean, _ := sampleMoments(a)
+
+	b := &Bernoulli{}
+	if err := b.Init(mean); err != nil {
+		return nil, nil, err
+	}
+	return b, &FitResult{
+		LogLikelihood: a.Length * (xlogy(b.P, b.P) + xlogy(b.Q, b.Q)),
+		StdErr:        fisherStdErr(b.FisherI(), a.Length),
+		Observations:  a.Length,
+	}, nil
 }
@@ -19,7 +19,7 @@ func TestBernoulli(t *testing.T) {
 	fmt.Printf("\n		Mx(0) = %f", dist.Moment(0))
 	fmt.Printf("\n		Mx(1) = %f\n", dist.Moment(1))
 
-	sl := []bool{}
+	sl := []float64{}
 	for i := 0; i < 10; i++ {
 		sl = append(sl, dist.Generate())
 	}
@@ -0,0 +1,121 @@
+package dist
+
+import (
+	"math"
+	"sort"
+
+	"github.com/ichbinfrog/statistics/pkg/array"
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// criterion is the information criterion used to rank fitted distributions
+type criterion int8
+
+const (
+	// RankAIC ranks the distributions by Akaike information criterion
+	//		AIC = 2k - 2log(L)
+	RankAIC criterion = iota
+	// RankBIC ranks the distributions by Bayesian information criterion
+	//		BIC = k log(n) - 2log(L)
+	RankBIC
+)
+
+// Candidate is a distribution fitted by BestFit along with its goodness of fit
+type Candidate struct {
+	Family        string       `json:"family"`
+	Dist          Distribution `json:"-"`
+	Discrete      bool         `json:"discrete"`
+	Params        int          `json:"params"`
+	LogLikelihood float64      `json:"logLikelihood"`
+	AIC           float64      `json:"aic"`
+	BIC           float64      `json:"bic"`
+	// PValue is the p-value of the Kolmogorov-Smirnov test for continuous
+	// distributions, or of the χ^2 test for discrete ones
+	PValue float64    `json:"pValue"`
+	Fit    *FitResult `json:"fit"`
+}
+
+// fitter associates a family of distribution to its maximum likelihood estimator
+type fitter struct {
+	Family   string
+	Discrete bool
+	Fit      func(*array.Arrayf64) (Distribution, *FitResult, error)
+}
+
+// fitted adapts the results of a maximum likelihood estimator to a fitter,
+// the distribution of a failed fit being a nil interface rather than a
+// nil pointer
+func fitted(d Distribution, res *FitResult, err error) (Distribution, *FitResult, error) {
+	if err != nil {
+		return nil, nil, err
+	}
+	return d, res, nil
+}
+
+// fitters lists the families tried by BestFit
+var fitters = []fitter{
+	{"normal", false, func(a *array.Arrayf64) (Distribution, *FitResult, error) { return fitted(FitNormal(a)) }},
+	{"exponential", false, func(a *array.Arrayf64) (Distribution, *FitResult, error) { return fitted(FitExponential(a)) }},
+	{"gamma", false, func(a *array.Arrayf64) (Distribution, *FitResult, error) { return fitted(FitGamma(a)) }},
+	{"lognormal", false, func(a *array.Arrayf64) (Distribution, *FitResult, error) { return fitted(FitLogNormal(a)) }},
+	{"weibull", false, func(a *array.Arrayf64) (Distribution, *FitResult, error) { return fitted(FitWeibull(a)) }},
+	{"pareto", false, func(a *array.Arrayf64) (Distribution, *FitResult, error) { return fitted(FitPareto(a)) }},
+	{"laplace", false, func(a *array.Arrayf64) (Distribution, *FitResult, error) { return fitted(FitLaplace(a)) }},
+	{"bernoulli", true, func(a *array.Arrayf64) (Distribution, *FitResult, error) { return fitted(FitBernoulli(a)) }},
+	{"geometric", true, func(a *array.Arrayf64) (Distribution, *FitResult, error) { return fitted(FitGeometric(a)) }},
+	{"poisson", true, func(a *array.Arrayf64) (Distribution, *FitResult, error) { return fitted(FitPoisson(a)) }},
+	{"polya", true, func(a *array.Arrayf64) (Distribution, *FitResult, error) { return fitted(FitPolya(a)) }},
+}
+
+// BestFit fits every family of distribution of the package applicable
+// to the observations and returns them ranked by the given criterion
+// (lowest first). Families whose domain does not contain every
+// observation are skipped, as are discrete families on non integer
+// observations.
+//
+// Note that likelihoods of discrete and continuous distributions are
+// not comparable, callers ranking integer data may want to filter the
+// result on Candidate.Discrete.
+//
+func BestFit(a *array.Arrayf64, rank criterion) ([]*Candidate, error) {
+	if a == nil || len(a.Data) < 2 {
+		return nil, util.ErrEmptyArray
+	}
+
+	res := []*Candidate{}
+	for _, f := range fitters {
+		d, fit, err := f.Fit(a)
+		if err != nil {
+			continue
+		}
+
+		k := float64(len(fit.StdErr))
+		c := &Candidate{
+			Family:        f.Family,
+			Dist:          d,
+			Discrete:      f.Discrete,
+			Params:        len(fit.StdErr),
+			LogLikelihood: fit.LogLikelihood,
+			AIC:           2*k - 2*fit.LogLikelihood,
+			BIC:           k*math.Log(fit.Observations) - 2*fit.LogLikelihood,
+			Fit:           fit,
+		}
+		if dd, ok := d.(Discrete); ok && f.Discrete {
+			_, c.PValue = ChiSquare(a, dd, c.Params)
+		} else {
+			_, c.PValue = KolmogorovSmirnov(a, d)
+		}
+		res = append(res, c)
+	}
+	if len(res) == 0 {
+		return nil, util.ErrFitSupport
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		if rank == RankBIC {
+			return res[i].BIC < res[j].BIC
+		}
+		return res[i].AIC < res[j].AIC
+	})
+	return res, nil
+}
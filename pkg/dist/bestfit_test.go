@@ -0,0 +1,72 @@
+package dist
+
+import (
+	"testing"
+
+	"github.com/ichbinfrog/statistics/pkg/array"
+)
+
+func TestBestFit(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Dist     Distribution
+		Rank     criterion
+		Expected string
+	}{
+		{"gamma", &Gamma{Alpha: 2, Beta: .5}, RankAIC, "gamma"},
+		{"normal", &Normal{Mu: -3, Sigma: 2}, RankBIC, "normal"},
+		{"exponential", &Exponential{Lambda: 3}, RankBIC, "exponential"},
+		{"poisson", &Poisson{Lambda: 4}, RankBIC, "poisson"},
+		{"polya", &Polya{R: 2, P: .8, Q: .2}, RankAIC, "polya"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			res, err := BestFit(sample(tc.Dist, 2000, 3), tc.Rank)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res[0].Family != tc.Expected {
+				t.Errorf("expected %s to rank first, got %+v", tc.Expected, res[0])
+			}
+			if res[0].PValue < .01 {
+				t.Errorf("best fit rejected by goodness of fit test: %+v", res[0])
+			}
+			for i := 1; i < len(res); i++ {
+				if (tc.Rank == RankAIC && res[i].AIC < res[i-1].AIC) || (tc.Rank == RankBIC && res[i].BIC < res[i-1].BIC) {
+					t.Errorf("candidates are not ranked: %+v before %+v", res[i-1], res[i])
+				}
+			}
+		})
+	}
+}
+
+func TestBestFitSupport(t *testing.T) {
+	a := &array.Arrayf64{}
+	a.Init(array.Optionf64{Degree: 2})
+	a.InsertSlice([]float64{-1.5, .2, 3.1, 2, 4.7})
+
+	res, err := BestFit(a, RankAIC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Only the families supported on the whole real line remain
+	real := map[string]bool{"normal": true, "laplace": true}
+	for _, c := range res {
+		if !real[c.Family] {
+			t.Errorf("%s should not be fitted on negative non integer data", c.Family)
+		}
+	}
+}
+
+func TestFitters(t *testing.T) {
+	// Failed fits return a nil interface rather than a nil pointer
+	a := observations(-1.5, .2, 3.1)
+	for _, f := range fitters {
+		if d, res, err := f.Fit(a); err != nil && (d != nil || res != nil) {
+			t.Errorf("%s: failed fit returned %v, %v", f.Family, d, res)
+		} else if err == nil && d == nil {
+			t.Errorf("%s: expected a distribution", f.Family)
+		}
+	}
+}
@@ -0,0 +1,240 @@
+package dist
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/ichbinfrog/statistics/pkg/specfun"
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// Beta represents the Beta distribution
+// Continuous probability distribution function as follows:
+//		X ~ B(α, β), α > 0, β > 0
+//
+//		f(x,α,β) = x^(α-1) (1-x)^(β-1) / B(α, β), x in [0, 1]
+//
+type Beta struct {
+	source
+	Alpha, Beta float64
+}
+
+// Init intialises a Beta distribution
+func (b *Beta) Init(alpha, beta float64) error {
+	if alpha <= 0 || beta <= 0 {
+		return util.ErrBetaParam
+	}
+	b.Alpha, b.Beta = alpha, beta
+	return nil
+}
+
+// Generate creates one sample of the Beta distribution
+func (b *Beta) Generate() float64 {
+	return b.Rand(b.rng())
+}
+
+// GenerateN creates n samples of the Beta distribution
+func (b *Beta) GenerateN(n int) []float64 {
+	return GenerateN(b, b.rng(), n)
+}
+
+// Fill fills dst with samples of the Beta distribution
+func (b *Beta) Fill(dst []float64) {
+	Fill(b, b.rng(), dst)
+}
+
+// Rand creates one sample of the Beta distribution using the given generator
+// Algorithm:
+//		X / (X + Y), X ~ Γ(α, 1), Y ~ Γ(β, 1)
+//
+// Complexity: O(1), the cost of two samples of the Gamma distribution
+//
+func (b *Beta) Rand(r *rand.Rand) float64 {
+	return b.ratio(r, newGammaSampler(b.Alpha), newGammaSampler(b.Beta))
+}
+
+// fill fills dst with samples of the Beta distribution, sharing the
+// constants of the Gamma samplers
+func (b *Beta) fill(r *rand.Rand, dst []float64) {
+	x, y := newGammaSampler(b.Alpha), newGammaSampler(b.Beta)
+	for i := range dst {
+		dst[i] = b.ratio(r, x, y)
+	}
+}
+
+// ratio returns X / (X + Y) from samples of the Gamma samplers x and y
+func (b *Beta) ratio(r *rand.Rand, x, y *gammaSampler) float64 {
+	u, v := x.sample(r), y.sample(r)
+	if u+v == 0 {
+		// Both samples underflow for tiny shapes, whose mass concentrates
+		// on the bounds
+		if r.Float64() < b.Alpha/(b.Alpha+b.Beta) {
+			return 1
+		}
+		return 0
+	}
+	return u / (u + v)
+}
+
+// Domain returns the definition domain of the distribution
+func (b *Beta) Domain() (float64, float64) {
+	return 0, 1
+}
+
+// PDF returns the probability density function value of a given x
+func (b *Beta) PDF(x float64) float64 {
+	return math.Exp(b.LogPDF(x))
+}
+
+// LogPDF returns the log of the probability density function value of a given x
+func (b *Beta) LogPDF(x float64) float64 {
+	if x < 0 || x > 1 {
+		return math.Inf(-1)
+	}
+	return xlogy(b.Alpha-1, x) + xlogy(b.Beta-1, 1-x) - specfun.LogBeta(b.Alpha, b.Beta)
+}
+
+// CDF returns the Cumulative distribution function value of a given x
+func (b *Beta) CDF(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	if x >= 1 {
+		return 1
+	}
+	return specfun.RegIncBeta(b.Alpha, b.Beta, x)
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given x
+func (b *Beta) LogCDF(x float64) float64 {
+	if x <= 0 {
+		return math.Inf(-1)
+	}
+	if x >= 1 {
+		return 0
+	}
+	return specfun.LogRegIncBeta(b.Alpha, b.Beta, x)
+}
+
+// Survival returns the survival function value of a given x
+func (b *Beta) Survival(x float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+	if x >= 1 {
+		return 0
+	}
+	return specfun.RegIncBeta(b.Beta, b.Alpha, 1-x)
+}
+
+// LogSurvival returns the log of the survival function value of a given x
+func (b *Beta) LogSurvival(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	if x >= 1 {
+		return math.Inf(-1)
+	}
+	return specfun.LogRegIncBeta(b.Beta, b.Alpha, 1-x)
+}
+
+// Quantile returns the p-th quantile of the distribution
+func (b *Beta) Quantile(p float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	return specfun.InvRegIncBeta(b.Alpha, b.Beta, p)
+}
+
+// Mean returns the mean of the distribution
+func (b *Beta) Mean() float64 {
+	return b.Alpha / (b.Alpha + b.Beta)
+}
+
+// Median returns the median of the distribution
+func (b *Beta) Median() float64 {
+	return b.Quantile(.5)
+}
+
+// Var returns the variance of the distribution
+func (b *Beta) Var() float64 {
+	s := b.Alpha + b.Beta
+	return b.Alpha * b.Beta / (s * s * (s + 1))
+}
+
+// Skewness returns the Pearson's moment coefficient of skewness of the distribution
+func (b *Beta) Skewness() float64 {
+	s := b.Alpha + b.Beta
+	return 2 * (b.Beta - b.Alpha) * math.Sqrt(s+1) / ((s + 2) * math.Sqrt(b.Alpha*b.Beta))
+}
+
+// Kurtosis returns the Kurtosis of the distribution
+func (b *Beta) Kurtosis() float64 {
+	s, p := b.Alpha+b.Beta, b.Alpha*b.Beta
+	return 6 * (math.Pow(b.Alpha-b.Beta, 2)*(s+1) - p*(s+2)) / (p * (s + 2) * (s + 3))
+}
+
+// Entropy returns the Entropy of the distribution
+func (b *Beta) Entropy() float64 {
+	return specfun.LogBeta(b.Alpha, b.Beta) - (b.Alpha-1)*specfun.Digamma(b.Alpha) -
+		(b.Beta-1)*specfun.Digamma(b.Beta) + (b.Alpha+b.Beta-2)*specfun.Digamma(b.Alpha+b.Beta)
+}
+
+// Moment returns the t-th moment of the distribution
+// Algorithm: Kummer's confluent hypergeometric series
+//		M(t) = 1 + Σ(k = 1; k < ∞; k++) Π(r = 0; r < k; r++) (α + r)/(α + β + r) * t^k/k!
+//
+func (b *Beta) Moment(t float64) float64 {
+	sum, term := 1.0, 1.0
+	for k := 0.0; k < maxIter; k++ {
+		term *= (b.Alpha + k) / (b.Alpha + b.Beta + k) * t / (k + 1)
+		sum += term
+		if math.Abs(term) < math.Abs(sum)*epsilon {
+			break
+		}
+	}
+	return sum
+}
+
+// rawMoment returns E[X^k] = Π_(r=0..k-1) (α + r) / (α + β + r)
+func (b *Beta) rawMoment(k int) float64 {
+	res := 1.0
+	for r := 0.0; r < float64(k); r++ {
+		res *= (b.Alpha + r) / (b.Alpha + b.Beta + r)
+	}
+	return res
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (b *Beta) RawMoment(k int) float64 {
+	return RawMoment(b, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (b *Beta) CentralMoment(k int) float64 {
+	return CentralMoment(b, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (b *Beta) Cumulant(k int) float64 {
+	return Cumulant(b, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (b *Beta) CF(t float64) complex128 {
+	return CF(b, t)
+}
+
+// FisherI returns the Fisher Information of the distribution
+func (b *Beta) FisherI() [][]float64 {
+	s := specfun.Trigamma(b.Alpha + b.Beta)
+	return [][]float64{
+		[]float64{specfun.Trigamma(b.Alpha) - s, -s},
+		[]float64{-s, specfun.Trigamma(b.Beta) - s},
+	}
+}
+
+// Summary returns the properties of the distribution
+func (b *Beta) Summary() *DistSummary {
+	return summarise(b, "beta", Param{"alpha", b.Alpha}, Param{"beta", b.Beta})
+}
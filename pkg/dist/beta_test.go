@@ -0,0 +1,69 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestBeta(t *testing.T) {
+	dist := &Beta{}
+	if err := dist.Init(2, 5); err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(dist.Summary())
+
+	// B(2, 1) has density 2x and cdf x^2
+	b := &Beta{Alpha: 2, Beta: 1}
+	for _, x := range []float64{0, .1, .5, .9, 1} {
+		if v := b.CDF(x); math.Abs(v-x*x) > 1e-12 {
+			t.Errorf("B(2, 1): F(%g) = %g, expected %g", x, v, x*x)
+		}
+		if v := b.PDF(x); math.Abs(v-2*x) > 1e-12 {
+			t.Errorf("B(2, 1): f(%g) = %g, expected %g", x, v, 2*x)
+		}
+	}
+
+	moments := []struct {
+		Name     string
+		Actual   float64
+		Expected float64
+	}{
+		{"mean", expectation(dist, func(x float64) float64 { return x }, 50000), dist.Mean()},
+		{"var", expectation(dist, func(x float64) float64 { return math.Pow(x-dist.Mean(), 2) }, 50000), dist.Var()},
+		{"skewness", expectation(dist, func(x float64) float64 { return math.Pow(x-dist.Mean(), 3) }, 50000) / math.Pow(dist.Var(), 1.5), dist.Skewness()},
+		{"kurtosis", expectation(dist, func(x float64) float64 { return math.Pow(x-dist.Mean(), 4) }, 50000)/math.Pow(dist.Var(), 2) - 3, dist.Kurtosis()},
+		{"entropy", expectation(dist, func(x float64) float64 { return -dist.LogPDF(x) }, 50000), dist.Entropy()},
+		{"mgf(2)", expectation(dist, func(x float64) float64 { return math.Exp(2 * x) }, 50000), dist.Moment(2)},
+		{"mgf(-3)", expectation(dist, func(x float64) float64 { return math.Exp(-3 * x) }, 50000), dist.Moment(-3)},
+	}
+	for _, m := range moments {
+		if math.Abs(m.Actual-m.Expected) > 1e-3*math.Max(1, math.Abs(m.Expected)) {
+			t.Errorf("%s %f, numerically %f", m.Name, m.Expected, m.Actual)
+		}
+	}
+	if err := dist.Init(0, 1); err == nil {
+		t.Errorf("expected error for α = 0")
+	}
+}
+
+func BenchmarkBetaRand(b *testing.B) {
+	dist := &Beta{}
+	dist.Init(2, 5)
+	dst := make([]float64, 1000)
+	b.Run("gammaratio", func(b *testing.B) {
+		r := rand.New(rand.NewSource(1))
+		for i := 0; i < b.N; i++ {
+			Fill(dist, r, dst)
+		}
+	})
+	b.Run("inversion", func(b *testing.B) {
+		r := rand.New(rand.NewSource(1))
+		for i := 0; i < b.N; i++ {
+			for j := range dst {
+				dst[j] = dist.Quantile(r.Float64())
+			}
+		}
+	})
+}
@@ -0,0 +1,201 @@
+package dist
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/ichbinfrog/statistics/pkg/specfun"
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// BetaBinomial represents the Beta-Binomial distribution, the number of
+// successes in n trials whose probability of success follows a Beta
+// distribution
+// Discreet probability distribution function as follows:
+//		X ~ BB(n, α, β), n >= 0, α > 0, β > 0
+//		f(k,n,α,β) = {
+//			C(n, k)B(k + α, n - k + β) / B(α, β)
+//		}, k in [0, ..., n]
+//
+type BetaBinomial struct {
+	source
+	N, Alpha, Beta float64
+}
+
+// Init intialises a Beta-Binomial distribution
+func (b *BetaBinomial) Init(n, alpha, beta float64) error {
+	if n < 0 || n != math.Floor(n) || alpha <= 0 || beta <= 0 {
+		return util.ErrBetaBinomialParam
+	}
+	b.N, b.Alpha, b.Beta = n, alpha, beta
+	return nil
+}
+
+// Generate creates one sample of the Beta-Binomial distribution
+func (b *BetaBinomial) Generate() float64 {
+	return b.Rand(b.rng())
+}
+
+// GenerateN creates n samples of the Beta-Binomial distribution
+func (b *BetaBinomial) GenerateN(n int) []float64 {
+	return GenerateN(b, b.rng(), n)
+}
+
+// Fill fills dst with samples of the Beta-Binomial distribution
+func (b *BetaBinomial) Fill(dst []float64) {
+	Fill(b, b.rng(), dst)
+}
+
+// Rand creates one sample of the Beta-Binomial distribution using the given generator
+// Algorithm: p = x / (x + y) with x ~ Γ(α, 1), y ~ Γ(β, 1) then k ~ B(n, p)
+//
+func (b *BetaBinomial) Rand(r *rand.Rand) float64 {
+	x, y := gammaRand(r, b.Alpha), gammaRand(r, b.Beta)
+	p := x / (x + y)
+	return newBinomialSampler(&Binomial{N: b.N, P: p, Q: 1 - p}).sample(r)
+}
+
+// Domain returns the definition domain of the distribution
+func (b *BetaBinomial) Domain() (float64, float64) {
+	return 0, b.N
+}
+
+// PMF returns the probability mass function value of a given k
+func (b *BetaBinomial) PMF(k float64) float64 {
+	return math.Exp(b.LogPMF(k))
+}
+
+// LogPMF returns the log of the probability mass function value of a given k
+func (b *BetaBinomial) LogPMF(k float64) float64 {
+	if k < 0 || k > b.N || k != math.Floor(k) {
+		return math.Inf(-1)
+	}
+	return specfun.LogChoose(b.N, k) + specfun.LogBeta(k+b.Alpha, b.N-k+b.Beta) - specfun.LogBeta(b.Alpha, b.Beta)
+}
+
+// pivot returns the value splitting the domain between the tails summed
+// by the cdf and the survival function
+func (b *BetaBinomial) pivot() float64 {
+	return math.Floor(b.Mean())
+}
+
+// CDF returns the Cumulative distribution function value of a given k
+// Algorithm: the tail holding k is summed from k, the other one being
+// obtained by complement
+// Complexity: O(n)
+//
+func (b *BetaBinomial) CDF(k float64) float64 {
+	if k < 0 {
+		return 0
+	}
+	if k >= b.N {
+		return 1
+	}
+	k = math.Floor(k)
+	if k < b.pivot() {
+		return math.Exp(logDiscreteTail(b.LogPMF, k, -1, 0))
+	}
+	return 1 - b.Survival(k)
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given k
+func (b *BetaBinomial) LogCDF(k float64) float64 {
+	if k < 0 {
+		return math.Inf(-1)
+	}
+	if k = math.Floor(k); k < b.pivot() {
+		return logDiscreteTail(b.LogPMF, k, -1, 0)
+	}
+	return math.Log1p(-b.Survival(k))
+}
+
+// Survival returns the survival function value of a given k
+func (b *BetaBinomial) Survival(k float64) float64 {
+	if k < 0 {
+		return 1
+	}
+	if k >= b.N {
+		return 0
+	}
+	k = math.Floor(k)
+	if k >= b.pivot() {
+		return math.Exp(logDiscreteTail(b.LogPMF, k+1, 1, b.N))
+	}
+	return 1 - b.CDF(k)
+}
+
+// LogSurvival returns the log of the survival function value of a given k
+func (b *BetaBinomial) LogSurvival(k float64) float64 {
+	if k < 0 {
+		return 0
+	}
+	if k >= b.N {
+		return math.Inf(-1)
+	}
+	if k = math.Floor(k); k >= b.pivot() {
+		return logDiscreteTail(b.LogPMF, k+1, 1, b.N)
+	}
+	return math.Log1p(-b.CDF(k))
+}
+
+// Quantile returns the p-th quantile of the distribution
+func (b *BetaBinomial) Quantile(p float64) float64 {
+	sd := math.Sqrt(b.Var())
+	return discreteQuantile(b.CDF, p, 0, b.N, cornishFisher(b.Mean(), sd, b.Skewness(), p))
+}
+
+// Mean returns the mean of the distribution
+func (b *BetaBinomial) Mean() float64 {
+	return b.N * b.Alpha / (b.Alpha + b.Beta)
+}
+
+// Median returns the median of the distribution
+func (b *BetaBinomial) Median() float64 {
+	return b.Quantile(.5)
+}
+
+// Var returns the variance of the distribution
+func (b *BetaBinomial) Var() float64 {
+	s := b.Alpha + b.Beta
+	return b.N * b.Alpha * b.Beta * (s + b.N) / (s * s * (s + 1))
+}
+
+// Skewness returns the Pearson's moment coefficient of skewness of the distribution
+func (b *BetaBinomial) Skewness() float64 {
+	n, a, c := b.N, b.Alpha, b.Beta
+	s := a + c
+	return (s + 2*n) * (c - a) / (s + 2) * math.Sqrt((1+s)/(n*a*c*(n+s)))
+}
+
+// Kurtosis returns the Kurtosis of the distribution
+func (b *BetaBinomial) Kurtosis() float64 {
+	n, a, c := b.N, b.Alpha, b.Beta
+	s, p := a+c, a*c
+	scale := s * s * (1 + s) / (n * p * (s + 2) * (s + 3) * (s + n))
+	return scale*(s*(s-1+6*n)+3*p*(n-2)+6*n*n-3*p*n*(6-n)/s-18*p*n*n/(s*s)) - 3
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (b *BetaBinomial) RawMoment(k int) float64 {
+	return RawMoment(b, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (b *BetaBinomial) CentralMoment(k int) float64 {
+	return CentralMoment(b, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (b *BetaBinomial) Cumulant(k int) float64 {
+	return Cumulant(b, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (b *BetaBinomial) CF(t float64) complex128 {
+	return CF(b, t)
+}
+
+// Summary returns the properties of the distribution
+func (b *BetaBinomial) Summary() *DistSummary {
+	return summarise(b, "betabinomial", Param{"n", b.N}, Param{"alpha", b.Alpha}, Param{"beta", b.Beta})
+}
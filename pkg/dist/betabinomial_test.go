@@ -0,0 +1,71 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestBetaBinomial(t *testing.T) {
+	dist := &BetaBinomial{}
+	if err := dist.Init(20, 2.5, 4); err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(dist.Summary())
+
+	// The pmf sums up to 1 and to the cdf, the moments match the closed forms
+	sum, m1, m2, m3, m4 := 0.0, 0.0, 0.0, 0.0, 0.0
+	for k := 0.0; k <= dist.N; k++ {
+		p := dist.PMF(k)
+		sum += p
+		if math.Abs(sum-dist.CDF(k)) > 1e-12 || math.Abs(1-sum-dist.Survival(k)) > 1e-12 {
+			t.Errorf("F(%g) = %g, S(%g) = %g, expected %g", k, dist.CDF(k), k, dist.Survival(k), sum)
+		}
+		m1 += k * p
+	}
+	for k := 0.0; k <= dist.N; k++ {
+		d := k - m1
+		m2 += d * d * dist.PMF(k)
+		m3 += d * d * d * dist.PMF(k)
+		m4 += d * d * d * d * dist.PMF(k)
+	}
+	testCases := []struct {
+		Name     string
+		Got      float64
+		Expected float64
+	}{
+		{"sum", sum, 1},
+		{"mean", dist.Mean(), m1},
+		{"variance", dist.Var(), m2},
+		{"skewness", dist.Skewness(), m3 / math.Pow(m2, 1.5)},
+		{"kurtosis", dist.Kurtosis(), m4/(m2*m2) - 3},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if math.Abs(tc.Got-tc.Expected) > 1e-10 {
+				t.Errorf("expected %g, got %g", tc.Expected, tc.Got)
+			}
+		})
+	}
+
+	// α = β = 1 is the discrete uniform distribution over [0, n]
+	uniform := &BetaBinomial{N: 10, Alpha: 1, Beta: 1}
+	for k := 0.0; k <= 10; k++ {
+		if p := uniform.PMF(k); math.Abs(p-1/11.) > 1e-14 {
+			t.Errorf("f(%g) = %g, expected %g", k, p, 1/11.)
+		}
+	}
+
+	samples := sample(dist, 50000, 1)
+	if math.Abs(samples.Mean()-dist.Mean()) > 4*math.Sqrt(dist.Var()/50000) {
+		t.Errorf("sample mean %f, expected %f", samples.Mean(), dist.Mean())
+	}
+	if q := dist.Quantile(dist.CDF(9)); q != 9 {
+		t.Errorf("Q(F(9)) = %g", q)
+	}
+	for _, params := range [][]float64{{-1, 1, 1}, {2.5, 1, 1}, {10, 0, 1}, {10, 1, -1}} {
+		if err := dist.Init(params[0], params[1], params[2]); err == nil {
+			t.Errorf("expected an error for %v", params)
+		}
+	}
+}
@@ -1,13 +1,14 @@
 package dist
 
 import (
-	"fmt"
 	"math"
 	"math/big"
+	"math/cmplx"
 	"math/rand"
 
+	"github.com/ichbinfrog/statistics/pkg/array"
+	"github.com/ichbinfrog/statistics/pkg/specfun"
 	"github.com/ichbinfrog/statistics/pkg/util"
-	"gonum.org/v1/gonum/mathext"
 )
 
 // Binomial represents the Binomial distribution
@@ -18,99 +19,228 @@ import (
 //		}, k in [0, ..., n]
 //
 type Binomial struct {
+	source
 	N, P, Q float64
 }
 
-// Generate creates one sample of the Bernoulli distribution
+// Generate creates one sample of the Binomial distribution
 func (b *Binomial) Generate() float64 {
-	// PRESS, William H., TEUKOLSKY, Saul A., VETTERLING, William T., et al. Numerical recipes in C. 1988.
-	p := b.P
+	return b.Rand(b.rng())
+}
+
+// GenerateN creates n samples of the Binomial distribution
+func (b *Binomial) GenerateN(n int) []float64 {
+	return GenerateN(b, b.rng(), n)
+}
+
+// Fill fills dst with samples of the Binomial distribution
+func (b *Binomial) Fill(dst []float64) {
+	Fill(b, b.rng(), dst)
+}
+
+// binomialSampler holds the constants of the Binomial sampler which only
+// depend on the parameters of the distribution, the samples being drawn
+// with the probability r = min(p, q) then flipped when p > 0.5
+type binomialSampler struct {
+	n, r, q            float64
+	flip               bool
+	qn, bound          float64
+	nrq, m, xm, xl, xr float64
+	c, laml, lamr      float64
+	p1, p2, p3, p4     float64
+}
+
+// newBinomialSampler precomputes the constants of the Binomial sampler
+func newBinomialSampler(b *Binomial) *binomialSampler {
+	s := &binomialSampler{n: b.N, r: b.P}
 	if b.P > .5 {
-		p = b.Q
+		s.r, s.flip = 1-b.P, true
+	}
+	s.q = 1 - s.r
+	s.nrq = s.n * s.r * s.q
+	if s.n*s.r < 30 {
+		s.qn = math.Exp(s.n * math.Log1p(-s.r))
+		s.bound = math.Min(s.n, s.n*s.r+10*math.Sqrt(s.nrq+1))
+		return s
 	}
 
-	// Direct method
-	if b.N < 25 {
-		bnl := 0.0
-		for i := 1; i <= int(b.N); i++ {
-			if rand.Float64() < p {
-				bnl++
-			}
-		}
-		if p != b.P {
-			return b.N - bnl
-		}
-		return bnl
-	}
-
-	am := b.N * p
-	// Direct Poisson method
-	if am < 1.0 {
-		g := math.Exp(-am)
-		t := 1.0
-		i := 0.0
-		for {
-			t *= rand.Float64()
-			if t < g || i >= b.N {
-				if p != b.P {
-					return b.N - i
-				}
-				return i
-			}
-			i++
+	fm := s.n*s.r + s.r
+	s.m = math.Floor(fm)
+	s.p1 = math.Floor(2.195*math.Sqrt(s.nrq)-4.6*s.q) + .5
+	s.xm = s.m + .5
+	s.xl = s.xm - s.p1
+	s.xr = s.xm + s.p1
+	s.c = .134 + 20.5/(15.3+s.m)
+	a := (fm - s.xl) / (fm - s.xl*s.r)
+	s.laml = a * (1 + a/2)
+	a = (s.xr - fm) / (s.xr * s.q)
+	s.lamr = a * (1 + a/2)
+	s.p2 = s.p1 * (1 + 2*s.c)
+	s.p3 = s.p2 + s.c/s.laml
+	s.p4 = s.p3 + s.c/s.lamr
+	return s
+}
+
+// sample creates one sample of the Binomial distribution
+// Algorithm:
+//		nr < 30 : inversion, walking up the cdf from P(X = 0) = q^n
+//		otherwise : BTPE, rejection from a triangle, two parallelograms and
+//		two exponential tails, accepted by squeezes or Stirling's formula
+//
+// KACHITVICHYANUKUL, Voratas, SCHMEISER, Bruce W. Binomial random variate generation. 1988.
+// Complexity: O(nr) for nr < 30, O(1) otherwise
+//
+func (s *binomialSampler) sample(r *rand.Rand) float64 {
+	if s.r == 0 {
+		return s.result(0)
+	}
+	if s.n*s.r < 30 {
+		return s.result(s.inversion(r))
+	}
+	return s.result(s.btpe(r))
+}
+
+// result returns the number of successes from the number drawn with
+// the probability min(p, q)
+func (s *binomialSampler) result(k float64) float64 {
+	if s.flip {
+		return s.n - k
+	}
+	return k
+}
+
+// inversion draws a sample by sequential search of the cdf, restarting
+// when the search goes past the point where the mass becomes negligible
+func (s *binomialSampler) inversion(r *rand.Rand) float64 {
+	x, px, u := 0.0, s.qn, r.Float64()
+	for u > px {
+		x++
+		if x > s.bound {
+			x, px, u = 0, s.qn, r.Float64()
+			continue
 		}
+		u -= px
+		px *= (s.n - x + 1) * s.r / (x * s.q)
 	}
+	return x
+}
 
-	// Rejection method
-	sq := math.Sqrt(2.0 * am * p)
-	oldg, _ := math.Lgamma(b.N + 1.0)
-	plog := math.Log(p)
-	pclog := math.Log(1.0 - p)
+// stirling returns the correction term of Stirling's formula for log(x!)
+// divided by x, as used by the final acceptance test of BTPE
+func stirling(x float64) float64 {
+	x2 := x * x
+	return (13860 - (462-(132-(99-140/x2)/x2)/x2)/x2) / x / 166320
+}
 
+// btpe draws a sample with the BTPE algorithm
+func (s *binomialSampler) btpe(r *rand.Rand) float64 {
 	for {
-		var y, em float64
-		for {
-			y = math.Tan(math.Pi * rand.Float64())
-			em = sq*y + am
-			if em >= 0.0 && em < b.N+1 {
-				break
+		u := r.Float64() * s.p4
+		v := r.Float64()
+
+		var y float64
+		switch {
+		case u <= s.p1:
+			// Triangular region, accepted immediately
+			return math.Floor(s.xm - s.p1*v + u)
+		case u <= s.p2:
+			// Parallelograms
+			x := s.xl + (u-s.p1)/s.c
+			v = v*s.c + 1 - math.Abs(s.m-x+.5)/s.p1
+			if v > 1 {
+				continue
 			}
+			y = math.Floor(x)
+		case u <= s.p3:
+			// Left exponential tail
+			y = math.Floor(s.xl + math.Log(v)/s.laml)
+			if y < 0 {
+				continue
+			}
+			v *= (u - s.p2) * s.laml
+		default:
+			// Right exponential tail
+			y = math.Floor(s.xr - math.Log(v)/s.lamr)
+			if y > s.n {
+				continue
+			}
+			v *= (u - s.p3) * s.lamr
 		}
-		em = math.Floor(em)
-		lg1, _ := math.Lgamma(em + 1.0)
-		lg2, _ := math.Lgamma(b.N - em + 1.0)
-		t := 1.2 * math.Sqrt(1.0+math.Pow(y, 2)) * math.Exp(oldg-lg1-lg2+em*plog+(b.N-em)*pclog)
-
-		if rand.Float64() <= t {
-			if p != b.P {
-				return b.N - em
+
+		k := math.Abs(y - s.m)
+		if k <= 20 || k >= s.nrq/2-1 {
+			// Explicit evaluation of f(y) / f(m) by recursion
+			sr := s.r / s.q
+			a := sr * (s.n + 1)
+			f := 1.0
+			for i := s.m + 1; i <= y; i++ {
+				f *= a/i - sr
+			}
+			for i := y + 1; i <= s.m; i++ {
+				f /= a/i - sr
 			}
-			return em
+			if v <= f {
+				return y
+			}
+			continue
+		}
+
+		// Squeezes on log(f(y) / f(m))
+		rho := (k / s.nrq) * ((k*(k/3+.625)+.1666666666666)/s.nrq + .5)
+		t := -k * k / (2 * s.nrq)
+		lv := math.Log(v)
+		if lv < t-rho {
+			return y
+		}
+		if lv > t+rho {
+			continue
+		}
+
+		// Final acceptance with Stirling's formula
+		x1, f1 := y+1, s.m+1
+		z, w := s.n+1-s.m, s.n-y+1
+		bound := s.xm*math.Log(f1/x1) + (s.n-s.m+.5)*math.Log(z/w) + (y-s.m)*math.Log(w*s.r/(x1*s.q)) +
+			stirling(f1) + stirling(z) + stirling(x1) + stirling(w)
+		if lv <= bound {
+			return y
 		}
 	}
 }
 
-// BinomialCoeff computes the binomial coeff with the given n, k
-func BinomialCoeff(n, k int) int64 {
-	if k == 1 || n == k {
-		return 1
-	}
-	r1, r2, r3 := big.NewInt(1), big.NewInt(1), big.NewInt(1)
+// Rand creates one sample of the Binomial distribution using the given generator
+func (b *Binomial) Rand(r *rand.Rand) float64 {
+	return newBinomialSampler(b).sample(r)
+}
 
-	for i := 2; i <= n; i++ {
-		r1.Mul(r1, big.NewInt(int64(i)))
-		if i == n-k && r2.Cmp(big.NewInt(0)) != 0 {
-			r2 = r2.Set(r1)
-		}
-		if i == k && r3.Cmp(big.NewInt(0)) != 0 {
-			r3 = r3.Set(r1)
-		}
+// fill fills dst with samples of the Binomial distribution, sharing the
+// constants of the sampler
+func (b *Binomial) fill(r *rand.Rand, dst []float64) {
+	s := newBinomialSampler(b)
+	for i := range dst {
+		dst[i] = s.sample(r)
 	}
+}
 
-	return r1.Div(r1, r2.Mul(r2, r3)).Int64()
+// BinomialCoeff returns the binomial coefficient C(n, k), or math.MaxInt64
+// when it does not fit in an int64
+//
+// Deprecated: use specfun.Choose for the exact value or specfun.LogChoose.
+func BinomialCoeff(n, k int) int64 {
+	return saturate(specfun.Choose(n, k))
 }
 
-// Init intialises a Bernoulli distribution
+// saturate returns v as an int64, math.MaxInt64 when it overflows, or 0 when nil
+func saturate(v *big.Int) int64 {
+	if v == nil {
+		return 0
+	}
+	if !v.IsInt64() {
+		return math.MaxInt64
+	}
+	return v.Int64()
+}
+
+// Init intialises a Binomial distribution
 func (b *Binomial) Init(n, p float64) error {
 	if p < 0 || p > 1 || n <= 0 {
 		return util.ErrBinomialParam
@@ -126,7 +256,15 @@ func (b *Binomial) Domain() (float64, float64) {
 
 // PMF returns the probability mass function value of a given k
 func (b *Binomial) PMF(k float64) float64 {
-	return float64(BinomialCoeff(int(b.N), int(k))) * math.Pow(b.P, k) * math.Pow(b.Q, float64(b.N)-k)
+	return math.Exp(b.LogPMF(k))
+}
+
+// LogPMF returns the log of the probability mass function value of a given k
+func (b *Binomial) LogPMF(k float64) float64 {
+	if k < 0 || k > b.N || k != math.Floor(k) {
+		return math.Inf(-1)
+	}
+	return specfun.LogChoose(b.N, k) + xlogy(k, b.P) + xlogy(b.N-k, b.Q)
 }
 
 // CDF returns the Cumulative distribution function value of a given k
@@ -138,7 +276,56 @@ func (b *Binomial) CDF(k float64) float64 {
 		return 1
 	}
 	k = math.Floor(k)
-	return mathext.RegIncBeta(b.N-k, k+1, b.Q)
+	return specfun.RegIncBeta(b.N-k, k+1, b.Q)
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given k
+func (b *Binomial) LogCDF(k float64) float64 {
+	if k < 0 {
+		return math.Inf(-1)
+	}
+	if k >= b.N {
+		return 0
+	}
+	k = math.Floor(k)
+	if v := b.CDF(k); v > 0 {
+		return math.Log(v)
+	}
+	return logDiscreteTail(b.LogPMF, k, -1, 0)
+}
+
+// Survival returns the survival function value of a given k
+func (b *Binomial) Survival(k float64) float64 {
+	if k < 0 {
+		return 1
+	}
+	if k >= b.N {
+		return 0
+	}
+	k = math.Floor(k)
+	return specfun.RegIncBeta(k+1, b.N-k, b.P)
+}
+
+// LogSurvival returns the log of the survival function value of a given k
+func (b *Binomial) LogSurvival(k float64) float64 {
+	if k < 0 {
+		return 0
+	}
+	if k >= b.N {
+		return math.Inf(-1)
+	}
+	k = math.Floor(k)
+	if v := b.Survival(k); v > 0 {
+		return math.Log(v)
+	}
+	return logDiscreteTail(b.LogPMF, k+1, 1, b.N)
+}
+
+// Quantile returns the p-th quantile of the distribution
+func (b *Binomial) Quantile(p float64) float64 {
+	dbeg, dend := b.Domain()
+	sd := math.Sqrt(b.Var())
+	return discreteQuantile(b.CDF, p, dbeg, dend, cornishFisher(b.Mean(), sd, (b.Q-b.P)/sd, p))
 }
 
 // Mean returns the mean of the distribution
@@ -174,23 +361,68 @@ func (b *Binomial) Moment(t float64) float64 {
 	return math.Pow(b.Q+b.P*math.Exp(t), float64(b.N))
 }
 
+// cf returns the characteristic function of the distribution at t
+//		φ(t) = (q + p exp(it))^n
+//
+func (b *Binomial) cf(t float64) complex128 {
+	return cmplx.Pow(complex(b.Q, 0)+complex(b.P, 0)*expi(t), complex(b.N, 0))
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (b *Binomial) RawMoment(k int) float64 {
+	return RawMoment(b, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (b *Binomial) CentralMoment(k int) float64 {
+	return CentralMoment(b, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (b *Binomial) Cumulant(k int) float64 {
+	return Cumulant(b, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (b *Binomial) CF(t float64) complex128 {
+	return CF(b, t)
+}
+
 // FisherI returns the Fisher Information of the distribution
-func (b *Binomial) FisherI() float64 {
-	return float64(b.N) / (b.P * b.Q)
+func (b *Binomial) FisherI() [][]float64 {
+	return [][]float64{
+		[]float64{b.N / (b.P * b.Q)},
+	}
 }
 
-// Summary returns a string summarising basic info about the distribution
-func (b *Binomial) Summary() string {
-	dbeg, dend := b.Domain()
-	return fmt.Sprintf(`
-	X ~ B(%f, %f)
-		Domain:			{ %f , %f }
-		Mean: 			%f
-		Median (upper): %f
-		Median (lower): %f
-		Var: 			%f
-		Skewness: 		%f
-		Kurtosis:		%f
-		FisherInfo:		%f
-`, b.N, b.P, dbeg, dend, b.Mean(), b.Median(true), b.Median(false), b.Var(), b.Skewness(), b.Kurtosis(), b.FisherI())
+// Summary returns the properties of the distribution
+func (b *Binomial) Summary() *DistSummary {
+	return summarise(b, "binomial", Param{"n", b.N}, Param{"p", b.P})
+}
+
+// FitBinomial returns the maximum likelihood estimation of a Binomial
+// distribution with a known number of trials from the given observations:
+//		p = E[X] / n
+//
+// Complexity: O(n)
+//
+func FitBinomial(a *array.Arrayf64, trials float64) (*Binomial, *FitResult, error) {
+	if err := checkSample(a, 1, 0, trials, true); err != nil {
+		return nil, nil, err
+	}
+	mean, _ := sampleMoments(a)
+
+	b := &Binomial{}
+	if err := b.Init(trials, mean/trials); err != nil {
+		return nil, nil, err
+	}
+	ll := a.Length * (xlogy(mean, b.P) + xlogy(trials-mean, b.Q))
+	for _, v := range a.Data {
+		ll += specfun.LogChoose(trials, v)
+	}
+	return b, &FitResult{
+		LogLikelihood: ll,
+		StdErr:        fisherStdErr(b.FisherI(), a.Length),
+		Observations:  a.Length,
+	}, nil
 }
@@ -2,7 +2,11 @@ package dist
 
 import (
 	"fmt"
+	"math"
+	"math/rand"
 	"testing"
+
+	"github.com/ichbinfrog/statistics/pkg/array"
 )
 
 func TestBinomial(t *testing.T) {
@@ -10,9 +14,9 @@ func TestBinomial(t *testing.T) {
 		Method string
 		N, P   float64
 	}{
-		{"direct", 24, .3},
-		{"direct_poisson", 25, .02},
-		{"rejection", 100, .4},
+		{"inversion", 24, .3},
+		{"inversion_small_p", 25, .02},
+		{"btpe", 100, .4},
 	}
 	for _, tc := range testCases {
 		dist := &Binomial{}
@@ -32,3 +36,153 @@ func TestBinomial(t *testing.T) {
 		})
 	}
 }
+
+func TestBinomialRand(t *testing.T) {
+	testCases := []struct {
+		Name string
+		N, P float64
+	}{
+		{"inversion", 24, .3},
+		{"inversion small p", 2000, .005},
+		{"inversion flipped", 40, .9},
+		{"btpe", 100, .4},
+		{"btpe flipped", 1000, .93},
+		{"btpe large", 20000, .5},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			dist := &Binomial{}
+			dist.Init(tc.N, tc.P)
+			r := rand.New(rand.NewSource(3))
+			a := &array.Arrayf64{}
+			a.Init(array.Optionf64{})
+			a.InsertSlice(GenerateN(dist, r, 20000))
+			if _, p := ChiSquare(a, dist, 0); p < 1e-3 {
+				t.Errorf("samples do not follow B(%g, %g), p-value %g", tc.N, tc.P, p)
+			}
+		})
+	}
+}
+
+// previousBinomialSampler is the Numerical Recipes sampler replaced by
+// BTPE, kept verbatim as a benchmark baseline. Its rejection step uses the
+// envelope √(2np) and 1.2√(1+y^2) instead of √(2np(1-p)) and
+// 1.2√(2np(1-p))(1+y^2), so its samples are biased for n >= 25 and np >= 1.
+type previousBinomialSampler struct {
+	n, p        float64
+	flip        bool
+	am, g       float64
+	sq, oldg    float64
+	plog, pclog float64
+}
+
+func newPreviousBinomialSampler(b *Binomial) *previousBinomialSampler {
+	s := &previousBinomialSampler{n: b.N, p: b.P}
+	if b.P > .5 {
+		s.p, s.flip = b.Q, true
+	}
+	s.am = s.n * s.p
+	s.g = math.Exp(-s.am)
+	s.sq = math.Sqrt(2.0 * s.am * s.p)
+	s.oldg, _ = math.Lgamma(s.n + 1.0)
+	s.plog = math.Log(s.p)
+	s.pclog = math.Log(1.0 - s.p)
+	return s
+}
+
+func (s *previousBinomialSampler) result(k float64) float64 {
+	if s.flip {
+		return s.n - k
+	}
+	return k
+}
+
+func (s *previousBinomialSampler) sample(r *rand.Rand) float64 {
+	// Direct method
+	if s.n < 25 {
+		bnl := 0.0
+		for i := 1; i <= int(s.n); i++ {
+			if r.Float64() < s.p {
+				bnl++
+			}
+		}
+		return s.result(bnl)
+	}
+
+	// Direct Poisson method
+	if s.am < 1.0 {
+		t := 1.0
+		i := 0.0
+		for {
+			t *= r.Float64()
+			if t < s.g || i >= s.n {
+				return s.result(i)
+			}
+			i++
+		}
+	}
+
+	// Rejection method
+	for {
+		var y, em float64
+		for {
+			y = math.Tan(math.Pi * r.Float64())
+			em = s.sq*y + s.am
+			if em >= 0.0 && em < s.n+1 {
+				break
+			}
+		}
+		em = math.Floor(em)
+		lg1, _ := math.Lgamma(em + 1.0)
+		lg2, _ := math.Lgamma(s.n - em + 1.0)
+		t := 1.2 * math.Sqrt(1.0+math.Pow(y, 2)) * math.Exp(s.oldg-lg1-lg2+em*s.plog+(s.n-em)*s.pclog)
+
+		if r.Float64() <= t {
+			return s.result(em)
+		}
+	}
+}
+
+func BenchmarkBinomialRand(b *testing.B) {
+	for _, n := range []float64{20, 100, 10000} {
+		dist := &Binomial{}
+		dist.Init(n, .4)
+		dst := make([]float64, 1000)
+		b.Run(fmt.Sprintf("btpe/n=%g", n), func(b *testing.B) {
+			r := rand.New(rand.NewSource(1))
+			for i := 0; i < b.N; i++ {
+				dist.fill(r, dst)
+			}
+		})
+		b.Run(fmt.Sprintf("previous/n=%g", n), func(b *testing.B) {
+			r := rand.New(rand.NewSource(1))
+			s := newPreviousBinomialSampler(dist)
+			for i := 0; i < b.N; i++ {
+				for j := range dst {
+					dst[j] = s.sample(r)
+				}
+			}
+		})
+	}
+}
+
+func TestBinomialCoeff(t *testing.T) {
+	testCases := []struct {
+		N, K     int
+		Expected int64
+	}{
+		{10, 1, 10},
+		{10, 0, 1},
+		{10, 9, 10},
+		{10, 10, 1},
+		{52, 5, 2598960},
+		{66, 33, 7219428434016265740},
+		// Overflows int64
+		{100, 50, math.MaxInt64},
+	}
+	for _, tc := range testCases {
+		if v := BinomialCoeff(tc.N, tc.K); v != tc.Expected {
+			t.Errorf("C(%d, %d) = %d, expected %d", tc.N, tc.K, v, tc.Expected)
+		}
+	}
+}
@@ -0,0 +1,277 @@
+package dist
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// Categorical represents the Categorical distribution over the indices
+// of a set of weights. It must be built with Init, which computes the
+// tables used for the cdf and the sampling.
+// Discreet probability distribution function as follows:
+//		X ~ Cat(p_0, ..., p_(m-1)), Σ p_i = 1
+//		f(k,p) = {
+//			p_k
+//		}, k in [0, ..., m - 1]
+//
+type Categorical struct {
+	source
+	// P holds the normalised weights of each category
+	P []float64
+
+	// cumulative holds the running sums of P for the cdf
+	cumulative []float64
+	// prob and alias are the tables of Walker's alias method
+	prob  []float64
+	alias []int
+}
+
+// Init intialises a Categorical distribution from non negative weights,
+// which are normalised to sum up to 1
+// Algorithm: Vose's construction of the alias tables
+//		Scale the probabilities by m and split them between small (< 1)
+//		and large (>= 1) ones
+//		Pair each small category with a large one donating the missing
+//		mass, the large one going back to the small list if it falls below 1
+//
+// VOSE, Michael D. A linear algorithm for generating random numbers with a given distribution. 1991.
+// Complexity: O(m)
+//
+func (c *Categorical) Init(weights []float64) error {
+	total := 0.0
+	for _, w := range weights {
+		if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return util.ErrCategoricalParam
+		}
+		total += w
+	}
+	if total <= 0 {
+		return util.ErrCategoricalParam
+	}
+
+	m := len(weights)
+	c.P = make([]float64, m)
+	c.cumulative = make([]float64, m)
+	c.prob = make([]float64, m)
+	c.alias = make([]int, m)
+
+	scaled := make([]float64, m)
+	small, large := []int{}, []int{}
+	sum := 0.0
+	for i, w := range weights {
+		c.P[i] = w / total
+		sum += c.P[i]
+		// Rounding errors of long sums can exceed 1
+		c.cumulative[i] = math.Min(sum, 1)
+		scaled[i] = c.P[i] * float64(m)
+		if scaled[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+	c.cumulative[m-1] = 1
+
+	for len(small) > 0 && len(large) > 0 {
+		s, l := small[len(small)-1], large[len(large)-1]
+		small = small[:len(small)-1]
+		c.prob[s], c.alias[s] = scaled[s], l
+		scaled[l] -= 1 - scaled[s]
+		if scaled[l] < 1 {
+			large = large[:len(large)-1]
+			small = append(small, l)
+		}
+	}
+	// Remaining categories are only left over because of rounding errors
+	for _, i := range append(small, large...) {
+		c.prob[i], c.alias[i] = 1, i
+	}
+	return nil
+}
+
+// Generate creates one sample of the Categorical distribution
+func (c *Categorical) Generate() float64 {
+	return c.Rand(c.rng())
+}
+
+// GenerateN creates n samples of the Categorical distribution
+func (c *Categorical) GenerateN(n int) []float64 {
+	return GenerateN(c, c.rng(), n)
+}
+
+// Fill fills dst with samples of the Categorical distribution
+func (c *Categorical) Fill(dst []float64) {
+	Fill(c, c.rng(), dst)
+}
+
+// Rand creates one sample of the Categorical distribution using the given generator
+// Algorithm: Walker's alias method
+//		Pick a category i uniformly, keep it with probability prob[i]
+//		and return its alias otherwise
+//
+// WALKER, Alastair J. An efficient method for generating discrete random variables with general distributions. 1977.
+// Complexity: O(1)
+//
+func (c *Categorical) Rand(r *rand.Rand) float64 {
+	if len(c.prob) == 0 {
+		return math.NaN()
+	}
+	i := r.Intn(len(c.prob))
+	if r.Float64() < c.prob[i] {
+		return float64(i)
+	}
+	return float64(c.alias[i])
+}
+
+// Domain returns the definition domain of the distribution
+func (c *Categorical) Domain() (float64, float64) {
+	return 0, float64(len(c.P) - 1)
+}
+
+// PMF returns the probability mass function value of a given k
+func (c *Categorical) PMF(k float64) float64 {
+	if k < 0 || k >= float64(len(c.P)) || k != math.Floor(k) {
+		return 0
+	}
+	return c.P[int(k)]
+}
+
+// LogPMF returns the log of the probability mass function value of a given k
+func (c *Categorical) LogPMF(k float64) float64 {
+	return math.Log(c.PMF(k))
+}
+
+// CDF returns the Cumulative distribution function value of a given k
+// Complexity: O(1)
+//
+func (c *Categorical) CDF(k float64) float64 {
+	if k < 0 {
+		return 0
+	}
+	if k >= float64(len(c.P)-1) {
+		return 1
+	}
+	return c.cumulative[int(k)]
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given k
+func (c *Categorical) LogCDF(k float64) float64 {
+	return math.Log(c.CDF(k))
+}
+
+// Survival returns the survival function value of a given k
+func (c *Categorical) Survival(k float64) float64 {
+	return 1 - c.CDF(k)
+}
+
+// LogSurvival returns the log of the survival function value of a given k
+func (c *Categorical) LogSurvival(k float64) float64 {
+	return math.Log(c.Survival(k))
+}
+
+// Quantile returns the p-th quantile of the distribution
+// Complexity: O(log(m))
+//
+func (c *Categorical) Quantile(p float64) float64 {
+	dbeg, dend := c.Domain()
+	return discreteQuantile(c.CDF, p, dbeg, dend, math.NaN())
+}
+
+// Mean returns the mean of the distribution
+func (c *Categorical) Mean() float64 {
+	return c.rawMoment(1)
+}
+
+// Median returns the median of the distribution
+func (c *Categorical) Median() float64 {
+	return c.Quantile(.5)
+}
+
+// rawMoment returns E[X^n]
+func (c *Categorical) rawMoment(n int) float64 {
+	sum := 0.0
+	for k, p := range c.P {
+		sum += p * math.Pow(float64(k), float64(n))
+	}
+	return sum
+}
+
+// centralMoment returns E[(X - E[X])^n]
+func (c *Categorical) centralMoment(n int) float64 {
+	mean, sum := c.Mean(), 0.0
+	for k, p := range c.P {
+		sum += p * math.Pow(float64(k)-mean, float64(n))
+	}
+	return sum
+}
+
+// Var returns the variance of the distribution
+func (c *Categorical) Var() float64 {
+	return c.centralMoment(2)
+}
+
+// Skewness returns the Pearson's moment coefficient of skewness of the distribution
+func (c *Categorical) Skewness() float64 {
+	return c.centralMoment(3) / math.Pow(c.Var(), 1.5)
+}
+
+// Kurtosis returns the Kurtosis of the distribution
+func (c *Categorical) Kurtosis() float64 {
+	return c.centralMoment(4)/math.Pow(c.Var(), 2) - 3
+}
+
+// Entropy returns the Entropy of the distribution
+func (c *Categorical) Entropy() float64 {
+	sum := 0.0
+	for _, p := range c.P {
+		sum -= xlogy(p, p)
+	}
+	return sum
+}
+
+// Moment returns the t-th moment of the distribution
+func (c *Categorical) Moment(t float64) float64 {
+	sum := 0.0
+	for k, p := range c.P {
+		sum += p * math.Exp(t*float64(k))
+	}
+	return sum
+}
+
+// cf returns the characteristic function of the distribution at t
+//		φ(t) = Σ p_k exp(itk)
+//
+func (c *Categorical) cf(t float64) complex128 {
+	var sum complex128
+	for k, p := range c.P {
+		sum += complex(p, 0) * expi(t*float64(k))
+	}
+	return sum
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (c *Categorical) RawMoment(k int) float64 {
+	return RawMoment(c, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (c *Categorical) CentralMoment(k int) float64 {
+	return CentralMoment(c, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (c *Categorical) Cumulant(k int) float64 {
+	return Cumulant(c, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (c *Categorical) CF(t float64) complex128 {
+	return CF(c, t)
+}
+
+// Summary returns the properties of the distribution
+func (c *Categorical) Summary() *DistSummary {
+	return summarise(c, "categorical", vectorParams("p", c.P)...)
+}
@@ -0,0 +1,64 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// categorical returns a Categorical distribution of the given weights
+func categorical(weights []float64) *Categorical {
+	c := &Categorical{}
+	c.Init(weights)
+	return c
+}
+
+func TestCategorical(t *testing.T) {
+	weights := []float64{1, 5, 0, 2, 2, 0, 9.999, 1e-3}
+	dist := categorical(weights)
+	fmt.Println(dist.Summary())
+
+	// Every category is sampled with its own probability, including the
+	// empty and tiny ones
+	r := rand.New(rand.NewSource(1))
+	n := 200000
+	counts := make([]float64, len(weights))
+	for i := 0; i < n; i++ {
+		counts[int(dist.Rand(r))]++
+	}
+	for k, c := range counts {
+		p := dist.PMF(float64(k))
+		if sd := math.Sqrt(float64(n) * p * (1 - p)); math.Abs(c-float64(n)*p) > 5*sd+1e-9 {
+			t.Errorf("category %d sampled %f times, expected %f", k, c, float64(n)*p)
+		}
+	}
+
+	if v := dist.CDF(2.5); math.Abs(v-.3) > 1e-12 {
+		t.Errorf("F(2.5) = %f, expected .3", v)
+	}
+	if v := dist.Quantile(.3); v != 1 {
+		t.Errorf("Q(.3) = %f, expected 1", v)
+	}
+	if v := dist.Moment(1); math.Abs(v-expectedMgf(dist, 1)) > 1e-12 {
+		t.Errorf("Mx(1) = %f", v)
+	}
+
+	for _, w := range [][]float64{{}, {0, 0}, {1, -1}, {math.NaN()}} {
+		if err := (&Categorical{}).Init(w); err != util.ErrCategoricalParam {
+			t.Errorf("weights %v: expected %v, got %v", w, util.ErrCategoricalParam, err)
+		}
+	}
+}
+
+// expectedMgf returns E[exp(tX)] by summing over the domain of a discrete distribution
+func expectedMgf(d Discrete, t float64) float64 {
+	dbeg, dend := d.Domain()
+	sum := 0.0
+	for k := dbeg; k <= dend; k++ {
+		sum += d.PMF(k) * math.Exp(t*k)
+	}
+	return sum
+}
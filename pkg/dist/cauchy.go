@@ -0,0 +1,192 @@
+package dist
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand"
+
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// Cauchy represents the Cauchy distribution with location μ and scale σ
+// Continuous probability distribution function as follows:
+//		X ~ C(μ, σ), σ > 0
+//
+//		f(x,μ,σ) = 1 / (πσ(1 + ((x-μ)/σ)^2))
+//
+type Cauchy struct {
+	source
+	Mu, Sigma float64
+}
+
+// Init intialises a Cauchy distribution
+func (c *Cauchy) Init(mu, sigma float64) error {
+	if sigma <= 0 {
+		return util.ErrCauchyParam
+	}
+	c.Mu, c.Sigma = mu, sigma
+	return nil
+}
+
+// Generate creates one sample of the Cauchy distribution
+func (c *Cauchy) Generate() float64 {
+	return c.Rand(c.rng())
+}
+
+// GenerateN creates n samples of the Cauchy distribution
+func (c *Cauchy) GenerateN(n int) []float64 {
+	return GenerateN(c, c.rng(), n)
+}
+
+// Fill fills dst with samples of the Cauchy distribution
+func (c *Cauchy) Fill(dst []float64) {
+	Fill(c, c.rng(), dst)
+}
+
+// Rand creates one sample of the Cauchy distribution using the given generator
+func (c *Cauchy) Rand(r *rand.Rand) float64 {
+	return c.Quantile(r.Float64())
+}
+
+// Domain returns the definition domain of the distribution
+func (c *Cauchy) Domain() (float64, float64) {
+	return math.Inf(-1), math.Inf(0)
+}
+
+// PDF returns the probability density function value of a given x
+func (c *Cauchy) PDF(x float64) float64 {
+	z := (x - c.Mu) / c.Sigma
+	return 1 / (math.Pi * c.Sigma * (1 + z*z))
+}
+
+// LogPDF returns the log of the probability density function value of a given x
+func (c *Cauchy) LogPDF(x float64) float64 {
+	z := (x - c.Mu) / c.Sigma
+	return -math.Log(math.Pi*c.Sigma) - math.Log1p(z*z)
+}
+
+// CDF returns the Cumulative distribution function value of a given x
+// Algorithm:
+//		F(x) = 1/2 + atan(z)/π, z = (x-μ)/σ
+//		F(x) = atan(-1/z)/π when z < -1 to keep the lower tail accurate
+//
+func (c *Cauchy) CDF(x float64) float64 {
+	z := (x - c.Mu) / c.Sigma
+	if z < -1 {
+		return math.Atan(-1/z) / math.Pi
+	}
+	return .5 + math.Atan(z)/math.Pi
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given x
+func (c *Cauchy) LogCDF(x float64) float64 {
+	return math.Log(c.CDF(x))
+}
+
+// Survival returns the survival function value of a given x
+func (c *Cauchy) Survival(x float64) float64 {
+	return c.CDF(2*c.Mu - x)
+}
+
+// LogSurvival returns the log of the survival function value of a given x
+func (c *Cauchy) LogSurvival(x float64) float64 {
+	return math.Log(c.Survival(x))
+}
+
+// Quantile returns the p-th quantile of the distribution
+func (c *Cauchy) Quantile(p float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	return c.Mu + c.Sigma*math.Tan(math.Pi*(p-.5))
+}
+
+// Mean returns the mean of the distribution, which is undefined
+func (c *Cauchy) Mean() float64 {
+	return math.NaN()
+}
+
+// Median returns the median of the distribution
+func (c *Cauchy) Median() float64 {
+	return c.Mu
+}
+
+// Var returns the variance of the distribution, which is undefined
+func (c *Cauchy) Var() float64 {
+	return math.NaN()
+}
+
+// Skewness returns the Pearson's moment coefficient of skewness of the
+// distribution, which is undefined
+func (c *Cauchy) Skewness() float64 {
+	return math.NaN()
+}
+
+// Kurtosis returns the Kurtosis of the distribution, which is undefined
+func (c *Cauchy) Kurtosis() float64 {
+	return math.NaN()
+}
+
+// Entropy returns the Entropy of the distribution
+func (c *Cauchy) Entropy() float64 {
+	return math.Log(4 * math.Pi * c.Sigma)
+}
+
+// Moment returns the t-th moment of the distribution, the moment
+// generating function of the Cauchy distribution only exists at 0
+func (c *Cauchy) Moment(t float64) float64 {
+	if t == 0 {
+		return 1
+	}
+	return math.NaN()
+}
+
+// rawMoment returns NaN, the moments of the Cauchy distribution being undefined
+func (c *Cauchy) rawMoment(k int) float64 {
+	return math.NaN()
+}
+
+// cumulant returns NaN, the cumulants of the Cauchy distribution being undefined
+func (c *Cauchy) cumulant(k int) float64 {
+	return math.NaN()
+}
+
+// cf returns the characteristic function of the distribution at t
+//		φ(t) = exp(iμt - σ|t|)
+//
+func (c *Cauchy) cf(t float64) complex128 {
+	return cmplx.Exp(complex(-c.Sigma*math.Abs(t), c.Mu*t))
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (c *Cauchy) RawMoment(k int) float64 {
+	return RawMoment(c, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (c *Cauchy) CentralMoment(k int) float64 {
+	return CentralMoment(c, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (c *Cauchy) Cumulant(k int) float64 {
+	return Cumulant(c, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (c *Cauchy) CF(t float64) complex128 {
+	return CF(c, t)
+}
+
+// FisherI returns the Fisher Information of the distribution
+func (c *Cauchy) FisherI() [][]float64 {
+	return [][]float64{
+		[]float64{1 / (2 * c.Sigma * c.Sigma), 0},
+		[]float64{0, 1 / (2 * c.Sigma * c.Sigma)},
+	}
+}
+
+// Summary returns the properties of the distribution
+func (c *Cauchy) Summary() *DistSummary {
+	return summarise(c, "cauchy", Param{"mu", c.Mu}, Param{"sigma", c.Sigma})
+}
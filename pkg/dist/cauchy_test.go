@@ -0,0 +1,35 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestCauchy(t *testing.T) {
+	dist := &Cauchy{}
+	if err := dist.Init(1, 2); err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(dist.Summary())
+
+	// The standard Cauchy distribution is the Student's t distribution with ν = 1
+	std, st := &Cauchy{Mu: 0, Sigma: 1}, &StudentT{Nu: 1}
+	for _, x := range []float64{-1e6, -10, -1, 0, .5, 3, 1e6} {
+		if v, e := std.CDF(x), st.CDF(x); math.Abs(v-e) > 1e-12*e {
+			t.Errorf("F(%g) = %g, expected %g", x, v, e)
+		}
+		if v, e := std.LogPDF(x), st.LogPDF(x); math.Abs(v-e) > 1e-12 {
+			t.Errorf("log f(%g) = %g, expected %g", x, v, e)
+		}
+	}
+	if h := expectation(dist, func(x float64) float64 { return -dist.LogPDF(x) }, 100000); math.Abs(h-dist.Entropy()) > 1e-3 {
+		t.Errorf("entropy %f, numerically %f", dist.Entropy(), h)
+	}
+	if !math.IsNaN(dist.Mean()) || !math.IsNaN(dist.Var()) || !math.IsNaN(dist.Moment(.1)) {
+		t.Errorf("expected undefined moments")
+	}
+	if err := dist.Init(0, 0); err == nil {
+		t.Errorf("expected error for σ = 0")
+	}
+}
@@ -0,0 +1,205 @@
+package dist
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// Censored represents any distribution of the package clipped to
+// [Lower, Upper], observations outside of the bounds being reported at the
+// closest bound, as done by instruments with a limited range
+// Mixed probability distribution function as follows:
+//		X = min(max(Y, Lower), Upper), Y ~ D
+//
+//		F(x) = {
+//			0, x < Lower
+//			F_D(x), Lower <= x < Upper
+//			1, x >= Upper
+//		}
+//
+// The bounds hold point masses, so that Censored has neither a density
+// nor a mass function unless the base distribution is discrete.
+type Censored struct {
+	source
+	Dist         Distribution
+	Lower, Upper float64
+}
+
+// Init intialises a Censored distribution
+func (c *Censored) Init(d Distribution, lower, upper float64) error {
+	if d == nil || !(lower < upper) {
+		return util.ErrCensoredParam
+	}
+	c.Dist, c.Lower, c.Upper = d, lower, upper
+	return nil
+}
+
+// clip returns x clipped to the bounds
+func (c *Censored) clip(x float64) float64 {
+	return math.Max(c.Lower, math.Min(c.Upper, x))
+}
+
+// Generate creates one sample of the Censored distribution
+func (c *Censored) Generate() float64 {
+	return c.Rand(c.rng())
+}
+
+// GenerateN creates n samples of the Censored distribution
+func (c *Censored) GenerateN(n int) []float64 {
+	return GenerateN(c, c.rng(), n)
+}
+
+// Fill fills dst with samples of the Censored distribution
+func (c *Censored) Fill(dst []float64) {
+	Fill(c, c.rng(), dst)
+}
+
+// Rand creates one sample of the Censored distribution using the given generator
+func (c *Censored) Rand(r *rand.Rand) float64 {
+	return c.clip(c.Dist.Rand(r))
+}
+
+// fill fills dst with samples of the base distribution clipped to the bounds
+func (c *Censored) fill(r *rand.Rand, dst []float64) {
+	Fill(c.Dist, r, dst)
+	for i, x := range dst {
+		dst[i] = c.clip(x)
+	}
+}
+
+// Domain returns the definition domain of the distribution
+func (c *Censored) Domain() (float64, float64) {
+	dbeg, dend := c.Dist.Domain()
+	return c.clip(dbeg), c.clip(dend)
+}
+
+// Censoring returns the probabilities P(Y <= Lower) and P(Y >= Upper) of an
+// observation being reported at the lower and upper bound
+func (c *Censored) Censoring() (float64, float64) {
+	upper := c.Dist.Survival(c.Upper)
+	if IsDiscrete(c.Dist) {
+		upper = c.Dist.Survival(math.Ceil(c.Upper) - 1)
+	}
+	return c.Dist.CDF(c.Lower), upper
+}
+
+// CDF returns the Cumulative distribution function value of a given x
+func (c *Censored) CDF(x float64) float64 {
+	if x < c.Lower {
+		return 0
+	}
+	if x >= c.Upper {
+		return 1
+	}
+	return c.Dist.CDF(x)
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given x
+func (c *Censored) LogCDF(x float64) float64 {
+	if x < c.Lower {
+		return math.Inf(-1)
+	}
+	if x >= c.Upper {
+		return 0
+	}
+	return c.Dist.LogCDF(x)
+}
+
+// Survival returns the survival function value of a given x
+func (c *Censored) Survival(x float64) float64 {
+	if x < c.Lower {
+		return 1
+	}
+	if x >= c.Upper {
+		return 0
+	}
+	return c.Dist.Survival(x)
+}
+
+// LogSurvival returns the log of the survival function value of a given x
+func (c *Censored) LogSurvival(x float64) float64 {
+	if x < c.Lower {
+		return 0
+	}
+	if x >= c.Upper {
+		return math.Inf(-1)
+	}
+	return c.Dist.LogSurvival(x)
+}
+
+// Quantile returns the p-th quantile of the distribution
+//		Q(p) = min(max(Q_D(p), Lower), Upper)
+//
+func (c *Censored) Quantile(p float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	return c.clip(c.Dist.Quantile(p))
+}
+
+// expectation returns E[g(X)], adding the point masses at the bounds to the
+// sum over the support of a discrete distribution, or to the integral of
+// g(Q_D(u)) between the cdf of both bounds otherwise
+func (c *Censored) expectation(g func(float64) float64) float64 {
+	lower, upper := c.Censoring()
+	res := 0.0
+	if lower > 0 {
+		res += lower * g(c.Lower)
+	}
+	if upper > 0 {
+		res += upper * g(c.Upper)
+	}
+	if IsDiscrete(c.Dist) {
+		dbeg, dend := c.Dist.Domain()
+		lo := math.Max(dbeg, math.Floor(c.Lower)+1)
+		hi := math.Min(dend, math.Ceil(c.Upper)-1)
+		pmf := func(k float64) float64 { return math.Exp(logDensity(c.Dist, k)) }
+		return res + discreteSum(pmf, g, lo, hi, 1-lower-upper)
+	}
+	return res + quantileIntegral(c.Dist.Quantile, g, lower, 1-upper)
+}
+
+// Mean returns the mean of the distribution
+func (c *Censored) Mean() float64 {
+	return c.expectation(func(x float64) float64 { return x })
+}
+
+// Median returns the median of the distribution
+func (c *Censored) Median() float64 {
+	return c.Quantile(.5)
+}
+
+// Var returns the variance of the distribution
+func (c *Censored) Var() float64 {
+	mean := c.Mean()
+	return c.expectation(func(x float64) float64 { return (x - mean) * (x - mean) })
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (c *Censored) RawMoment(k int) float64 {
+	return RawMoment(c, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (c *Censored) CentralMoment(k int) float64 {
+	return CentralMoment(c, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (c *Censored) Cumulant(k int) float64 {
+	return Cumulant(c, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (c *Censored) CF(t float64) complex128 {
+	return CF(c, t)
+}
+
+// Summary returns the properties of the distribution
+func (c *Censored) Summary() *DistSummary {
+	s := summarise(c, "censored", Param{"lower", c.Lower}, Param{"upper", c.Upper})
+	s.Components = []*DistSummary{summaryOf(c.Dist)}
+	return s
+}
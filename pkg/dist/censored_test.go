@@ -0,0 +1,65 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+func TestCensored(t *testing.T) {
+	std := &Normal{Mu: 0, Sigma: 1}
+	dist := &Censored{}
+	if err := dist.Init(std, -1, 1); err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(dist.Summary())
+
+	// E[X^2] = ∫x^2 φ(x)dx over [-1, 1] + P(|Y| >= 1)
+	variance := std.CDF(1) - std.CDF(-1) - 2*std.PDF(1) + 2*std.Survival(1)
+	if v := dist.Mean(); math.Abs(v) > 1e-12 {
+		t.Errorf("E[X] = %f, expected 0", v)
+	}
+	if v := dist.Var(); math.Abs(v-variance) > 1e-9 {
+		t.Errorf("Var(X) = %f, expected %f", v, variance)
+	}
+	if v := dist.Quantile(.05); v != -1 {
+		t.Errorf("Q(.05) = %f, expected -1", v)
+	}
+	if v := dist.CDF(-1); math.Abs(v-std.CDF(-1)) > 1e-15 {
+		t.Errorf("F(-1) = %f, expected the censored mass %f", v, std.CDF(-1))
+	}
+
+	// Censoring a discrete distribution moves the tails onto the bounds
+	poisson := &Poisson{Lambda: 4}
+	counts := &Censored{}
+	counts.Init(poisson, 2, 6)
+	mean := 0.0
+	for k := 0.0; k < 100; k++ {
+		mean += math.Max(2, math.Min(6, k)) * poisson.PMF(k)
+	}
+	if v := counts.Mean(); math.Abs(v-mean) > 1e-12 {
+		t.Errorf("E[X] = %f, expected %f", v, mean)
+	}
+
+	// The sampler clips the base distribution
+	skewed := &Censored{Dist: &Gamma{Alpha: 2, Beta: 1}, Lower: .5, Upper: 3}
+	r := rand.New(rand.NewSource(1))
+	n, sum := 20000, 0.0
+	for i := 0; i < n; i++ {
+		x := skewed.Rand(r)
+		if x < .5 || x > 3 {
+			t.Fatalf("sample %f outside of [.5, 3]", x)
+		}
+		sum += x
+	}
+	if v := sum / float64(n); math.Abs(v-skewed.Mean()) > 4*math.Sqrt(skewed.Var()/float64(n)) {
+		t.Errorf("sample mean %f, expected %f", v, skewed.Mean())
+	}
+
+	if err := (&Censored{}).Init(std, 1, 1); err != util.ErrCensoredParam {
+		t.Errorf("expected %v, got %v", util.ErrCensoredParam, err)
+	}
+}
@@ -1,10 +1,12 @@
 package dist
 
 import (
-	"fmt"
 	"math"
+	"math/cmplx"
+	"math/rand"
 
-	"gonum.org/v1/gonum/mathext"
+	"github.com/ichbinfrog/statistics/pkg/specfun"
+	"github.com/ichbinfrog/statistics/pkg/util"
 )
 
 // Chisq represents the Chi squared distribution
@@ -12,28 +14,108 @@ import (
 // 		X ~ χ(k), k >= 0
 //
 type Chisq struct {
+	source
 	Degree float64
 }
 
-// Generate creates one sample of the Bernoulli distribution
+// Init intialises a Chi squared distribution
+func (c *Chisq) Init(degree float64) error {
+	if degree <= 0 {
+		return util.ErrChisqParam
+	}
+	c.Degree = degree
+	return nil
+}
+
+// Generate creates one sample of the Chi squared distribution
 func (c *Chisq) Generate() float64 {
+	return c.Rand(c.rng())
+}
+
+// GenerateN creates n samples of the Chi squared distribution
+func (c *Chisq) GenerateN(n int) []float64 {
+	return GenerateN(c, c.rng(), n)
+}
+
+// Fill fills dst with samples of the Chi squared distribution
+func (c *Chisq) Fill(dst []float64) {
+	Fill(c, c.rng(), dst)
+}
+
+// Rand creates one sample of the Chi squared distribution using the given generator
+func (c *Chisq) Rand(r *rand.Rand) float64 {
 	g := Gamma{}
 	if err := g.Init(c.Degree/2, .5); err != nil {
 		return math.NaN()
 	}
-	return g.Generate()
+	return g.Rand(r)
 }
 
-// PMF returns the probability mass function value of a given k
-func (c *Chisq) PMF(x float64) float64 {
-	lg, _ := math.Lgamma(c.Degree / 2)
-	return (math.Pow(x, c.Degree/2-1) * math.Exp(-x/2)) / (math.Pow(2, c.Degree/2) * lg)
+// fill fills dst with samples of the Chi squared distribution, sharing
+// the underlying Gamma distribution
+func (c *Chisq) fill(r *rand.Rand, dst []float64) {
+	g := Gamma{}
+	if err := g.Init(c.Degree/2, .5); err != nil {
+		for i := range dst {
+			dst[i] = math.NaN()
+		}
+		return
+	}
+	Fill(&g, r, dst)
 }
 
-// CDF returns the Cumulative distribution function value of a given k
+// Domain returns the definition domain of the distribution
+func (c *Chisq) Domain() (float64, float64) {
+	return 0, math.Inf(0)
+}
+
+// PDF returns the probability density function value of a given x
+func (c *Chisq) PDF(x float64) float64 {
+	return math.Exp(c.LogPDF(x))
+}
+
+// LogPDF returns the log of the probability density function value of a given x
+func (c *Chisq) LogPDF(x float64) float64 {
+	g := Gamma{Alpha: c.Degree / 2, Beta: .5}
+	return g.LogPDF(x)
+}
+
+// CDF returns the Cumulative distribution function value of a given x
 func (c *Chisq) CDF(x float64) float64 {
-	lg, _ := math.Lgamma(c.Degree / 2)
-	return mathext.GammaIncReg(c.Degree/2, x/2) / lg
+	if x <= 0 {
+		return 0
+	}
+	return specfun.GammaIncReg(c.Degree/2, x/2)
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given x
+func (c *Chisq) LogCDF(x float64) float64 {
+	return specfun.LogGammaIncReg(c.Degree/2, x/2)
+}
+
+// Survival returns the survival function value of a given x
+func (c *Chisq) Survival(x float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+	return specfun.GammaIncRegComp(c.Degree/2, x/2)
+}
+
+// LogSurvival returns the log of the survival function value of a given x
+func (c *Chisq) LogSurvival(x float64) float64 {
+	return specfun.LogGammaIncRegComp(c.Degree/2, x/2)
+}
+
+// Quantile returns the p-th quantile of the distribution
+func (c *Chisq) Quantile(p float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	if x := specfun.GammaIncRegInv(c.Degree/2, p); !math.IsNaN(x) {
+		return 2 * x
+	}
+	dbeg, dend := c.Domain()
+	return continuousQuantile(c.CDF, p, dbeg, dend, c.Mean(), math.Sqrt(c.Var()))
 }
 
 // Mean returns the mean of the distribution
@@ -53,7 +135,7 @@ func (c *Chisq) Var() float64 {
 
 // Skewness returns the Pearson's moment coefficient of skewness of the distribution
 func (c *Chisq) Skewness() float64 {
-	return math.Sqrt(c.Degree - 2.0)
+	return math.Sqrt(8 / c.Degree)
 }
 
 // Kurtosis returns the Kurtosis of the distribution
@@ -63,20 +145,51 @@ func (c *Chisq) Kurtosis() float64 {
 
 // Moment returns the t-th moment of the distribution
 func (c *Chisq) Moment(t float64) float64 {
-	if t < 1/2 {
+	if t < .5 {
 		return math.Pow(1-2*t, -c.Degree/2)
 	}
 	return math.NaN()
 }
 
-// Summary returns a string summarising basic info about the distribution
-func (c *Chisq) Summary() string {
-	return fmt.Sprintf(`
-	X ~ χ(%f)
-		Mean: 		%f
-		Median: 	%f
-		Var: 		%f
-		Skewness: 	%f
-		Kurtosis:	%f
-`, c.Degree, c.Mean(), c.Median(), c.Var(), c.Skewness(), c.Kurtosis())
+// rawMoment returns E[X^n] = 2^n Γ(k/2 + n) / Γ(k/2)
+func (c *Chisq) rawMoment(n int) float64 {
+	nf := float64(n)
+	return math.Exp(specfun.LogGamma(c.Degree/2+nf) - specfun.LogGamma(c.Degree/2) + nf*math.Ln2)
+}
+
+// cumulant returns the n-th cumulant κ_n = 2^(n-1) (n-1)! k
+func (c *Chisq) cumulant(n int) float64 {
+	return c.Degree * math.Exp(specfun.LogFactorial(float64(n-1))+float64(n-1)*math.Ln2)
+}
+
+// cf returns the characteristic function of the distribution at t
+//		φ(t) = (1 - 2it)^(-k/2)
+//
+func (c *Chisq) cf(t float64) complex128 {
+	return cmplx.Pow(complex(1, -2*t), complex(-c.Degree/2, 0))
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (c *Chisq) RawMoment(k int) float64 {
+	return RawMoment(c, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (c *Chisq) CentralMoment(k int) float64 {
+	return CentralMoment(c, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (c *Chisq) Cumulant(k int) float64 {
+	return Cumulant(c, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (c *Chisq) CF(t float64) complex128 {
+	return CF(c, t)
+}
+
+// Summary returns the properties of the distribution
+func (c *Chisq) Summary() *DistSummary {
+	return summarise(c, "chisq", Param{"degree", c.Degree})
 }
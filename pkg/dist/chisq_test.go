@@ -11,8 +11,8 @@ func TestChisq(t *testing.T) {
 	}
 	fmt.Println(dist.Summary())
 
-	fmt.Printf("		f(0) = %f", dist.PMF(0))
-	fmt.Printf("\n		f(1) = %f\n", dist.PMF(1))
+	fmt.Printf("		f(0) = %f", dist.PDF(0))
+	fmt.Printf("\n		f(1) = %f\n", dist.PDF(1))
 	fmt.Printf("\n		F(5) = %f", dist.CDF(5))
 	fmt.Printf("\n		Mx(0) = %f", dist.Moment(0))
 	fmt.Printf("\n		Mx(1) = %f\n", dist.Moment(1))
@@ -0,0 +1,405 @@
+package dist
+
+import (
+	"math"
+
+	"github.com/ichbinfrog/statistics/pkg/array"
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// credibleInterval returns the equal tailed interval holding the given
+// probability mass of the distribution
+func credibleInterval(d Distribution, level float64) (float64, float64) {
+	if !validProbability(level) {
+		return math.NaN(), math.NaN()
+	}
+	return d.Quantile((1 - level) / 2), d.Quantile((1 + level) / 2)
+}
+
+// BetaBernoulli is the Beta prior of the probability of success of
+// Bernoulli and Binomial observations
+//		p ~ B(α, β)
+//		p | k successes in n trials ~ B(α + k, β + n - k)
+//
+type BetaBernoulli struct {
+	Alpha, Beta float64
+}
+
+// Init intialises the prior from the pseudo counts of successes α and
+// of failures β
+func (b *BetaBernoulli) Init(alpha, beta float64) error {
+	if alpha <= 0 || beta <= 0 {
+		return util.ErrBetaParam
+	}
+	b.Alpha, b.Beta = alpha, beta
+	return nil
+}
+
+// Update returns the posterior given Bernoulli observations in {0, 1}
+func (b *BetaBernoulli) Update(a *array.Arrayf64) (*BetaBernoulli, error) {
+	if err := checkSample(a, 1, 0, 1, true); err != nil {
+		return nil, err
+	}
+	successes := sampleSum(a)
+	return b.UpdateCounts(successes, a.Length-successes)
+}
+
+// UpdateBinomial returns the posterior given the numbers of successes of
+// experiments of the given number of trials each
+func (b *BetaBernoulli) UpdateBinomial(a *array.Arrayf64, trials float64) (*BetaBernoulli, error) {
+	if err := checkSample(a, 1, 0, trials, true); err != nil {
+		return nil, err
+	}
+	successes := sampleSum(a)
+	return b.UpdateCounts(successes, trials*a.Length-successes)
+}
+
+// UpdateCounts returns the posterior given the numbers of successes and failures
+func (b *BetaBernoulli) UpdateCounts(successes, failures float64) (*BetaBernoulli, error) {
+	if successes < 0 || failures < 0 {
+		return nil, util.ErrFitSupport
+	}
+	return &BetaBernoulli{Alpha: b.Alpha + successes, Beta: b.Beta + failures}, nil
+}
+
+// Posterior returns the distribution of the probability of success
+func (b *BetaBernoulli) Posterior() *Beta {
+	return &Beta{Alpha: b.Alpha, Beta: b.Beta}
+}
+
+// Predictive returns the distribution of the next Bernoulli observation
+func (b *BetaBernoulli) Predictive() *Bernoulli {
+	p := b.Alpha / (b.Alpha + b.Beta)
+	return &Bernoulli{P: p, Q: 1 - p}
+}
+
+// PredictiveBinomial returns the distribution of the number of successes
+// of the next experiment of the given number of trials
+func (b *BetaBernoulli) PredictiveBinomial(trials float64) *BetaBinomial {
+	return &BetaBinomial{N: trials, Alpha: b.Alpha, Beta: b.Beta}
+}
+
+// CredibleInterval returns the equal tailed interval holding the
+// probability of success with the given probability
+func (b *BetaBernoulli) CredibleInterval(level float64) (float64, float64) {
+	return credibleInterval(b.Posterior(), level)
+}
+
+// GammaPoisson is the Gamma prior of the rate of Poisson observations
+//		λ ~ Γ(α, β)
+//		λ | x_1, ..., x_n ~ Γ(α + Σ x_i, β + n)
+//
+type GammaPoisson struct {
+	Alpha, Beta float64
+}
+
+// Init intialises the prior from α events observed over β intervals
+func (g *GammaPoisson) Init(alpha, beta float64) error {
+	if alpha <= 0 || beta <= 0 {
+		return util.ErrGammaParam
+	}
+	g.Alpha, g.Beta = alpha, beta
+	return nil
+}
+
+// Update returns the posterior given Poisson observations
+func (g *GammaPoisson) Update(a *array.Arrayf64) (*GammaPoisson, error) {
+	if err := checkSample(a, 1, 0, math.Inf(0), true); err != nil {
+		return nil, err
+	}
+	return g.UpdateCounts(a.Length, sampleSum(a))
+}
+
+// UpdateCounts returns the posterior given the total number of events
+// observed over n intervals
+func (g *GammaPoisson) UpdateCounts(n, total float64) (*GammaPoisson, error) {
+	if n < 0 || total < 0 {
+		return nil, util.ErrFitSupport
+	}
+	return &GammaPoisson{Alpha: g.Alpha + total, Beta: g.Beta + n}, nil
+}
+
+// Posterior returns the distribution of the rate
+func (g *GammaPoisson) Posterior() *Gamma {
+	return &Gamma{Alpha: g.Alpha, Beta: g.Beta}
+}
+
+// Predictive returns the distribution of the next observation
+//		X ~ NB(α, 1 / (1 + β))
+//
+func (g *GammaPoisson) Predictive() *Polya {
+	return &Polya{R: g.Alpha, P: 1 / (1 + g.Beta), Q: g.Beta / (1 + g.Beta)}
+}
+
+// CredibleInterval returns the equal tailed interval holding the rate
+// with the given probability
+func (g *GammaPoisson) CredibleInterval(level float64) (float64, float64) {
+	return credibleInterval(g.Posterior(), level)
+}
+
+// GammaExponential is the Gamma prior of the rate of Exponential observations
+//		λ ~ Γ(α, β)
+//		λ | x_1, ..., x_n ~ Γ(α + n, β + Σ x_i)
+//
+type GammaExponential struct {
+	Alpha, Beta float64
+}
+
+// Init intialises the prior from α observations lasting β in total
+func (g *GammaExponential) Init(alpha, beta float64) error {
+	if alpha <= 0 || beta <= 0 {
+		return util.ErrGammaParam
+	}
+	g.Alpha, g.Beta = alpha, beta
+	return nil
+}
+
+// Update returns the posterior given Exponential observations
+func (g *GammaExponential) Update(a *array.Arrayf64) (*GammaExponential, error) {
+	if err := checkSample(a, 1, 0, math.Inf(0), false); err != nil {
+		return nil, err
+	}
+	return g.UpdateCounts(a.Length, sampleSum(a))
+}
+
+// UpdateCounts returns the posterior given n observations summing up to total
+func (g *GammaExponential) UpdateCounts(n, total float64) (*GammaExponential, error) {
+	if n < 0 || total < 0 {
+		return nil, util.ErrFitSupport
+	}
+	return &GammaExponential{Alpha: g.Alpha + n, Beta: g.Beta + total}, nil
+}
+
+// Posterior returns the distribution of the rate
+func (g *GammaExponential) Posterior() *Gamma {
+	return &Gamma{Alpha: g.Alpha, Beta: g.Beta}
+}
+
+// Predictive returns the distribution of the next observation
+//		X ~ Lomax(α, β)
+//
+func (g *GammaExponential) Predictive() *Lomax {
+	return &Lomax{Alpha: g.Alpha, Lambda: g.Beta}
+}
+
+// CredibleInterval returns the equal tailed interval holding the rate
+// with the given probability
+func (g *GammaExponential) CredibleInterval(level float64) (float64, float64) {
+	return credibleInterval(g.Posterior(), level)
+}
+
+// NormalNormal is the Normal prior of the mean of Normal observations of
+// known standard deviation σ
+//		μ ~ N(μ0, τ)
+//		μ | x_1, ..., x_n ~ N((μ0/τ^2 + n x̄/σ^2) / p, 1/√p), p = 1/τ^2 + n/σ^2
+//
+type NormalNormal struct {
+	Mu, Tau, Sigma float64
+}
+
+// Init intialises the prior of mean μ and standard deviation τ of the mean
+// of observations of standard deviation σ
+func (n *NormalNormal) Init(mu, tau, sigma float64) error {
+	if tau <= 0 || sigma <= 0 {
+		return util.ErrNormalParam
+	}
+	n.Mu, n.Tau, n.Sigma = mu, tau, sigma
+	return nil
+}
+
+// Update returns the posterior given Normal observations
+func (n *NormalNormal) Update(a *array.Arrayf64) (*NormalNormal, error) {
+	if err := checkSample(a, 1, math.Inf(-1), math.Inf(0), false); err != nil {
+		return nil, err
+	}
+	mean, _ := sampleMoments(a)
+	return n.UpdateStats(a.Length, mean)
+}
+
+// UpdateStats returns the posterior given the number and the mean of the
+// observations, their sufficient statistics
+func (n *NormalNormal) UpdateStats(count, mean float64) (*NormalNormal, error) {
+	if count < 0 {
+		return nil, util.ErrFitSupport
+	}
+	precision := 1/(n.Tau*n.Tau) + count/(n.Sigma*n.Sigma)
+	return &NormalNormal{
+		Mu:    (n.Mu/(n.Tau*n.Tau) + count*mean/(n.Sigma*n.Sigma)) / precision,
+		Tau:   1 / math.Sqrt(precision),
+		Sigma: n.Sigma,
+	}, nil
+}
+
+// Posterior returns the distribution of the mean
+func (n *NormalNormal) Posterior() *Normal {
+	return &Normal{Mu: n.Mu, Sigma: n.Tau}
+}
+
+// Predictive returns the distribution of the next observation
+//		X ~ N(μ, √(τ^2 + σ^2))
+//
+func (n *NormalNormal) Predictive() *Normal {
+	return &Normal{Mu: n.Mu, Sigma: math.Hypot(n.Tau, n.Sigma)}
+}
+
+// CredibleInterval returns the equal tailed interval holding the mean
+// with the given probability
+func (n *NormalNormal) CredibleInterval(level float64) (float64, float64) {
+	return credibleInterval(n.Posterior(), level)
+}
+
+// NormalInverseGamma is the Normal-inverse-Gamma prior of the mean and
+// variance of Normal observations
+//		σ^2 ~ Inv-Γ(α, β), μ | σ^2 ~ N(μ0, σ/√λ)
+//		λ' = λ + n
+//		μ' = (λμ0 + n x̄) / λ'
+//		α' = α + n/2
+//		β' = β + Σ (x_i - x̄)^2 / 2 + λn(x̄ - μ0)^2 / (2λ')
+//
+type NormalInverseGamma struct {
+	Mu, Lambda, Alpha, Beta float64
+}
+
+// Init intialises the prior of the mean μ estimated from λ observations
+// and of the variance estimated from 2α observations of sum of squared
+// deviations 2β
+func (n *NormalInverseGamma) Init(mu, lambda, alpha, beta float64) error {
+	if lambda <= 0 || alpha <= 0 || beta <= 0 {
+		return util.ErrNormalInverseGammaParam
+	}
+	n.Mu, n.Lambda, n.Alpha, n.Beta = mu, lambda, alpha, beta
+	return nil
+}
+
+// Update returns the posterior given Normal observations
+func (n *NormalInverseGamma) Update(a *array.Arrayf64) (*NormalInverseGamma, error) {
+	if err := checkSample(a, 1, math.Inf(-1), math.Inf(0), false); err != nil {
+		return nil, err
+	}
+	mean, variance := sampleMoments(a)
+	return n.UpdateStats(a.Length, mean, variance)
+}
+
+// UpdateStats returns the posterior given the number, the mean and the
+// (biased) variance of the observations, their sufficient statistics
+func (n *NormalInverseGamma) UpdateStats(count, mean, variance float64) (*NormalInverseGamma, error) {
+	if count < 0 || variance < 0 {
+		return nil, util.ErrFitSupport
+	}
+	lambda := n.Lambda + count
+	d := mean - n.Mu
+	return &NormalInverseGamma{
+		Mu:     (n.Lambda*n.Mu + count*mean) / lambda,
+		Lambda: lambda,
+		Alpha:  n.Alpha + count/2,
+		Beta:   n.Beta + count*variance/2 + n.Lambda*count*d*d/(2*lambda),
+	}, nil
+}
+
+// Posterior returns the marginal distribution of the mean
+//		μ ~ t(2α, μ0, √(β / (αλ)))
+//
+func (n *NormalInverseGamma) Posterior() *LocationScaleT {
+	return &LocationScaleT{Nu: 2 * n.Alpha, Mu: n.Mu, Sigma: math.Sqrt(n.Beta / (n.Alpha * n.Lambda))}
+}
+
+// Precision returns the marginal distribution of the precision 1/σ^2
+//		1/σ^2 ~ Γ(α, β)
+//
+func (n *NormalInverseGamma) Precision() *Gamma {
+	return &Gamma{Alpha: n.Alpha, Beta: n.Beta}
+}
+
+// Predictive returns the distribution of the next observation
+//		X ~ t(2α, μ0, √(β(λ + 1) / (αλ)))
+//
+func (n *NormalInverseGamma) Predictive() *LocationScaleT {
+	return &LocationScaleT{Nu: 2 * n.Alpha, Mu: n.Mu, Sigma: math.Sqrt(n.Beta * (n.Lambda + 1) / (n.Alpha * n.Lambda))}
+}
+
+// CredibleInterval returns the equal tailed interval holding the mean
+// with the given probability
+func (n *NormalInverseGamma) CredibleInterval(level float64) (float64, float64) {
+	return credibleInterval(n.Posterior(), level)
+}
+
+// VarianceCredibleInterval returns the equal tailed interval holding the
+// variance with the given probability
+func (n *NormalInverseGamma) VarianceCredibleInterval(level float64) (float64, float64) {
+	lo, hi := credibleInterval(n.Precision(), level)
+	return 1 / hi, 1 / lo
+}
+
+// DirichletCategorical is the Dirichlet prior of the probabilities of
+// Categorical observations
+//		p ~ Dir(α_0, ..., α_(m-1))
+//		p | c_0, ..., c_(m-1) occurrences ~ Dir(α_0 + c_0, ..., α_(m-1) + c_(m-1))
+//
+type DirichletCategorical struct {
+	Alpha []float64
+}
+
+// Init intialises the prior from the pseudo counts of each category
+func (d *DirichletCategorical) Init(alpha []float64) error {
+	prior := &Dirichlet{}
+	if err := prior.Init(alpha); err != nil {
+		return err
+	}
+	d.Alpha = prior.Alpha
+	return nil
+}
+
+// Update returns the posterior given Categorical observations in
+// [0, ..., m - 1]
+func (d *DirichletCategorical) Update(a *array.Arrayf64) (*DirichletCategorical, error) {
+	if err := checkSample(a, 1, 0, float64(len(d.Alpha)-1), true); err != nil {
+		return nil, err
+	}
+	counts := make([]float64, len(d.Alpha))
+	for _, v := range a.Data {
+		counts[int(v)]++
+	}
+	return d.UpdateCounts(counts)
+}
+
+// UpdateCounts returns the posterior given the number of occurrences of
+// each category
+func (d *DirichletCategorical) UpdateCounts(counts []float64) (*DirichletCategorical, error) {
+	if len(counts) != len(d.Alpha) {
+		return nil, util.ErrDimension
+	}
+	res := &DirichletCategorical{Alpha: make([]float64, len(d.Alpha))}
+	for i, c := range counts {
+		if c < 0 {
+			return nil, util.ErrFitSupport
+		}
+		res.Alpha[i] = d.Alpha[i] + c
+	}
+	return res, nil
+}
+
+// Posterior returns the distribution of the probabilities
+func (d *DirichletCategorical) Posterior() *Dirichlet {
+	return &Dirichlet{Alpha: append([]float64{}, d.Alpha...)}
+}
+
+// Predictive returns the distribution of the next observation
+//		X ~ Cat(α_0 / Σ α_i, ..., α_(m-1) / Σ α_i)
+//
+func (d *DirichletCategorical) Predictive() *Categorical {
+	c := &Categorical{}
+	c.Init(d.Alpha)
+	return c
+}
+
+// CredibleInterval returns the equal tailed intervals holding the
+// probability of each category with the given probability, computed from
+// the Beta marginals of the posterior
+func (d *DirichletCategorical) CredibleInterval(level float64) ([]float64, []float64) {
+	lo, hi := make([]float64, len(d.Alpha)), make([]float64, len(d.Alpha))
+	post := d.Posterior()
+	for i := range d.Alpha {
+		lo[i], hi[i] = credibleInterval(post.Marginal(i), level)
+	}
+	return lo, hi
+}
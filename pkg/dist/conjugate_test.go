@@ -0,0 +1,264 @@
+package dist
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ichbinfrog/statistics/pkg/array"
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// observations returns an array holding the given values
+func observations(values ...float64) *array.Arrayf64 {
+	a := &array.Arrayf64{}
+	a.Init(array.Optionf64{
+		Degree: 2,
+	})
+	a.InsertSlice(values)
+	return a
+}
+
+// checkInterval checks that the credible interval holds the given mass of d
+func checkInterval(t *testing.T, d Distribution, lo, hi, level float64) {
+	if mass := d.CDF(hi) - d.CDF(lo); math.Abs(mass-level) > 1e-9 || d.CDF(lo) > (1-level)/2+1e-9 {
+		t.Errorf("interval [%f, %f] holds %f, expected %f", lo, hi, mass, level)
+	}
+}
+
+func TestBetaBernoulli(t *testing.T) {
+	prior := &BetaBernoulli{}
+	if err := prior.Init(1, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	// 7 successes out of 10 trials
+	post, err := prior.Update(observations(1, 1, 0, 1, 1, 1, 0, 1, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if post.Alpha != 8 || post.Beta != 4 || prior.Alpha != 1 {
+		t.Errorf("unexpected posterior B(%g, %g)", post.Alpha, post.Beta)
+	}
+	binomial, err := prior.UpdateBinomial(observations(3, 4), 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *binomial != *post {
+		t.Errorf("binomial posterior B(%g, %g), expected B(%g, %g)", binomial.Alpha, binomial.Beta, post.Alpha, post.Beta)
+	}
+	// Counts are exact where the mean times the length is not, 3/9 * 9 != 3
+	if thirds, _ := prior.Update(observations(1, 0, 0, 1, 0, 0, 1, 0, 0)); thirds.Alpha != 4 || thirds.Beta != 7 {
+		t.Errorf("unexpected posterior B(%g, %g), expected B(4, 7)", thirds.Alpha, thirds.Beta)
+	}
+
+	if p := post.Predictive().P; math.Abs(p-8./12) > 1e-15 || math.Abs(post.PredictiveBinomial(1).PMF(1)-p) > 1e-14 {
+		t.Errorf("predictive probability %f, expected %f", p, 8./12)
+	}
+	if m := post.PredictiveBinomial(20).Mean(); math.Abs(m-20*8./12) > 1e-12 {
+		t.Errorf("predictive mean %f, expected %f", m, 20*8./12)
+	}
+	lo, hi := post.CredibleInterval(.95)
+	checkInterval(t, post.Posterior(), lo, hi, .95)
+
+	if _, err := prior.Update(observations(0, 2)); err != util.ErrFitSupport {
+		t.Errorf("expected %v, got %v", util.ErrFitSupport, err)
+	}
+	if _, err := prior.UpdateCounts(-1, 2); err != util.ErrFitSupport {
+		t.Errorf("expected %v, got %v", util.ErrFitSupport, err)
+	}
+	if err := prior.Init(0, 1); err != util.ErrBetaParam {
+		t.Errorf("expected %v, got %v", util.ErrBetaParam, err)
+	}
+}
+
+func TestGammaPoisson(t *testing.T) {
+	prior := &GammaPoisson{}
+	if err := prior.Init(2, 1); err != nil {
+		t.Fatal(err)
+	}
+	a := sample(&Poisson{Lambda: 4}, 1000, 1)
+	post, err := prior.Update(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	total := 0.0
+	for _, v := range a.Data {
+		total += v
+	}
+	if post.Alpha != 2+total || post.Beta != 1001 {
+		t.Errorf("unexpected posterior Γ(%g, %g), expected Γ(%g, 1001)", post.Alpha, post.Beta, 2+total)
+	}
+	lo, hi := post.CredibleInterval(.99)
+	checkInterval(t, post.Posterior(), lo, hi, .99)
+	if lo > 4 || hi < 4 {
+		t.Errorf("interval [%f, %f] misses the rate", lo, hi)
+	}
+
+	// The predictive is the Poisson distribution mixed over the posterior
+	pred := prior.Predictive()
+	rate := prior.Posterior()
+	for _, k := range []float64{0, 1, 4, 10} {
+		poisson := &Poisson{}
+		e := expectation(rate, func(l float64) float64 { poisson.Lambda = l; return poisson.PMF(k) }, 100000)
+		if p := pred.PMF(k); math.Abs(p-e) > 1e-6 {
+			t.Errorf("f(%g) = %g, expected %g", k, p, e)
+		}
+	}
+
+	// Samples of the predictive of a non integer shape have the mean α/β
+	fractional := &GammaPoisson{}
+	if err := fractional.Init(2.5, .8); err != nil {
+		t.Fatal(err)
+	}
+	pred = fractional.Predictive()
+	n := 20000
+	b := powerSums(pred, n, 3, 2)
+	if m, se := 2.5/.8, math.Sqrt(pred.Var()/float64(n)); math.Abs(b.Mean()-m) > 5*se {
+		t.Errorf("predictive sample mean %f, expected %f ± %f", b.Mean(), m, 5*se)
+	}
+	if _, p := ChiSquare(b, pred, 0); p < 1e-3 {
+		t.Errorf("predictive samples do not follow NB(2.5, 1/1.8), p-value %g", p)
+	}
+
+	if _, err := prior.Update(observations(1, 2.5)); err != util.ErrFitSupport {
+		t.Errorf("expected %v, got %v", util.ErrFitSupport, err)
+	}
+}
+
+func TestGammaExponential(t *testing.T) {
+	prior := &GammaExponential{}
+	if err := prior.Init(3, 2); err != nil {
+		t.Fatal(err)
+	}
+	post, err := prior.Update(observations(.5, 1, 1.5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if post.Alpha != 6 || post.Beta != 5 {
+		t.Errorf("unexpected posterior Γ(%g, %g)", post.Alpha, post.Beta)
+	}
+	lo, hi := post.CredibleInterval(.9)
+	checkInterval(t, post.Posterior(), lo, hi, .9)
+
+	// The predictive is the Exponential distribution mixed over the posterior
+	pred := post.Predictive()
+	rate := post.Posterior()
+	for _, x := range []float64{0, .3, 1, 5} {
+		e := expectation(rate, func(l float64) float64 { return l * math.Exp(-l*x) }, 100000)
+		if p := pred.PDF(x); math.Abs(p-e) > 1e-6 {
+			t.Errorf("f(%g) = %g, expected %g", x, p, e)
+		}
+	}
+
+	if _, err := prior.Update(observations(-1, 2)); err != util.ErrFitSupport {
+		t.Errorf("expected %v, got %v", util.ErrFitSupport, err)
+	}
+}
+
+func TestNormalNormal(t *testing.T) {
+	prior := &NormalNormal{}
+	if err := prior.Init(0, 2, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	// Updating in several steps is updating at once
+	a, b := observations(1, 2, 3), observations(4, 5)
+	step, _ := prior.Update(a)
+	step, _ = step.Update(b)
+	once, _ := prior.Update(observations(1, 2, 3, 4, 5))
+	if math.Abs(step.Mu-once.Mu) > 1e-12 || math.Abs(step.Tau-once.Tau) > 1e-12 {
+		t.Errorf("sequential N(%f, %f), expected N(%f, %f)", step.Mu, step.Tau, once.Mu, once.Tau)
+	}
+	// precision 1/4 + 5 and mean 15 / precision
+	if p := 1 / (once.Tau * once.Tau); math.Abs(p-5.25) > 1e-12 || math.Abs(once.Mu-15/5.25) > 1e-12 {
+		t.Errorf("unexpected posterior N(%f, %f)", once.Mu, once.Tau)
+	}
+	if v := once.Predictive().Var(); math.Abs(v-1/5.25-1) > 1e-12 {
+		t.Errorf("predictive variance %f, expected %f", v, 1/5.25+1)
+	}
+	lo, hi := once.CredibleInterval(.95)
+	checkInterval(t, once.Posterior(), lo, hi, .95)
+
+	if err := prior.Init(0, 0, 1); err != util.ErrNormalParam {
+		t.Errorf("expected %v, got %v", util.ErrNormalParam, err)
+	}
+}
+
+func TestNormalInverseGamma(t *testing.T) {
+	prior := &NormalInverseGamma{}
+	if err := prior.Init(1, 2, 3, 4); err != nil {
+		t.Fatal(err)
+	}
+
+	// Updating in several steps is updating at once
+	step, _ := prior.Update(observations(1.5, -.3, 2.2))
+	step, _ = step.Update(observations(.7, 3.1, 0))
+	once, _ := prior.Update(observations(1.5, -.3, 2.2, .7, 3.1, 0))
+	for _, v := range [][]float64{{step.Mu, once.Mu}, {step.Lambda, once.Lambda}, {step.Alpha, once.Alpha}, {step.Beta, once.Beta}} {
+		if math.Abs(v[0]-v[1]) > 1e-12 {
+			t.Errorf("sequential posterior %+v, expected %+v", step, once)
+		}
+	}
+
+	// The predictive variance is E[σ^2](1 + 1/λ)
+	pred := once.Predictive()
+	if e := once.Beta / (once.Alpha - 1) * (1 + 1/once.Lambda); math.Abs(pred.Var()-e) > 1e-12 {
+		t.Errorf("predictive variance %f, expected %f", pred.Var(), e)
+	}
+	lo, hi := once.CredibleInterval(.95)
+	checkInterval(t, once.Posterior(), lo, hi, .95)
+
+	// The variance interval converges to the variance of the observations
+	post, err := prior.Update(sample(&Normal{Mu: 3, Sigma: 2}, 5000, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lo, hi := post.VarianceCredibleInterval(.99); lo > 4 || hi < 4 || hi-lo > 1 {
+		t.Errorf("variance interval [%f, %f], expected around 4", lo, hi)
+	}
+	if lo, hi := post.CredibleInterval(.99); lo > 3 || hi < 3 || hi-lo > .3 {
+		t.Errorf("mean interval [%f, %f], expected around 3", lo, hi)
+	}
+
+	if err := prior.Init(0, 1, 0, 1); err != util.ErrNormalInverseGammaParam {
+		t.Errorf("expected %v, got %v", util.ErrNormalInverseGammaParam, err)
+	}
+}
+
+func TestDirichletCategorical(t *testing.T) {
+	prior := &DirichletCategorical{}
+	if err := prior.Init([]float64{1, 1, 1}); err != nil {
+		t.Fatal(err)
+	}
+	post, err := prior.Update(observations(0, 2, 2, 1, 2, 0, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	counts, _ := prior.UpdateCounts([]float64{2, 1, 4})
+	for i, e := range []float64{3, 2, 5} {
+		if post.Alpha[i] != e || counts.Alpha[i] != e {
+			t.Errorf("unexpected posterior %v, expected α_%d = %g", post.Alpha, i, e)
+		}
+	}
+	if prior.Alpha[2] != 1 {
+		t.Errorf("the prior was modified %v", prior.Alpha)
+	}
+
+	pred := post.Predictive()
+	for i, e := range []float64{.3, .2, .5} {
+		if p := pred.PMF(float64(i)); math.Abs(p-e) > 1e-15 {
+			t.Errorf("f(%d) = %g, expected %g", i, p, e)
+		}
+	}
+	lo, hi := post.CredibleInterval(.95)
+	for i := range lo {
+		checkInterval(t, post.Posterior().Marginal(i), lo[i], hi[i], .95)
+	}
+
+	if _, err := prior.Update(observations(0, 3)); err != util.ErrFitSupport {
+		t.Errorf("expected %v, got %v", util.ErrFitSupport, err)
+	}
+	if _, err := prior.UpdateCounts([]float64{1, 2}); err != util.ErrDimension {
+		t.Errorf("expected %v, got %v", util.ErrDimension, err)
+	}
+}
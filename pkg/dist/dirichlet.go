@@ -0,0 +1,180 @@
+package dist
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/ichbinfrog/statistics/pkg/matrix"
+	"github.com/ichbinfrog/statistics/pkg/specfun"
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// Dirichlet represents the Dirichlet distribution of order k, the
+// conjugate prior of the Categorical and Multinomial distributions
+// Continuous multivariate probability distribution function as follows:
+//		X ~ Dir(α_1, ..., α_k), k >= 2, α_i > 0
+//
+//		f(x,α) = Π x_i^(α_i-1) / B(α), x on the simplex Σ x_i = 1
+//		B(α) = Π Γ(α_i) / Γ(α_0), α_0 = Σ α_i
+//
+type Dirichlet struct {
+	source
+	Alpha []float64
+}
+
+// Init intialises a Dirichlet distribution
+func (d *Dirichlet) Init(alpha []float64) error {
+	if len(alpha) < 2 {
+		return util.ErrDirichletParam
+	}
+	for _, a := range alpha {
+		if !(a > 0) || math.IsInf(a, 0) {
+			return util.ErrDirichletParam
+		}
+	}
+	d.Alpha = make([]float64, len(alpha))
+	copy(d.Alpha, alpha)
+	return nil
+}
+
+// alpha0 returns the sum of the concentration parameters
+func (d *Dirichlet) alpha0() float64 {
+	sum := 0.0
+	for _, a := range d.Alpha {
+		sum += a
+	}
+	return sum
+}
+
+// Generate creates one sample of the Dirichlet distribution
+func (d *Dirichlet) Generate() []float64 {
+	return d.Rand(d.rng())
+}
+
+// GenerateN creates n samples of the Dirichlet distribution
+func (d *Dirichlet) GenerateN(n int) [][]float64 {
+	res := make([][]float64, n)
+	rng := d.rng()
+	for i := range res {
+		res[i] = d.Rand(rng)
+	}
+	return res
+}
+
+// Rand creates one sample of the Dirichlet distribution using the given generator
+// Algorithm:
+//		y_i ~ Γ(α_i, 1)
+//		x_i = y_i / Σ y_j
+//
+func (d *Dirichlet) Rand(r *rand.Rand) []float64 {
+	x := make([]float64, len(d.Alpha))
+	sum := 0.0
+	for i, a := range d.Alpha {
+		g := &Gamma{Alpha: a, Beta: 1}
+		x[i] = g.Rand(r)
+		sum += x[i]
+	}
+	for i := range x {
+		x[i] /= sum
+	}
+	return x
+}
+
+// PDF returns the probability density function value of a given point of the simplex
+func (d *Dirichlet) PDF(x []float64) float64 {
+	return math.Exp(d.LogPDF(x))
+}
+
+// LogPDF returns the log of the probability density function value of a given point of the simplex
+func (d *Dirichlet) LogPDF(x []float64) float64 {
+	if len(x) != len(d.Alpha) {
+		return math.Inf(-1)
+	}
+	res, sum := 0.0, 0.0
+	for i, v := range x {
+		if v < 0 || v > 1 {
+			return math.Inf(-1)
+		}
+		res += xlogy(d.Alpha[i]-1, v) - specfun.LogGamma(d.Alpha[i])
+		sum += v
+	}
+	if math.Abs(sum-1) > 1e-9 {
+		return math.Inf(-1)
+	}
+	return res + specfun.LogGamma(d.alpha0())
+}
+
+// Marginal returns the Beta distribution of the i-th component
+func (d *Dirichlet) Marginal(i int) *Beta {
+	return &Beta{Alpha: d.Alpha[i], Beta: d.alpha0() - d.Alpha[i]}
+}
+
+// Mean returns the mean of each component
+func (d *Dirichlet) Mean() []float64 {
+	a0 := d.alpha0()
+	res := make([]float64, len(d.Alpha))
+	for i, a := range d.Alpha {
+		res[i] = a / a0
+	}
+	return res
+}
+
+// Mode returns the mode of the distribution, defined when every α_i > 1
+func (d *Dirichlet) Mode() []float64 {
+	k := float64(len(d.Alpha))
+	a0 := d.alpha0()
+	res := make([]float64, len(d.Alpha))
+	for i, a := range d.Alpha {
+		if a <= 1 {
+			res[i] = math.NaN()
+			continue
+		}
+		res[i] = (a - 1) / (a0 - k)
+	}
+	return res
+}
+
+// Var returns the variance of each component
+func (d *Dirichlet) Var() []float64 {
+	a0 := d.alpha0()
+	res := make([]float64, len(d.Alpha))
+	for i, a := range d.Alpha {
+		res[i] = a * (a0 - a) / (a0 * a0 * (a0 + 1))
+	}
+	return res
+}
+
+// Cov returns the covariance matrix of the components
+//		Cov(X_i, X_j) = (δ_ij α_i/α_0 - α_i α_j/α_0^2) / (α_0 + 1)
+//
+func (d *Dirichlet) Cov() *matrix.Matrixf64 {
+	k := len(d.Alpha)
+	a0 := d.alpha0()
+	res := &matrix.Matrixf64{}
+	res.Init(k, k)
+	for i := 0; i < k; i++ {
+		for j := 0; j < k; j++ {
+			res.Data[i][j] = -d.Alpha[i] * d.Alpha[j] / (a0 * a0 * (a0 + 1))
+		}
+		res.Data[i][i] += d.Alpha[i] / (a0 * (a0 + 1))
+	}
+	return res
+}
+
+// Entropy returns the Entropy of the distribution
+//		H = log B(α) + (α_0 - k)ψ(α_0) - Σ (α_i - 1)ψ(α_i)
+//
+func (d *Dirichlet) Entropy() float64 {
+	k := float64(len(d.Alpha))
+	a0 := d.alpha0()
+	res := -specfun.LogGamma(a0) + (a0-k)*specfun.Digamma(a0)
+	for _, a := range d.Alpha {
+		res += specfun.LogGamma(a) - (a-1)*specfun.Digamma(a)
+	}
+	return res
+}
+
+// Summary returns the properties of the distribution
+func (d *Dirichlet) Summary() *DistSummary {
+	return summariseMultivariate("dirichlet", 0, 1, d.Mean(), d.Cov(), d.Entropy(), vectorParams("alpha", d.Alpha)...)
+}
@@ -0,0 +1,72 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+func TestDirichlet(t *testing.T) {
+	dist := &Dirichlet{}
+	if err := dist.Init([]float64{.5, 2, 3.5}); err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(dist.Summary())
+
+	// Two components reduce to the Beta distribution
+	beta := &Dirichlet{}
+	beta.Init([]float64{2.5, 4})
+	for _, x := range []float64{.01, .2, .5, .9} {
+		expected := (&Beta{Alpha: 2.5, Beta: 4}).PDF(x)
+		if v := beta.PDF([]float64{x, 1 - x}); math.Abs(v-expected) > 1e-12 {
+			t.Errorf("f(%f) = %f, expected %f", x, v, expected)
+		}
+	}
+	for _, x := range [][]float64{{.5, .5}, {.2, .2, .2}, {-.1, .6, .5}} {
+		if v := dist.PDF(x); v != 0 {
+			t.Errorf("f(%v) = %f, expected 0", x, v)
+		}
+	}
+
+	// Sample moments match the mean and the covariance matrix
+	r := rand.New(rand.NewSource(1))
+	n := 50000
+	mean := make([]float64, 3)
+	cov := [3][3]float64{}
+	for i := 0; i < n; i++ {
+		x := dist.Rand(r)
+		for j := range x {
+			mean[j] += x[j] / float64(n)
+			for l := range x {
+				cov[j][l] += x[j] * x[l] / float64(n)
+			}
+		}
+	}
+	expectedMean, expectedCov := dist.Mean(), dist.Cov()
+	for j := range mean {
+		if math.Abs(mean[j]-expectedMean[j]) > 3e-3 {
+			t.Errorf("mean[%d] = %f, expected %f", j, mean[j], expectedMean[j])
+		}
+		for l := range mean {
+			c := cov[j][l] - mean[j]*mean[l]
+			if e := *expectedCov.At(j, l); math.Abs(c-e) > 1e-3 {
+				t.Errorf("cov[%d][%d] = %f, expected %f", j, l, c, e)
+			}
+		}
+	}
+	if v, e := dist.Marginal(1).Var(), dist.Var()[1]; math.Abs(v-e) > 1e-12 {
+		t.Errorf("marginal variance = %f, expected %f", v, e)
+	}
+	if v, e := beta.Entropy(), (&Beta{Alpha: 2.5, Beta: 4}).Entropy(); math.Abs(v-e) > 1e-12 {
+		t.Errorf("H = %f, expected %f", v, e)
+	}
+
+	for _, alpha := range [][]float64{{1}, {1, 0}, {1, -2, 3}, {1, math.NaN()}} {
+		if err := (&Dirichlet{}).Init(alpha); err != util.ErrDirichletParam {
+			t.Errorf("Dir(%v): expected %v, got %v", alpha, util.ErrDirichletParam, err)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package dist
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// DiscreteUniform represents the discrete Uniform distribution over the
+// integers of [a, b]
+// Discreet probability distribution function as follows:
+//		X ~ U{a, b}, a <= b
+//		f(k,a,b) = {
+//			1 / (b - a + 1)
+//		}, k in [a, ..., b]
+//
+type DiscreteUniform struct {
+	source
+	A, B float64
+}
+
+// Init intialises a discrete Uniform distribution
+func (u *DiscreteUniform) Init(a, b float64) error {
+	if b < a || a != math.Floor(a) || b != math.Floor(b) {
+		return util.ErrDiscreteUniformParam
+	}
+	u.A, u.B = a, b
+	return nil
+}
+
+// size returns the number of values of the domain
+func (u *DiscreteUniform) size() float64 {
+	return u.B - u.A + 1
+}
+
+// Generate creates one sample of the discrete Uniform distribution
+func (u *DiscreteUniform) Generate() float64 {
+	return u.Rand(u.rng())
+}
+
+// GenerateN creates n samples of the discrete Uniform distribution
+func (u *DiscreteUniform) GenerateN(n int) []float64 {
+	return GenerateN(u, u.rng(), n)
+}
+
+// Fill fills dst with samples of the discrete Uniform distribution
+func (u *DiscreteUniform) Fill(dst []float64) {
+	Fill(u, u.rng(), dst)
+}
+
+// Rand creates one sample of the discrete Uniform distribution using the given generator
+func (u *DiscreteUniform) Rand(r *rand.Rand) float64 {
+	return u.A + float64(r.Int63n(int64(u.size())))
+}
+
+// Domain returns the definition domain of the distribution
+func (u *DiscreteUniform) Domain() (float64, float64) {
+	return u.A, u.B
+}
+
+// PMF returns the probability mass function value of a given k
+func (u *DiscreteUniform) PMF(k float64) float64 {
+	if k < u.A || k > u.B || k != math.Floor(k) {
+		return 0
+	}
+	return 1 / u.size()
+}
+
+// LogPMF returns the log of the probability mass function value of a given k
+func (u *DiscreteUniform) LogPMF(k float64) float64 {
+	return math.Log(u.PMF(k))
+}
+
+// CDF returns the Cumulative distribution function value of a given k
+func (u *DiscreteUniform) CDF(k float64) float64 {
+	if k < u.A {
+		return 0
+	}
+	if k >= u.B {
+		return 1
+	}
+	return (math.Floor(k) - u.A + 1) / u.size()
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given k
+func (u *DiscreteUniform) LogCDF(k float64) float64 {
+	return math.Log(u.CDF(k))
+}
+
+// Survival returns the survival function value of a given k
+func (u *DiscreteUniform) Survival(k float64) float64 {
+	if k < u.A {
+		return 1
+	}
+	if k >= u.B {
+		return 0
+	}
+	return (u.B - math.Floor(k)) / u.size()
+}
+
+// LogSurvival returns the log of the survival function value of a given k
+func (u *DiscreteUniform) LogSurvival(k float64) float64 {
+	return math.Log(u.Survival(k))
+}
+
+// Quantile returns the p-th quantile of the distribution
+func (u *DiscreteUniform) Quantile(p float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	return math.Max(u.A, u.A-1+math.Ceil(p*quantileFuzz*u.size()))
+}
+
+// Mean returns the mean of the distribution
+func (u *DiscreteUniform) Mean() float64 {
+	return (u.A + u.B) / 2
+}
+
+// Median returns the median of the distribution
+func (u *DiscreteUniform) Median() float64 {
+	return u.Quantile(.5)
+}
+
+// Var returns the variance of the distribution
+func (u *DiscreteUniform) Var() float64 {
+	n := u.size()
+	return (n*n - 1) / 12
+}
+
+// Skewness returns the Pearson's moment coefficient of skewness of the distribution
+func (u *DiscreteUniform) Skewness() float64 {
+	return 0
+}
+
+// Kurtosis returns the Kurtosis of the distribution
+func (u *DiscreteUniform) Kurtosis() float64 {
+	n := u.size()
+	return -6 * (n*n + 1) / (5 * (n*n - 1))
+}
+
+// Entropy returns the Entropy of the distribution
+func (u *DiscreteUniform) Entropy() float64 {
+	return math.Log(u.size())
+}
+
+// Moment returns the t-th moment of the distribution
+func (u *DiscreteUniform) Moment(t float64) float64 {
+	if t == 0 {
+		return 1
+	}
+	return (math.Exp(u.A*t) - math.Exp((u.B+1)*t)) / (u.size() * -math.Expm1(t))
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (u *DiscreteUniform) RawMoment(k int) float64 {
+	return RawMoment(u, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (u *DiscreteUniform) CentralMoment(k int) float64 {
+	return CentralMoment(u, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (u *DiscreteUniform) Cumulant(k int) float64 {
+	return Cumulant(u, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (u *DiscreteUniform) CF(t float64) complex128 {
+	return CF(u, t)
+}
+
+// Summary returns the properties of the distribution
+func (u *DiscreteUniform) Summary() *DistSummary {
+	return summarise(u, "discreteuniform", Param{"a", u.A}, Param{"b", u.B})
+}
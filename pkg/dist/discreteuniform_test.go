@@ -0,0 +1,40 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestDiscreteUniform(t *testing.T) {
+	dist := &DiscreteUniform{}
+	if err := dist.Init(-3, 7); err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(dist.Summary())
+
+	moments := []struct {
+		Name     string
+		Actual   float64
+		Expected float64
+	}{
+		{"mean", centralSum(dist, 1, 0), dist.Mean()},
+		{"var", centralSum(dist, 2, dist.Mean()), dist.Var()},
+		{"kurtosis", centralSum(dist, 4, dist.Mean())/math.Pow(dist.Var(), 2) - 3, dist.Kurtosis()},
+		{"mgf(.3)", expectedMgf(dist, .3), dist.Moment(.3)},
+		{"mgf(-.3)", expectedMgf(dist, -.3), dist.Moment(-.3)},
+	}
+	for _, m := range moments {
+		if math.Abs(m.Actual-m.Expected) > 1e-12*math.Max(1, math.Abs(m.Expected)) {
+			t.Errorf("%s %f, expected %f", m.Name, m.Expected, m.Actual)
+		}
+	}
+	for i := 0; i < 1000; i++ {
+		if v := dist.Generate(); v < -3 || v > 7 || v != math.Floor(v) {
+			t.Fatalf("generated %f outside of the domain", v)
+		}
+	}
+	if err := dist.Init(2, 1.5); err == nil {
+		t.Errorf("expected error for b < a")
+	}
+}
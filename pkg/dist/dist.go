@@ -0,0 +1,97 @@
+package dist
+
+import (
+	"math/rand"
+)
+
+// Distribution groups the methods shared by every probability
+// distribution of the package, whether continuous or discrete.
+type Distribution interface {
+	// Domain returns the definition domain of the distribution
+	Domain() (float64, float64)
+	// CDF returns the Cumulative distribution function value of a given x
+	CDF(x float64) float64
+	// LogCDF returns the log of the Cumulative distribution function value of a given x
+	LogCDF(x float64) float64
+	// Survival returns the survival function value (1 - CDF) of a given x
+	Survival(x float64) float64
+	// LogSurvival returns the log of the survival function value of a given x
+	LogSurvival(x float64) float64
+	// Quantile returns the p-th quantile of the distribution
+	Quantile(p float64) float64
+	// Mean returns the mean of the distribution
+	Mean() float64
+	// Var returns the variance of the distribution
+	Var() float64
+	// Generate creates one sample of the distribution using the random
+	// source of the distribution
+	Generate() float64
+	// Rand creates one sample of the distribution using the given generator
+	Rand(r *rand.Rand) float64
+}
+
+// Continuous is implemented by every continuous distribution of the package
+type Continuous interface {
+	Distribution
+	// PDF returns the probability density function value of a given x
+	PDF(x float64) float64
+	// LogPDF returns the log of the probability density function value of a given x
+	LogPDF(x float64) float64
+}
+
+// Discrete is implemented by every discrete distribution of the package
+type Discrete interface {
+	Distribution
+	// PMF returns the probability mass function value of a given k
+	PMF(k float64) float64
+	// LogPMF returns the log of the probability mass function value of a given k
+	LogPMF(k float64) float64
+}
+
+var (
+	_ Continuous = (*Normal)(nil)
+	_ Continuous = (*Gamma)(nil)
+	_ Continuous = (*Chisq)(nil)
+	_ Continuous = (*Exponential)(nil)
+	_ Continuous = (*Triangular)(nil)
+	_ Continuous = (*Uniform)(nil)
+	_ Continuous = (*StudentT)(nil)
+	_ Continuous = (*FisherF)(nil)
+	_ Continuous = (*Beta)(nil)
+	_ Continuous = (*LogNormal)(nil)
+	_ Continuous = (*Weibull)(nil)
+	_ Continuous = (*Pareto)(nil)
+	_ Continuous = (*Lomax)(nil)
+	_ Continuous = (*Cauchy)(nil)
+	_ Continuous = (*Laplace)(nil)
+	_ Continuous = (*Logistic)(nil)
+	_ Continuous = (*Gumbel)(nil)
+	_ Continuous = (*GumbelMin)(nil)
+	_ Continuous = (*NoncentralT)(nil)
+	_ Continuous = (*LocationScaleT)(nil)
+	_ Continuous = (*Mixture)(nil)
+	_ Continuous = (*Truncated)(nil)
+	_ Continuous = (*Empirical)(nil)
+	_ Continuous = (*KDE)(nil)
+	_ Continuous = (*Affine)(nil)
+	_ Continuous = (*Maximum)(nil)
+	_ Continuous = (*Minimum)(nil)
+
+	_ Discrete = (*Bernoulli)(nil)
+	_ Discrete = (*Binomial)(nil)
+	_ Discrete = (*Geometric)(nil)
+	_ Discrete = (*Poisson)(nil)
+	_ Discrete = (*Polya)(nil)
+	_ Discrete = (*Hypergeometric)(nil)
+	_ Discrete = (*DiscreteUniform)(nil)
+	_ Discrete = (*Categorical)(nil)
+	_ Discrete = (*BetaBinomial)(nil)
+	_ Discrete = (*Mixture)(nil)
+	_ Discrete = (*Truncated)(nil)
+	_ Discrete = (*Empirical)(nil)
+	_ Discrete = (*Affine)(nil)
+	_ Discrete = (*Maximum)(nil)
+	_ Discrete = (*Minimum)(nil)
+
+	_ Distribution = (*Censored)(nil)
+)
@@ -0,0 +1,375 @@
+package dist
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/ichbinfrog/statistics/pkg/array"
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// Empirical represents the empirical distribution of a set of observations,
+// each of the n sorted observations x_(1) <= ... <= x_(n) holding a mass of 1/n
+// Probability distribution function as follows:
+//		F(x) = #{x_(i) <= x} / n
+//
+// When Interpolate is set, the cdf is instead linearly interpolated between
+// the points (x_(i), (i - 1)/(n - 1)), making the distribution continuous
+// over [x_(1), x_(n)] apart from tied observations, which keep a point mass:
+//		F(x) = (i - 1 + (x - x_(i)) / (x_(i+1) - x_(i))) / (n - 1), x_(i) <= x < x_(i+1)
+//
+// PMF always returns the masses of the observations and PDF the density
+// of the interpolated cdf, so that Empirical implements both interfaces.
+type Empirical struct {
+	source
+	// Data holds the sorted observations
+	Data        []float64
+	Interpolate bool
+}
+
+// Init intialises an Empirical distribution from the observations of the array
+func (e *Empirical) Init(a *array.Arrayf64) error {
+	if a == nil || len(a.Data) == 0 {
+		return util.ErrEmptyArray
+	}
+	e.Data = make([]float64, len(a.Data))
+	copy(e.Data, a.Data)
+	return nil
+}
+
+// n returns the number of observations
+func (e *Empirical) n() float64 {
+	return float64(len(e.Data))
+}
+
+// interpolated returns true when the interpolated cdf is used, which
+// requires at least two distinct observations
+func (e *Empirical) interpolated() bool {
+	return e.Interpolate && e.Data[0] < e.Data[len(e.Data)-1]
+}
+
+// count returns #{x_(i) <= x}
+// Complexity: O(log(n))
+//
+func (e *Empirical) count(x float64) int {
+	return sort.Search(len(e.Data), func(i int) bool { return e.Data[i] > x })
+}
+
+// Generate creates one sample of the Empirical distribution
+func (e *Empirical) Generate() float64 {
+	return e.Rand(e.rng())
+}
+
+// GenerateN creates n samples of the Empirical distribution
+func (e *Empirical) GenerateN(n int) []float64 {
+	return GenerateN(e, e.rng(), n)
+}
+
+// Fill fills dst with samples of the Empirical distribution
+func (e *Empirical) Fill(dst []float64) {
+	Fill(e, e.rng(), dst)
+}
+
+// Rand creates one sample of the Empirical distribution using the given generator
+// Algorithm:
+//		Pick one of the observations uniformly (sampling with replacement),
+//		or use the inverse transform of the interpolated cdf
+//
+// Complexity: O(1)
+//
+func (e *Empirical) Rand(r *rand.Rand) float64 {
+	if e.interpolated() {
+		return e.Quantile(r.Float64())
+	}
+	return e.Data[r.Intn(len(e.Data))]
+}
+
+// Resample returns n observations drawn with replacement, as used by
+// the bootstrap
+func (e *Empirical) Resample(r *rand.Rand, n int) []float64 {
+	res := make([]float64, n)
+	for i := range res {
+		res[i] = e.Data[r.Intn(len(e.Data))]
+	}
+	return res
+}
+
+// Domain returns the definition domain of the distribution
+func (e *Empirical) Domain() (float64, float64) {
+	return e.Data[0], e.Data[len(e.Data)-1]
+}
+
+// PMF returns the proportion of observations equal to k
+// Complexity: O(log(n))
+//
+func (e *Empirical) PMF(k float64) float64 {
+	lo := sort.SearchFloat64s(e.Data, k)
+	return float64(e.count(k)-lo) / e.n()
+}
+
+// LogPMF returns the log of the proportion of observations equal to k
+func (e *Empirical) LogPMF(k float64) float64 {
+	return math.Log(e.PMF(k))
+}
+
+// PDF returns the density of the interpolated cdf at a given x
+//		f(x) = 1 / ((n - 1)(x_(i+1) - x_(i))), x_(i) <= x < x_(i+1)
+//
+// Complexity: O(log(n))
+//
+func (e *Empirical) PDF(x float64) float64 {
+	dbeg, dend := e.Domain()
+	if x < dbeg || x > dend || !(dend > dbeg) {
+		return 0
+	}
+	i := e.count(x) - 1
+	if i == len(e.Data)-1 {
+		// The density is right continuous but the domain is closed
+		i = sort.SearchFloat64s(e.Data, x) - 1
+	}
+	return 1 / ((e.n() - 1) * (e.Data[i+1] - e.Data[i]))
+}
+
+// LogPDF returns the log of the density of the interpolated cdf at a given x
+func (e *Empirical) LogPDF(x float64) float64 {
+	return math.Log(e.PDF(x))
+}
+
+// CDF returns the Cumulative distribution function value of a given x
+// Complexity: O(log(n))
+//
+func (e *Empirical) CDF(x float64) float64 {
+	j := e.count(x)
+	if !e.interpolated() || j == 0 || j == len(e.Data) {
+		return float64(j) / e.n()
+	}
+	lo, hi := e.Data[j-1], e.Data[j]
+	return (float64(j-1) + (x-lo)/(hi-lo)) / (e.n() - 1)
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given x
+func (e *Empirical) LogCDF(x float64) float64 {
+	return math.Log(e.CDF(x))
+}
+
+// Survival returns the survival function value of a given x
+func (e *Empirical) Survival(x float64) float64 {
+	j := e.count(x)
+	if !e.interpolated() || j == 0 || j == len(e.Data) {
+		return float64(len(e.Data)-j) / e.n()
+	}
+	lo, hi := e.Data[j-1], e.Data[j]
+	return (float64(len(e.Data)-j-1) + (hi-x)/(hi-lo)) / (e.n() - 1)
+}
+
+// LogSurvival returns the log of the survival function value of a given x
+func (e *Empirical) LogSurvival(x float64) float64 {
+	return math.Log(e.Survival(x))
+}
+
+// Quantile returns the p-th quantile of the distribution
+// Algorithm:
+//		Q(p) = x_(max(1, ceil(np))) for the step cdf
+//		Q(p) = x_(h) + (h - floor(h))(x_(h+1) - x_(h)), h = 1 + (n - 1)p
+//		for the interpolated cdf
+//
+// HYNDMAN, Rob J., FAN, Yanan. Sample quantiles in statistical packages. 1996.
+// Complexity: O(1)
+//
+func (e *Empirical) Quantile(p float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	if !e.interpolated() {
+		i := int(math.Ceil(p*quantileFuzz*e.n())) - 1
+		return e.Data[int(math.Max(0, float64(i)))]
+	}
+	h := (e.n() - 1) * p
+	i := int(math.Floor(h))
+	if i >= len(e.Data)-1 {
+		return e.Data[len(e.Data)-1]
+	}
+	return e.Data[i] + (h-float64(i))*(e.Data[i+1]-e.Data[i])
+}
+
+// ConfidenceBand returns the bounds of the Dvoretzky–Kiefer–Wolfowitz
+// confidence band of level 1 - alpha around the cdf at a given x
+//		P(sup |F(x) - F_true(x)| > ε) <= alpha, ε = sqrt(log(2 / alpha) / 2n)
+//
+// MASSART, Pascal. The tight constant in the Dvoretzky-Kiefer-Wolfowitz inequality. 1990.
+//
+func (e *Empirical) ConfidenceBand(x, alpha float64) (float64, float64) {
+	eps := math.Sqrt(math.Log(2/alpha) / (2 * e.n()))
+	f := e.CDF(x)
+	return math.Max(0, f-eps), math.Min(1, f+eps)
+}
+
+// momentAbout returns E[(X - c)^k], the interpolated distribution being
+// uniform with mass 1/(n - 1) between consecutive observations
+func (e *Empirical) momentAbout(c, k float64) float64 {
+	sum := 0.0
+	if !e.interpolated() {
+		for _, v := range e.Data {
+			sum += math.Pow(v-c, k)
+		}
+		return sum / e.n()
+	}
+	for i := 0; i < len(e.Data)-1; i++ {
+		a, b := e.Data[i]-c, e.Data[i+1]-c
+		if a == b {
+			sum += math.Pow(a, k)
+			continue
+		}
+		sum += (math.Pow(b, k+1) - math.Pow(a, k+1)) / ((k + 1) * (b - a))
+	}
+	return sum / (e.n() - 1)
+}
+
+// Mean returns the mean of the distribution
+func (e *Empirical) Mean() float64 {
+	return e.momentAbout(0, 1)
+}
+
+// Median returns the median of the distribution
+func (e *Empirical) Median() float64 {
+	return e.Quantile(.5)
+}
+
+// Var returns the variance of the distribution
+func (e *Empirical) Var() float64 {
+	return e.momentAbout(e.Mean(), 2)
+}
+
+// Skewness returns the Pearson's moment coefficient of skewness of the distribution
+func (e *Empirical) Skewness() float64 {
+	return e.momentAbout(e.Mean(), 3) / math.Pow(e.Var(), 1.5)
+}
+
+// Kurtosis returns the Kurtosis of the distribution
+func (e *Empirical) Kurtosis() float64 {
+	return e.momentAbout(e.Mean(), 4)/math.Pow(e.Var(), 2) - 3
+}
+
+// Entropy returns the Entropy of the distribution, the differential
+// entropy of the interpolated one
+func (e *Empirical) Entropy() float64 {
+	sum := 0.0
+	if !e.interpolated() {
+		for i := 0; i < len(e.Data); {
+			j := e.count(e.Data[i])
+			p := float64(j-i) / e.n()
+			sum -= p * math.Log(p)
+			i = j
+		}
+		return sum
+	}
+	w := 1 / (e.n() - 1)
+	for i := 0; i < len(e.Data)-1; i++ {
+		if d := e.Data[i+1] - e.Data[i]; d > 0 {
+			sum += w * math.Log(d/w)
+		}
+	}
+	return sum
+}
+
+// Moment returns the t-th moment of the distribution
+func (e *Empirical) Moment(t float64) float64 {
+	if t == 0 {
+		return 1
+	}
+	sum := 0.0
+	if !e.interpolated() {
+		for _, v := range e.Data {
+			sum += math.Exp(t * v)
+		}
+		return sum / e.n()
+	}
+	for i := 0; i < len(e.Data)-1; i++ {
+		a, b := e.Data[i], e.Data[i+1]
+		if a == b {
+			sum += math.Exp(t * a)
+			continue
+		}
+		sum += (math.Exp(t*b) - math.Exp(t*a)) / (t * (b - a))
+	}
+	return sum / (e.n() - 1)
+}
+
+// expectation returns E[g(X)], the interpolated distribution being
+// uniform with mass 1/(n - 1) between consecutive observations
+func (e *Empirical) expectation(g func(float64) float64) float64 {
+	sum := 0.0
+	if !e.interpolated() {
+		for _, v := range e.Data {
+			sum += g(v)
+		}
+		return sum / e.n()
+	}
+	for i := 0; i < len(e.Data)-1; i++ {
+		a, b := e.Data[i], e.Data[i+1]
+		if a == b {
+			sum += g(a)
+			continue
+		}
+		sum += tanhSinh(g, a, b) / (b - a)
+	}
+	return sum / (e.n() - 1)
+}
+
+// rawMoment returns E[X^k]
+func (e *Empirical) rawMoment(k int) float64 {
+	return e.momentAbout(0, float64(k))
+}
+
+// centralMoment returns E[(X - E[X])^k]
+func (e *Empirical) centralMoment(k int) float64 {
+	return e.momentAbout(e.Mean(), float64(k))
+}
+
+// cf returns the characteristic function of the distribution at t, the
+// interpolated distribution being uniform with mass 1/(n - 1) between
+// consecutive observations
+func (e *Empirical) cf(t float64) complex128 {
+	var sum complex128
+	if !e.interpolated() {
+		for _, v := range e.Data {
+			sum += expi(t * v)
+		}
+		return sum / complex(e.n(), 0)
+	}
+	for i := 0; i < len(e.Data)-1; i++ {
+		a, b := e.Data[i], e.Data[i+1]
+		if a == b {
+			sum += expi(t * a)
+			continue
+		}
+		sum += (expi(t*b) - expi(t*a)) / complex(0, t*(b-a))
+	}
+	return sum / complex(e.n()-1, 0)
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (e *Empirical) RawMoment(k int) float64 {
+	return RawMoment(e, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (e *Empirical) CentralMoment(k int) float64 {
+	return CentralMoment(e, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (e *Empirical) Cumulant(k int) float64 {
+	return Cumulant(e, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (e *Empirical) CF(t float64) complex128 {
+	return CF(e, t)
+}
+
+// Summary returns the properties of the distribution
+func (e *Empirical) Summary() *DistSummary {
+	return summarise(e, "empirical", Param{"n", float64(len(e.Data))})
+}
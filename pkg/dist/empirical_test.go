@@ -0,0 +1,97 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/ichbinfrog/statistics/pkg/array"
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+func TestEmpirical(t *testing.T) {
+	a := &array.Arrayf64{}
+	a.Init(array.Optionf64{Degree: 2})
+	a.InsertSlice([]float64{3, 1, 4, 1, 5, 9, 2, 6})
+
+	dist := &Empirical{}
+	if err := dist.Init(a); err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(dist.Summary())
+
+	testCases := []struct {
+		Name     string
+		Value    float64
+		Expected float64
+	}{
+		{"F(.5)", dist.CDF(.5), 0},
+		{"F(1)", dist.CDF(1), .25},
+		{"F(4.5)", dist.CDF(4.5), .625},
+		{"F(9)", dist.CDF(9), 1},
+		{"S(4.5)", dist.Survival(4.5), .375},
+		{"f(1)", dist.PMF(1), .25},
+		{"f(1.5)", dist.PMF(1.5), 0},
+		{"Q(0)", dist.Quantile(0), 1},
+		{"Q(.25)", dist.Quantile(.25), 1},
+		{"Q(.26)", dist.Quantile(.26), 2},
+		{"Q(1)", dist.Quantile(1), 9},
+		{"E[X]", dist.Mean(), a.Mean()},
+		{"Var(X)", dist.Var(), a.Var() * 7 / 8},
+		{"H", dist.Entropy(), -.25*math.Log(.25) - 6*.125*math.Log(.125)},
+	}
+	for _, tc := range testCases {
+		if math.Abs(tc.Value-tc.Expected) > 1e-12 {
+			t.Errorf("%s = %f, expected %f", tc.Name, tc.Value, tc.Expected)
+		}
+	}
+
+	// The interpolated quantiles are those of R's and numpy's default method
+	dist.Interpolate = true
+	for _, c := range [][2]float64{{.3, 2.1}, {0, 1}, {1, 9}, {.5, 3.5}, {.95, 7.95}} {
+		if v := dist.Quantile(c[0]); math.Abs(v-c[1]) > 1e-12 {
+			t.Errorf("Q(%f) = %f, expected %f", c[0], v, c[1])
+		}
+		if v := dist.CDF(c[1]); c[0] > 0 && math.Abs(v-c[0]) > 1e-12 {
+			t.Errorf("F(%f) = %f, expected %f", c[1], v, c[0])
+		}
+	}
+	sum, mean, h := 0.0, 0.0, 1e-4
+	for x := h / 2; x < 10; x += h {
+		sum += dist.PDF(x) * h
+		mean += x * dist.PDF(x) * h
+	}
+	// The tied observations at 1 keep a point mass of 1/7
+	if math.Abs(sum+1.0/7-1) > 1e-3 || math.Abs(mean+1.0/7-dist.Mean()) > 1e-3 {
+		t.Errorf("∫f = %f, ∫xf = %f, expected 6/7 and %f", sum, mean, dist.Mean()-1.0/7)
+	}
+	if v := dist.CDF(4.5) + dist.Survival(4.5); math.Abs(v-1) > 1e-12 {
+		t.Errorf("F(4.5) + S(4.5) = %f", v)
+	}
+
+	// The true cdf lies within the DKW band
+	normal := &Normal{Mu: 2, Sigma: 3}
+	r := rand.New(rand.NewSource(1))
+	obs := &array.Arrayf64{}
+	obs.Init(array.Optionf64{Degree: 2})
+	for i := 0; i < 2000; i++ {
+		obs.Insert(normal.Rand(r))
+	}
+	ecdf := &Empirical{}
+	ecdf.Init(obs)
+	for x := -8.0; x <= 12; x += .25 {
+		if lo, hi := ecdf.ConfidenceBand(x, .01); normal.CDF(x) < lo || normal.CDF(x) > hi {
+			t.Errorf("F(%f) = %f outside of [%f, %f]", x, normal.CDF(x), lo, hi)
+		}
+	}
+	for _, v := range ecdf.Resample(r, 100) {
+		if ecdf.PMF(v) == 0 {
+			t.Fatalf("resampled value %f is not an observation", v)
+		}
+	}
+
+	if err := (&Empirical{}).Init(&array.Arrayf64{}); err != util.ErrEmptyArray {
+		t.Errorf("expected %v, got %v", util.ErrEmptyArray, err)
+	}
+}
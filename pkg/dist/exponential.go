@@ -1,10 +1,11 @@
 package dist
 
 import (
-	"fmt"
 	"math"
 	"math/rand"
 
+	"github.com/ichbinfrog/statistics/pkg/array"
+	"github.com/ichbinfrog/statistics/pkg/specfun"
 	"github.com/ichbinfrog/statistics/pkg/util"
 )
 
@@ -17,10 +18,11 @@ import (
 // 		}
 //
 type Exponential struct {
+	source
 	Lambda float64
 }
 
-// Init intialises a Bernoulli distribution
+// Init intialises an Exponential distribution
 func (e *Exponential) Init(lambda float64) error {
 	if lambda <= 0 {
 		return util.ErrExponentialParam
@@ -31,7 +33,27 @@ func (e *Exponential) Init(lambda float64) error {
 
 // Generate creates one sample of an exponential distribution
 func (e *Exponential) Generate() float64 {
-	return -math.Log(rand.Float64()) / e.Lambda
+	return e.Rand(e.rng())
+}
+
+// GenerateN creates n samples of the exponential distribution
+func (e *Exponential) GenerateN(n int) []float64 {
+	return GenerateN(e, e.rng(), n)
+}
+
+// Fill fills dst with samples of the exponential distribution
+func (e *Exponential) Fill(dst []float64) {
+	Fill(e, e.rng(), dst)
+}
+
+// Rand creates one sample of an exponential distribution using the given generator
+// Algorithm: ziggurat sampling of Exp(1) as done by rand.ExpFloat64, scaled by 1/λ,
+// which avoids computing a logarithm for most samples
+//
+// MARSAGLIA, George, TSANG, Wai Wan. The ziggurat method for generating random variables. 2000.
+//
+func (e *Exponential) Rand(r *rand.Rand) float64 {
+	return r.ExpFloat64() / e.Lambda
 }
 
 // Domain returns the definition domain of the distribution
@@ -39,14 +61,49 @@ func (e *Exponential) Domain() (float64, float64) {
 	return 0, math.Inf(0)
 }
 
-// PMF returns the probability mass function value of a given k
-func (e *Exponential) PMF(k float64) float64 {
-	return e.Lambda * math.Exp(-e.Lambda*k)
+// PDF returns the probability density function value of a given x
+func (e *Exponential) PDF(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	return e.Lambda * math.Exp(-e.Lambda*x)
 }
 
-// CDF returns the Cumulative distribution function value of a given k
-func (e *Exponential) CDF(k float64) float64 {
-	return 1 - math.Exp(-e.Lambda*k)
+// LogPDF returns the log of the probability density function value of a given x
+func (e *Exponential) LogPDF(x float64) float64 {
+	if x < 0 {
+		return math.Inf(-1)
+	}
+	return math.Log(e.Lambda) - e.Lambda*x
+}
+
+// CDF returns the Cumulative distribution function value of a given x
+func (e *Exponential) CDF(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	return -math.Expm1(-e.Lambda * x)
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given x
+func (e *Exponential) LogCDF(x float64) float64 {
+	return math.Log(e.CDF(x))
+}
+
+// Survival returns the survival function value of a given x
+func (e *Exponential) Survival(x float64) float64 {
+	if x < 0 {
+		return 1
+	}
+	return math.Exp(-e.Lambda * x)
+}
+
+// LogSurvival returns the log of the survival function value of a given x
+func (e *Exponential) LogSurvival(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	return -e.Lambda * x
 }
 
 // Mean returns the mean of the distribution
@@ -56,10 +113,10 @@ func (e *Exponential) Mean() float64 {
 
 // Quantile returns the p-th quantile of the distribution
 func (e *Exponential) Quantile(p float64) float64 {
-	if p >= 0 || p <= 1 {
-		return -math.Log(1-p) / e.Lambda
+	if !validProbability(p) {
+		return math.NaN()
 	}
-	return math.NaN()
+	return -math.Log1p(-p) / e.Lambda
 }
 
 // Median returns the median of the distribution
@@ -95,23 +152,74 @@ func (e *Exponential) Moment(t float64) float64 {
 	return math.NaN()
 }
 
+// rawMoment returns E[X^k] = k! / λ^k
+func (e *Exponential) rawMoment(k int) float64 {
+	return math.Exp(specfun.LogFactorial(float64(k)) - float64(k)*math.Log(e.Lambda))
+}
+
+// cumulant returns the k-th cumulant κ_k = (k-1)! / λ^k
+func (e *Exponential) cumulant(k int) float64 {
+	return math.Exp(specfun.LogFactorial(float64(k-1)) - float64(k)*math.Log(e.Lambda))
+}
+
+// cf returns the characteristic function of the distribution at t
+//		φ(t) = λ / (λ - it)
+//
+func (e *Exponential) cf(t float64) complex128 {
+	return complex(e.Lambda, 0) / complex(e.Lambda, -t)
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (e *Exponential) RawMoment(k int) float64 {
+	return RawMoment(e, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (e *Exponential) CentralMoment(k int) float64 {
+	return CentralMoment(e, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (e *Exponential) Cumulant(k int) float64 {
+	return Cumulant(e, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (e *Exponential) CF(t float64) complex128 {
+	return CF(e, t)
+}
+
 // FisherI returns the Fisher Information of the distribution
-func (e *Exponential) FisherI() float64 {
-	return 1 / math.Pow(e.Lambda, 2)
+func (e *Exponential) FisherI() [][]float64 {
+	return [][]float64{
+		[]float64{1 / math.Pow(e.Lambda, 2)},
+	}
+}
+
+// Summary returns the properties of the distribution
+func (e *Exponential) Summary() *DistSummary {
+	return summarise(e, "exponential", Param{"lambda", e.Lambda})
 }
 
-// Summary returns a string summarising basic info about the distribution
-func (e *Exponential) Summary() string {
-	dbeg, dend := e.Domain()
-	return fmt.Sprintf(`
-	X ~ ε(%f)
-		Domain:		{ %f , %f }
-		Mean: 		%f
-		Median: 	%f
-		Var: 		%f
-		Skewness: 	%f
-		Kurtosis:	%f
-		Entropy:	%f
-		FisherInfo:	%f
-`, e.Lambda, dbeg, dend, e.Mean(), e.Median(), e.Var(), e.Skewness(), e.Kurtosis(), e.Entropy(), e.FisherI())
+// FitExponential returns the maximum likelihood estimation of an
+// Exponential distribution from the given observations:
+//		λ = 1 / E[X]
+//
+// Complexity: O(1) if the array maintains a degree >= 1, O(n) otherwise
+//
+func FitExponential(a *array.Arrayf64) (*Exponential, *FitResult, error) {
+	if err := checkSample(a, 1, 0, math.Inf(0), false); err != nil {
+		return nil, nil, err
+	}
+	mean, _ := sampleMoments(a)
+
+	e := &Exponential{}
+	if err := e.Init(1 / mean); err != nil {
+		return nil, nil, err
+	}
+	return e, &FitResult{
+		LogLikelihood: a.Length * (math.Log(e.Lambda) - 1),
+		StdErr:        fisherStdErr(e.FisherI(), a.Length),
+		Observations:  a.Length,
+	}, nil
 }
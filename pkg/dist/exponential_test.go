@@ -2,7 +2,11 @@ package dist
 
 import (
 	"fmt"
+	"math"
+	"math/rand"
 	"testing"
+
+	"github.com/ichbinfrog/statistics/pkg/array"
 )
 
 func TestExponetial(t *testing.T) {
@@ -10,8 +14,8 @@ func TestExponetial(t *testing.T) {
 	dist.Init(10)
 	fmt.Println(dist.Summary())
 
-	fmt.Printf("		f(0) = %f", dist.PMF(0))
-	fmt.Printf("\n		f(1) = %f\n", dist.PMF(1))
+	fmt.Printf("		f(0) = %f", dist.PDF(0))
+	fmt.Printf("\n		f(1) = %f\n", dist.PDF(1))
 	fmt.Printf("\n		F(-1) = %f", dist.CDF(-1))
 	fmt.Printf("\n		F(.3) = %f", dist.CDF(.3))
 	fmt.Printf("\n		F(.5) = %f", dist.CDF(.5))
@@ -25,3 +29,34 @@ func TestExponetial(t *testing.T) {
 	}
 	fmt.Printf("\n	Generated slice: %v\n\n", sl)
 }
+
+func TestExponentialRand(t *testing.T) {
+	dist := &Exponential{}
+	dist.Init(3)
+	a := &array.Arrayf64{}
+	a.Init(array.Optionf64{})
+	a.InsertSlice(GenerateN(dist, rand.New(rand.NewSource(2)), 20000))
+	if _, p := KolmogorovSmirnov(a, dist); p < 1e-3 {
+		t.Errorf("samples do not follow Exp(3), p-value %g", p)
+	}
+}
+
+func BenchmarkExponentialRand(b *testing.B) {
+	dist := &Exponential{}
+	dist.Init(3)
+	dst := make([]float64, 1000)
+	b.Run("ziggurat", func(b *testing.B) {
+		r := rand.New(rand.NewSource(1))
+		for i := 0; i < b.N; i++ {
+			Fill(dist, r, dst)
+		}
+	})
+	b.Run("inversion", func(b *testing.B) {
+		r := rand.New(rand.NewSource(1))
+		for i := 0; i < b.N; i++ {
+			for j := range dst {
+				dst[j] = -math.Log(r.Float64()) / dist.Lambda
+			}
+		}
+	})
+}
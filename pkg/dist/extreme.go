@@ -0,0 +1,437 @@
+package dist
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// checkExtreme returns an error unless n is a positive integer
+func checkExtreme(d Distribution, n float64) error {
+	if d == nil || n < 1 || n != math.Floor(n) || math.IsInf(n, 0) {
+		return util.ErrExtremeParam
+	}
+	return nil
+}
+
+// Maximum represents the distribution of the maximum of n independent
+// random variables following the same distribution
+// Probability distribution function as follows:
+//		M = max(X_1, ..., X_n), n >= 1
+//
+//		F_M(x) = F(x)^n
+//
+type Maximum struct {
+	source
+	Dist Distribution
+	N    float64
+}
+
+// Init intialises the distribution of the maximum of n variables following d
+func (m *Maximum) Init(d Distribution, n float64) error {
+	if err := checkExtreme(d, n); err != nil {
+		return err
+	}
+	m.Dist, m.N = d, n
+	return nil
+}
+
+// MaxOf returns the distribution of the maximum of n independent random
+// variables following d, exactly for the families closed under maxima
+//		max Gumbel(μ, σ) = Gumbel(μ + σ log(n), σ)
+// the other distributions being wrapped by Maximum.
+func MaxOf(d Distribution, n float64) (Distribution, error) {
+	if err := checkExtreme(d, n); err != nil {
+		return nil, err
+	}
+	if n == 1 {
+		return d, nil
+	}
+	if g, ok := d.(*Gumbel); ok {
+		return &Gumbel{Mu: g.Mu + g.Sigma*math.Log(n), Sigma: g.Sigma}, nil
+	}
+	return &Maximum{Dist: d, N: n}, nil
+}
+
+// Generate creates one sample of the distribution of the maximum
+func (m *Maximum) Generate() float64 {
+	return m.Rand(m.rng())
+}
+
+// GenerateN creates n samples of the distribution of the maximum
+func (m *Maximum) GenerateN(n int) []float64 {
+	return GenerateN(m, m.rng(), n)
+}
+
+// Fill fills dst with samples of the distribution of the maximum
+func (m *Maximum) Fill(dst []float64) {
+	Fill(m, m.rng(), dst)
+}
+
+// Rand creates one sample of the distribution of the maximum using the given generator
+// Algorithm: inverse transform sampling, M = Q(U^(1/n))
+//
+func (m *Maximum) Rand(r *rand.Rand) float64 {
+	return m.Quantile(r.Float64())
+}
+
+// Domain returns the definition domain of the distribution
+func (m *Maximum) Domain() (float64, float64) {
+	return m.Dist.Domain()
+}
+
+// PDF returns the probability density function value of a given x
+func (m *Maximum) PDF(x float64) float64 {
+	return math.Exp(m.LogPDF(x))
+}
+
+// LogPDF returns the log of the probability density function value of a given x
+//		f_M(x) = n f(x) F(x)^(n-1) for continuous distributions
+//		P(M = k) = F(k)^n - F(k-)^n for discrete ones
+//
+func (m *Maximum) LogPDF(x float64) float64 {
+	if IsDiscrete(m.Dist) {
+		hi := m.LogCDF(x)
+		lo := m.N * m.Dist.LogCDF(math.Nextafter(x, math.Inf(-1)))
+		return hi + math.Log1p(-math.Exp(lo-hi))
+	}
+	return math.Log(m.N) + logDensity(m.Dist, x) + (m.N-1)*m.Dist.LogCDF(x)
+}
+
+// PMF returns the probability mass function value of a given k
+func (m *Maximum) PMF(k float64) float64 {
+	return m.PDF(k)
+}
+
+// LogPMF returns the log of the probability mass function value of a given k
+func (m *Maximum) LogPMF(k float64) float64 {
+	return m.LogPDF(k)
+}
+
+// CDF returns the Cumulative distribution function value of a given x
+func (m *Maximum) CDF(x float64) float64 {
+	return math.Exp(m.LogCDF(x))
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given x
+func (m *Maximum) LogCDF(x float64) float64 {
+	return m.N * m.Dist.LogCDF(x)
+}
+
+// Survival returns the survival function value of a given x
+//		S_M(x) = 1 - (1 - S(x))^n
+//
+func (m *Maximum) Survival(x float64) float64 {
+	return -math.Expm1(m.N * math.Log1p(-m.Dist.Survival(x)))
+}
+
+// LogSurvival returns the log of the survival function value of a given x
+func (m *Maximum) LogSurvival(x float64) float64 {
+	return math.Log(m.Survival(x))
+}
+
+// Quantile returns the p-th quantile of the distribution
+//		Q_M(p) = Q(p^(1/n))
+//
+func (m *Maximum) Quantile(p float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	return m.Dist.Quantile(math.Pow(p, 1/m.N))
+}
+
+// expectation returns E[g(M)]
+func (m *Maximum) expectation(g func(float64) float64) float64 {
+	if IsDiscrete(m.Dist) {
+		dbeg, dend := m.Domain()
+		if !math.IsInf(dbeg, -1) {
+			return discreteSum(m.PMF, g, dbeg, dend, 1)
+		}
+	}
+	return quantileIntegral(m.Quantile, g, 0, 1)
+}
+
+// Mean returns the mean of the distribution
+func (m *Maximum) Mean() float64 {
+	if mean := m.Dist.Mean(); math.IsNaN(mean) || math.IsInf(mean, 0) {
+		return mean
+	}
+	return m.expectation(func(x float64) float64 { return x })
+}
+
+// Median returns the median of the distribution
+func (m *Maximum) Median() float64 {
+	return m.Quantile(.5)
+}
+
+// Var returns the variance of the distribution
+func (m *Maximum) Var() float64 {
+	if v := m.Dist.Var(); math.IsNaN(v) || math.IsInf(v, 0) {
+		return v
+	}
+	mean := m.Mean()
+	return m.expectation(func(x float64) float64 { return (x - mean) * (x - mean) })
+}
+
+// rawMoment returns E[M^k], that of the base distribution when it is not finite
+func (m *Maximum) rawMoment(k int) float64 {
+	if v := RawMoment(m.Dist, k); math.IsNaN(v) || math.IsInf(v, 0) {
+		return v
+	}
+	return m.expectation(func(x float64) float64 { return math.Pow(x, float64(k)) })
+}
+
+// centralMoment returns E[(M - E[M])^k], undefined when the raw moment is not finite
+func (m *Maximum) centralMoment(k int) float64 {
+	if v := m.rawMoment(k); math.IsNaN(v) || math.IsInf(v, 0) {
+		return v
+	}
+	mean := m.Mean()
+	return m.expectation(func(x float64) float64 { return math.Pow(x-mean, float64(k)) })
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (m *Maximum) RawMoment(k int) float64 {
+	return RawMoment(m, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (m *Maximum) CentralMoment(k int) float64 {
+	return CentralMoment(m, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (m *Maximum) Cumulant(k int) float64 {
+	return Cumulant(m, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (m *Maximum) CF(t float64) complex128 {
+	return CF(m, t)
+}
+
+// Summary returns the properties of the distribution
+func (m *Maximum) Summary() *DistSummary {
+	s := summarise(m, "maximum", Param{"n", m.N})
+	s.Components = []*DistSummary{summaryOf(m.Dist)}
+	return s
+}
+
+// Minimum represents the distribution of the minimum of n independent
+// random variables following the same distribution
+// Probability distribution function as follows:
+//		M = min(X_1, ..., X_n), n >= 1
+//
+//		S_M(x) = S(x)^n
+//
+type Minimum struct {
+	source
+	Dist Distribution
+	N    float64
+}
+
+// Init intialises the distribution of the minimum of n variables following d
+func (m *Minimum) Init(d Distribution, n float64) error {
+	if err := checkExtreme(d, n); err != nil {
+		return err
+	}
+	m.Dist, m.N = d, n
+	return nil
+}
+
+// MinOf returns the distribution of the minimum of n independent random
+// variables following d, exactly for the families closed under minima
+//		min Exp(λ) = Exp(nλ)
+//		min W(k, λ, θ) = W(k, λ n^(-1/k), θ)
+//		min Pareto(xm, α) = Pareto(xm, nα)
+//		min G(p) = G(1 - (1 - p)^n)
+//		min GumbelMin(μ, σ) = GumbelMin(μ - σ log(n), σ)
+// the other distributions being wrapped by Minimum.
+//
+func MinOf(d Distribution, n float64) (Distribution, error) {
+	if err := checkExtreme(d, n); err != nil {
+		return nil, err
+	}
+	if n == 1 {
+		return d, nil
+	}
+	switch v := d.(type) {
+	case *Exponential:
+		return &Exponential{Lambda: n * v.Lambda}, nil
+	case *Weibull:
+		return &Weibull{K: v.K, Lambda: v.Lambda * math.Pow(n, -1/v.K), Theta: v.Theta}, nil
+	case *Pareto:
+		return &Pareto{Xm: v.Xm, Alpha: n * v.Alpha}, nil
+	case *Geometric:
+		q := math.Exp(n * math.Log1p(-v.P))
+		return &Geometric{P: 1 - q, Q: q}, nil
+	case *GumbelMin:
+		return &GumbelMin{Mu: v.Mu - v.Sigma*math.Log(n), Sigma: v.Sigma}, nil
+	}
+	return &Minimum{Dist: d, N: n}, nil
+}
+
+// Generate creates one sample of the distribution of the minimum
+func (m *Minimum) Generate() float64 {
+	return m.Rand(m.rng())
+}
+
+// GenerateN creates n samples of the distribution of the minimum
+func (m *Minimum) GenerateN(n int) []float64 {
+	return GenerateN(m, m.rng(), n)
+}
+
+// Fill fills dst with samples of the distribution of the minimum
+func (m *Minimum) Fill(dst []float64) {
+	Fill(m, m.rng(), dst)
+}
+
+// Rand creates one sample of the distribution of the minimum using the given generator
+// Algorithm: inverse transform sampling, M = Q(1 - U^(1/n))
+//
+func (m *Minimum) Rand(r *rand.Rand) float64 {
+	return m.Quantile(r.Float64())
+}
+
+// Domain returns the definition domain of the distribution
+func (m *Minimum) Domain() (float64, float64) {
+	return m.Dist.Domain()
+}
+
+// PDF returns the probability density function value of a given x
+func (m *Minimum) PDF(x float64) float64 {
+	return math.Exp(m.LogPDF(x))
+}
+
+// LogPDF returns the log of the probability density function value of a given x
+//		f_M(x) = n f(x) S(x)^(n-1) for continuous distributions
+//		P(M = k) = S(k-)^n - S(k)^n for discrete ones
+//
+func (m *Minimum) LogPDF(x float64) float64 {
+	if IsDiscrete(m.Dist) {
+		hi := m.N * m.Dist.LogSurvival(math.Nextafter(x, math.Inf(-1)))
+		lo := m.LogSurvival(x)
+		return hi + math.Log1p(-math.Exp(lo-hi))
+	}
+	return math.Log(m.N) + logDensity(m.Dist, x) + (m.N-1)*m.Dist.LogSurvival(x)
+}
+
+// PMF returns the probability mass function value of a given k
+func (m *Minimum) PMF(k float64) float64 {
+	return m.PDF(k)
+}
+
+// LogPMF returns the log of the probability mass function value of a given k
+func (m *Minimum) LogPMF(k float64) float64 {
+	return m.LogPDF(k)
+}
+
+// CDF returns the Cumulative distribution function value of a given x
+//		F_M(x) = 1 - (1 - F(x))^n
+//
+func (m *Minimum) CDF(x float64) float64 {
+	return -math.Expm1(m.N * math.Log1p(-m.Dist.CDF(x)))
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given x
+func (m *Minimum) LogCDF(x float64) float64 {
+	return math.Log(m.CDF(x))
+}
+
+// Survival returns the survival function value of a given x
+func (m *Minimum) Survival(x float64) float64 {
+	return math.Exp(m.LogSurvival(x))
+}
+
+// LogSurvival returns the log of the survival function value of a given x
+func (m *Minimum) LogSurvival(x float64) float64 {
+	return m.N * m.Dist.LogSurvival(x)
+}
+
+// Quantile returns the p-th quantile of the distribution
+//		Q_M(p) = Q(1 - (1 - p)^(1/n))
+//
+func (m *Minimum) Quantile(p float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	return m.Dist.Quantile(-math.Expm1(math.Log1p(-p) / m.N))
+}
+
+// expectation returns E[g(M)]
+func (m *Minimum) expectation(g func(float64) float64) float64 {
+	if IsDiscrete(m.Dist) {
+		dbeg, dend := m.Domain()
+		if !math.IsInf(dbeg, -1) {
+			return discreteSum(m.PMF, g, dbeg, dend, 1)
+		}
+	}
+	return quantileIntegral(m.Quantile, g, 0, 1)
+}
+
+// Mean returns the mean of the distribution
+func (m *Minimum) Mean() float64 {
+	if mean := m.Dist.Mean(); math.IsNaN(mean) || math.IsInf(mean, 0) {
+		return mean
+	}
+	return m.expectation(func(x float64) float64 { return x })
+}
+
+// Median returns the median of the distribution
+func (m *Minimum) Median() float64 {
+	return m.Quantile(.5)
+}
+
+// Var returns the variance of the distribution
+func (m *Minimum) Var() float64 {
+	if v := m.Dist.Var(); math.IsNaN(v) || math.IsInf(v, 0) {
+		return v
+	}
+	mean := m.Mean()
+	return m.expectation(func(x float64) float64 { return (x - mean) * (x - mean) })
+}
+
+// rawMoment returns E[M^k], that of the base distribution when it is not finite
+func (m *Minimum) rawMoment(k int) float64 {
+	if v := RawMoment(m.Dist, k); math.IsNaN(v) || math.IsInf(v, 0) {
+		return v
+	}
+	return m.expectation(func(x float64) float64 { return math.Pow(x, float64(k)) })
+}
+
+// centralMoment returns E[(M - E[M])^k], undefined when the raw moment is not finite
+func (m *Minimum) centralMoment(k int) float64 {
+	if v := m.rawMoment(k); math.IsNaN(v) || math.IsInf(v, 0) {
+		return v
+	}
+	mean := m.Mean()
+	return m.expectation(func(x float64) float64 { return math.Pow(x-mean, float64(k)) })
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (m *Minimum) RawMoment(k int) float64 {
+	return RawMoment(m, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (m *Minimum) CentralMoment(k int) float64 {
+	return CentralMoment(m, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (m *Minimum) Cumulant(k int) float64 {
+	return Cumulant(m, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (m *Minimum) CF(t float64) complex128 {
+	return CF(m, t)
+}
+
+// Summary returns the properties of the distribution
+func (m *Minimum) Summary() *DistSummary {
+	s := summarise(m, "minimum", Param{"n", m.N})
+	s.Components = []*DistSummary{summaryOf(m.Dist)}
+	return s
+}
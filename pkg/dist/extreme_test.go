@@ -0,0 +1,151 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+func TestExtreme(t *testing.T) {
+	// The closed forms match the Maximum and Minimum wrappers
+	for _, tc := range []struct {
+		dist Distribution
+		n    float64
+		max  bool
+	}{
+		{&Gumbel{Mu: 1, Sigma: 2}, 5, true},
+		{&Exponential{Lambda: 2}, 3, false},
+		{&Weibull{K: 1.5, Lambda: 2, Theta: 1}, 4, false},
+		{&Pareto{Xm: 1, Alpha: 2}, 3, false},
+		{&Geometric{P: .3, Q: .7}, 4, false},
+		{&GumbelMin{Mu: 0, Sigma: 1}, 10, false},
+	} {
+		t.Run(fmt.Sprintf("%T(%g)", tc.dist, tc.n), func(t *testing.T) {
+			var exact, wrapped Distribution
+			var err error
+			if tc.max {
+				exact, err = MaxOf(tc.dist, tc.n)
+				wrapped = &Maximum{Dist: tc.dist, N: tc.n}
+			} else {
+				exact, err = MinOf(tc.dist, tc.n)
+				wrapped = &Minimum{Dist: tc.dist, N: tc.n}
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if fmt.Sprintf("%T", exact) != fmt.Sprintf("%T", tc.dist) {
+				t.Fatalf("expected a closed form, got %T", exact)
+			}
+			for _, p := range []float64{.01, .2, .5, .8, .99} {
+				x := wrapped.Quantile(p)
+				if v := exact.Quantile(p); math.Abs(v-x) > 1e-8*math.Max(1, math.Abs(x)) {
+					t.Errorf("Q(%f) = %f, expected %f", p, v, x)
+				}
+				if v, e := exact.CDF(x), wrapped.CDF(x); math.Abs(v-e) > 1e-10 {
+					t.Errorf("F(%f) = %f, expected %f", x, v, e)
+				}
+				if v, e := logDensity(exact, x), logDensity(wrapped, x); math.Abs(v-e) > 1e-9 {
+					t.Errorf("log f(%f) = %f, expected %f", x, v, e)
+				}
+			}
+		})
+	}
+
+	if d, _ := MaxOf(&Normal{Mu: 0, Sigma: 1}, 1); *d.(*Normal) != (Normal{Mu: 0, Sigma: 1}) {
+		t.Errorf("expected the distribution itself, got %+v", d)
+	}
+	if _, err := MinOf(&Normal{Mu: 0, Sigma: 1}, 2.5); err != util.ErrExtremeParam {
+		t.Errorf("expected %v, got %v", util.ErrExtremeParam, err)
+	}
+	if err := (&Maximum{}).Init(&Normal{Mu: 0, Sigma: 1}, 0); err != util.ErrExtremeParam {
+		t.Errorf("expected %v, got %v", util.ErrExtremeParam, err)
+	}
+}
+
+func TestMaximum(t *testing.T) {
+	// The maximum of n standard uniforms follows B(n, 1)
+	max := &Maximum{}
+	if err := max.Init(&Uniform{A: 0, B: 1}, 4); err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(max.Summary())
+	beta := &Beta{Alpha: 4, Beta: 1}
+	for _, x := range []float64{.1, .5, .9} {
+		if v, e := max.CDF(x), math.Pow(x, 4); math.Abs(v-e) > 1e-15 {
+			t.Errorf("F(%f) = %f, expected %f", x, v, e)
+		}
+		if v, e := max.PDF(x), beta.PDF(x); math.Abs(v-e) > 1e-12 {
+			t.Errorf("f(%f) = %f, expected %f", x, v, e)
+		}
+		if v, e := max.Survival(x), 1-math.Pow(x, 4); math.Abs(v-e) > 1e-15 {
+			t.Errorf("S(%f) = %f, expected %f", x, v, e)
+		}
+	}
+	if v, e := max.Mean(), beta.Mean(); math.Abs(v-e) > 1e-8 {
+		t.Errorf("E[M] = %f, expected %f", v, e)
+	}
+	if v, e := max.Var(), beta.Var(); math.Abs(v-e) > 1e-8 {
+		t.Errorf("Var(M) = %f, expected %f", v, e)
+	}
+
+	// Maximum of 3 dice
+	dice := &Maximum{}
+	dice.Init(&DiscreteUniform{A: 1, B: 6}, 3)
+	if !IsDiscrete(dice) {
+		t.Errorf("expected a discrete distribution")
+	}
+	mean := 0.0
+	for k := 1.0; k <= 6; k++ {
+		e := (math.Pow(k, 3) - math.Pow(k-1, 3)) / 216
+		mean += k * e
+		if v := dice.PMF(k); math.Abs(v-e) > 1e-14 {
+			t.Errorf("P(M = %g) = %g, expected %g", k, v, e)
+		}
+	}
+	if v := dice.Mean(); math.Abs(v-mean) > 1e-12 {
+		t.Errorf("E[M] = %f, expected %f", v, mean)
+	}
+	if q := dice.Quantile(.5); q != 5 {
+		t.Errorf("median %f, expected 5", q)
+	}
+}
+
+func TestMinimum(t *testing.T) {
+	// The minimum of n standard uniforms follows B(1, n)
+	min := &Minimum{}
+	if err := min.Init(&Uniform{A: 0, B: 1}, 3); err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(min.Summary())
+	beta := &Beta{Alpha: 1, Beta: 3}
+	for _, x := range []float64{.1, .5, .9} {
+		if v, e := min.CDF(x), beta.CDF(x); math.Abs(v-e) > 1e-12 {
+			t.Errorf("F(%f) = %f, expected %f", x, v, e)
+		}
+		if v, e := min.PDF(x), beta.PDF(x); math.Abs(v-e) > 1e-12 {
+			t.Errorf("f(%f) = %f, expected %f", x, v, e)
+		}
+	}
+	if v, e := min.Mean(), beta.Mean(); math.Abs(v-e) > 1e-8 {
+		t.Errorf("E[M] = %f, expected %f", v, e)
+	}
+
+	// Minimum of 2 Poisson(4), P(M >= k) = P(X >= k)^2
+	poisson := &Poisson{Lambda: 4}
+	pmin := &Minimum{}
+	pmin.Init(poisson, 2)
+	for k := 0.0; k < 10; k++ {
+		e := math.Pow(poisson.Survival(k-1), 2) - math.Pow(poisson.Survival(k), 2)
+		if v := pmin.PMF(k); math.Abs(v-e) > 1e-14 {
+			t.Errorf("P(M = %g) = %g, expected %g", k, v, e)
+		}
+	}
+	for _, p := range []float64{.1, .5, .9} {
+		q := pmin.Quantile(p)
+		if pmin.CDF(q) < p || pmin.CDF(q-1) >= p {
+			t.Errorf("Q(%f) = %f is not the generalized inverse of the cdf", p, q)
+		}
+	}
+}
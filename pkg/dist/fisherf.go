@@ -0,0 +1,217 @@
+package dist
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/ichbinfrog/statistics/pkg/specfun"
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// FisherF represents the Fisher-Snedecor F distribution
+// Continuous probability distribution function as follows:
+//		X ~ F(d1, d2), d1 > 0, d2 > 0
+//
+//		f(x,d1,d2) = (d1/d2)^(d1/2) x^(d1/2-1) (1 + d1x/d2)^(-(d1+d2)/2) / B(d1/2, d2/2)
+//
+type FisherF struct {
+	source
+	D1, D2 float64
+}
+
+// Init intialises a Fisher F distribution
+func (f *FisherF) Init(d1, d2 float64) error {
+	if d1 <= 0 || d2 <= 0 {
+		return util.ErrFisherFParam
+	}
+	f.D1, f.D2 = d1, d2
+	return nil
+}
+
+// Generate creates one sample of the F distribution
+func (f *FisherF) Generate() float64 {
+	return f.Rand(f.rng())
+}
+
+// GenerateN creates n samples of the F distribution
+func (f *FisherF) GenerateN(n int) []float64 {
+	return GenerateN(f, f.rng(), n)
+}
+
+// Fill fills dst with samples of the F distribution
+func (f *FisherF) Fill(dst []float64) {
+	Fill(f, f.rng(), dst)
+}
+
+// Rand creates one sample of the F distribution using the given generator
+func (f *FisherF) Rand(r *rand.Rand) float64 {
+	return f.Quantile(r.Float64())
+}
+
+// Domain returns the definition domain of the distribution
+func (f *FisherF) Domain() (float64, float64) {
+	return 0, math.Inf(0)
+}
+
+// PDF returns the probability density function value of a given x
+func (f *FisherF) PDF(x float64) float64 {
+	return math.Exp(f.LogPDF(x))
+}
+
+// LogPDF returns the log of the probability density function value of a given x
+func (f *FisherF) LogPDF(x float64) float64 {
+	if x < 0 {
+		return math.Inf(-1)
+	}
+	return f.D1/2*math.Log(f.D1/f.D2) + xlogy(f.D1/2-1, x) -
+		(f.D1+f.D2)/2*math.Log1p(f.D1*x/f.D2) - specfun.LogBeta(f.D1/2, f.D2/2)
+}
+
+// CDF returns the Cumulative distribution function value of a given x
+// Algorithm:
+//		F(x) = I(d1x/(d1x+d2); d1/2, d2/2)
+//
+func (f *FisherF) CDF(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	if math.IsInf(x, 1) {
+		return 1
+	}
+	return specfun.RegIncBeta(f.D1/2, f.D2/2, f.D1*x/(f.D1*x+f.D2))
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given x
+func (f *FisherF) LogCDF(x float64) float64 {
+	if x <= 0 {
+		return math.Inf(-1)
+	}
+	return specfun.LogRegIncBeta(f.D1/2, f.D2/2, f.D1*x/(f.D1*x+f.D2))
+}
+
+// Survival returns the survival function value of a given x
+func (f *FisherF) Survival(x float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+	if math.IsInf(x, 1) {
+		return 0
+	}
+	return specfun.RegIncBeta(f.D2/2, f.D1/2, f.D2/(f.D1*x+f.D2))
+}
+
+// LogSurvival returns the log of the survival function value of a given x
+func (f *FisherF) LogSurvival(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	return specfun.LogRegIncBeta(f.D2/2, f.D1/2, f.D2/(f.D1*x+f.D2))
+}
+
+// Quantile returns the p-th quantile of the distribution
+// Algorithm:
+//		y = I^-1(p; d1/2, d2/2)
+//		x = d2 y / (d1 (1 - y))
+//
+func (f *FisherF) Quantile(p float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	if p == 1 {
+		return math.Inf(0)
+	}
+	y := specfun.InvRegIncBeta(f.D1/2, f.D2/2, p)
+	return f.D2 * y / (f.D1 * (1 - y))
+}
+
+// Mean returns the mean of the distribution, undefined for d2 <= 2
+func (f *FisherF) Mean() float64 {
+	if f.D2 <= 2 {
+		return math.NaN()
+	}
+	return f.D2 / (f.D2 - 2)
+}
+
+// Median returns the median of the distribution
+func (f *FisherF) Median() float64 {
+	return f.Quantile(.5)
+}
+
+// Var returns the variance of the distribution, undefined for d2 <= 4
+func (f *FisherF) Var() float64 {
+	if f.D2 <= 4 {
+		return math.NaN()
+	}
+	return 2 * f.D2 * f.D2 * (f.D1 + f.D2 - 2) / (f.D1 * math.Pow(f.D2-2, 2) * (f.D2 - 4))
+}
+
+// Skewness returns the Pearson's moment coefficient of skewness of the
+// distribution, undefined for d2 <= 6
+func (f *FisherF) Skewness() float64 {
+	if f.D2 <= 6 {
+		return math.NaN()
+	}
+	return (2*f.D1 + f.D2 - 2) * math.Sqrt(8*(f.D2-4)) / ((f.D2 - 6) * math.Sqrt(f.D1*(f.D1+f.D2-2)))
+}
+
+// Kurtosis returns the Kurtosis of the distribution, undefined for d2 <= 8
+func (f *FisherF) Kurtosis() float64 {
+	if f.D2 <= 8 {
+		return math.NaN()
+	}
+	return 12 * (f.D1*(5*f.D2-22)*(f.D1+f.D2-2) + (f.D2-4)*math.Pow(f.D2-2, 2)) /
+		(f.D1 * (f.D2 - 6) * (f.D2 - 8) * (f.D1 + f.D2 - 2))
+}
+
+// Entropy returns the Entropy of the distribution
+func (f *FisherF) Entropy() float64 {
+	a, b := f.D1/2, f.D2/2
+	return math.Log(f.D2/f.D1) + specfun.LogBeta(a, b) + (1-a)*specfun.Digamma(a) -
+		(1+b)*specfun.Digamma(b) + (a+b)*specfun.Digamma(a+b)
+}
+
+// Moment returns the t-th moment of the distribution, the moment
+// generating function of the F distribution only exists at 0
+func (f *FisherF) Moment(t float64) float64 {
+	if t == 0 {
+		return 1
+	}
+	return math.NaN()
+}
+
+// rawMoment returns E[X^k] for 2k < d2, +Inf otherwise
+//		E[X^k] = (d2/d1)^k Γ(d1/2 + k) Γ(d2/2 - k) / (Γ(d1/2) Γ(d2/2))
+//
+func (f *FisherF) rawMoment(k int) float64 {
+	kf := float64(k)
+	if 2*kf >= f.D2 {
+		return math.Inf(1)
+	}
+	lg := specfun.LogGamma(f.D1/2+kf) + specfun.LogGamma(f.D2/2-kf) - specfun.LogGamma(f.D1/2) - specfun.LogGamma(f.D2/2)
+	return math.Exp(kf*math.Log(f.D2/f.D1) + lg)
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (f *FisherF) RawMoment(k int) float64 {
+	return RawMoment(f, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (f *FisherF) CentralMoment(k int) float64 {
+	return CentralMoment(f, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (f *FisherF) Cumulant(k int) float64 {
+	return Cumulant(f, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (f *FisherF) CF(t float64) complex128 {
+	return CF(f, t)
+}
+
+// Summary returns the properties of the distribution
+func (f *FisherF) Summary() *DistSummary {
+	return summarise(f, "fisherf", Param{"d1", f.D1}, Param{"d2", f.D2})
+}
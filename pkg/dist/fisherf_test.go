@@ -0,0 +1,47 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestFisherF(t *testing.T) {
+	dist := &FisherF{}
+	if err := dist.Init(4, 12); err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(dist.Summary())
+
+	// T^2 ~ F(1, ν) when T ~ t(ν)
+	f, st := &FisherF{D1: 1, D2: 6}, &StudentT{Nu: 6}
+	for _, x := range []float64{.01, .5, 1, 3, 20} {
+		if v, e := f.CDF(x), 1-2*st.Survival(math.Sqrt(x)); math.Abs(v-e) > 1e-12 {
+			t.Errorf("F(1, 6): F(%g) = %g, expected %g", x, v, e)
+		}
+	}
+	if q := dist.Quantile(.95); math.Abs(q-3.259166726901249) > 1e-9 {
+		t.Errorf("Q(.95) = %.15f", q)
+	}
+
+	moments := []struct {
+		Name     string
+		Actual   float64
+		Expected float64
+	}{
+		{"mean", expectation(dist, func(x float64) float64 { return x }, 50000), dist.Mean()},
+		{"var", expectation(dist, func(x float64) float64 { return math.Pow(x-dist.Mean(), 2) }, 50000), dist.Var()},
+		{"entropy", expectation(dist, func(x float64) float64 { return -dist.LogPDF(x) }, 50000), dist.Entropy()},
+	}
+	for _, m := range moments {
+		if math.Abs(m.Actual-m.Expected) > 5e-3*math.Abs(m.Expected) {
+			t.Errorf("%s %f, numerically %f", m.Name, m.Expected, m.Actual)
+		}
+	}
+	if !math.IsNaN((&FisherF{D1: 3, D2: 2}).Mean()) {
+		t.Errorf("expected undefined mean for d2 <= 2")
+	}
+	if err := dist.Init(1, -1); err == nil {
+		t.Errorf("expected error for d2 < 0")
+	}
+}
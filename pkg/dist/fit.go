@@ -0,0 +1,144 @@
+package dist
+
+import (
+	"math"
+
+	"github.com/ichbinfrog/statistics/pkg/array"
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// FitResult groups the goodness of a maximum likelihood estimation
+type FitResult struct {
+	// LogLikelihood is the log-likelihood of the observations under
+	// the fitted distribution
+	LogLikelihood float64 `json:"logLikelihood"`
+	// StdErr holds the asymptotic standard error of each estimated
+	// parameter, in the order of the arguments of the Init method
+	StdErr []float64 `json:"stdErr"`
+	// Observations is the number of observations the distribution was fitted on
+	Observations float64 `json:"observations"`
+}
+
+// sampleMoments returns the mean and the (biased) maximum likelihood
+// variance of the array. The power sums maintained by the array are
+// reused when its degree allows it, making the operation O(1).
+func sampleMoments(a *array.Arrayf64) (float64, float64) {
+	n := a.Length
+	if a.Option.Degree >= 2 {
+		return a.Mean(), a.Var() * (n - 1) / n
+	}
+
+	mean, variance := 0.0, 0.0
+	for _, v := range a.Data {
+		mean += v
+	}
+	mean /= n
+	for _, v := range a.Data {
+		variance += math.Pow(v-mean, 2)
+	}
+	return mean, variance / n
+}
+
+// sampleSum returns the sum of the observations, kept by the array when its
+// degree allows
+func sampleSum(a *array.Arrayf64) float64 {
+	if a.Option.Degree >= 1 {
+		return a.Sum[0]
+	}
+	sum := 0.0
+	for _, v := range a.Data {
+		sum += v
+	}
+	return sum
+}
+
+// checkSample returns an error if the array holds less than n observations
+// or if one of them lies outside of [lo, hi]. Integer observations are
+// required when discrete is set. Since the array is sorted, only the
+// extremities are checked for the bounds.
+func checkSample(a *array.Arrayf64, n, lo, hi float64, discrete bool) error {
+	if a == nil || a.Length < n || len(a.Data) == 0 {
+		return util.ErrEmptyArray
+	}
+	if a.Data[0] < lo || a.Data[len(a.Data)-1] > hi {
+		return util.ErrFitSupport
+	}
+	if discrete {
+		for _, v := range a.Data {
+			if v != math.Floor(v) {
+				return util.ErrFitSupport
+			}
+		}
+	}
+	return nil
+}
+
+// fisherStdErr returns the asymptotic standard errors of maximum
+// likelihood estimates computed from n observations:
+//		sqrt(diag((n * I)^-1))
+// where I is the Fisher Information of a single observation.
+func fisherStdErr(fisher [][]float64, n float64) []float64 {
+	inv := invert(fisher)
+	res := make([]float64, len(fisher))
+	for i := range res {
+		if inv == nil {
+			res[i] = math.NaN()
+			continue
+		}
+		res[i] = math.Sqrt(inv[i][i] / n)
+	}
+	return res
+}
+
+// invert returns the inverse of a square matrix using the Gauss-Jordan
+// elimination with partial pivoting, or nil if the matrix is singular.
+func invert(m [][]float64) [][]float64 {
+	n := len(m)
+	aug := make([][]float64, n)
+	for i := range m {
+		aug[i] = make([]float64, 2*n)
+		copy(aug[i], m[i])
+		aug[i][n+i] = 1
+	}
+
+	for col := 0; col < n; col++ {
+		pivot := col
+		for row := col + 1; row < n; row++ {
+			if math.Abs(aug[row][col]) > math.Abs(aug[pivot][col]) {
+				pivot = row
+			}
+		}
+		if aug[pivot][col] == 0 || math.IsNaN(aug[pivot][col]) {
+			return nil
+		}
+		aug[col], aug[pivot] = aug[pivot], aug[col]
+
+		div := aug[col][col]
+		for j := range aug[col] {
+			aug[col][j] /= div
+		}
+		for row := 0; row < n; row++ {
+			if row == col {
+				continue
+			}
+			f := aug[row][col]
+			for j := range aug[row] {
+				aug[row][j] -= f * aug[col][j]
+			}
+		}
+	}
+
+	res := make([][]float64, n)
+	for i := range aug {
+		res[i] = aug[i][n:]
+	}
+	return res
+}
+
+// xlogy returns x * log(y), with the convention 0 * log(0) = 0
+func xlogy(x, y float64) float64 {
+	if x == 0 {
+		return 0
+	}
+	return x * math.Log(y)
+}
@@ -0,0 +1,162 @@
+package dist
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/ichbinfrog/statistics/pkg/array"
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// sample returns an array holding n samples of the given distribution
+// drawn by inverse transform
+func sample(d Distribution, n int, seed int64) *array.Arrayf64 {
+	r := rand.New(rand.NewSource(seed))
+	a := &array.Arrayf64{}
+	a.Init(array.Optionf64{
+		Degree: 2,
+	})
+	for i := 0; i < n; i++ {
+		a.Insert(d.Quantile(r.Float64()))
+	}
+	return a
+}
+
+func TestFit(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		Dist   Distribution
+		Params []float64
+		Fit    func(a *array.Arrayf64) ([]float64, *FitResult, error)
+	}{
+		{"normal", &Normal{Mu: 3, Sigma: 2}, []float64{3, 2}, func(a *array.Arrayf64) ([]float64, *FitResult, error) {
+			d, res, err := FitNormal(a)
+			if err != nil {
+				return nil, nil, err
+			}
+			return []float64{d.Mu, d.Sigma}, res, nil
+		}},
+		{"exponential", &Exponential{Lambda: 4}, []float64{4}, func(a *array.Arrayf64) ([]float64, *FitResult, error) {
+			d, res, err := FitExponential(a)
+			if err != nil {
+				return nil, nil, err
+			}
+			return []float64{d.Lambda}, res, nil
+		}},
+		{"gamma", &Gamma{Alpha: 5, Beta: 10}, []float64{5, 10}, func(a *array.Arrayf64) ([]float64, *FitResult, error) {
+			d, res, err := FitGamma(a)
+			if err != nil {
+				return nil, nil, err
+			}
+			return []float64{d.Alpha, d.Beta}, res, nil
+		}},
+		{"lognormal", &LogNormal{Mu: 1, Sigma: .5}, []float64{1, .5}, func(a *array.Arrayf64) ([]float64, *FitResult, error) {
+			d, res, err := FitLogNormal(a)
+			if err != nil {
+				return nil, nil, err
+			}
+			return []float64{d.Mu, d.Sigma}, res, nil
+		}},
+		{"weibull", &Weibull{K: 1.5, Lambda: 2}, []float64{1.5, 2}, func(a *array.Arrayf64) ([]float64, *FitResult, error) {
+			d, res, err := FitWeibull(a)
+			if err != nil {
+				return nil, nil, err
+			}
+			return []float64{d.K, d.Lambda}, res, nil
+		}},
+		{"pareto", &Pareto{Xm: 2, Alpha: 3}, []float64{2, 3}, func(a *array.Arrayf64) ([]float64, *FitResult, error) {
+			d, res, err := FitPareto(a)
+			if err != nil {
+				return nil, nil, err
+			}
+			return []float64{d.Xm, d.Alpha}, res, nil
+		}},
+		{"laplace", &Laplace{Mu: -1, Sigma: 2}, []float64{-1, 2}, func(a *array.Arrayf64) ([]float64, *FitResult, error) {
+			d, res, err := FitLaplace(a)
+			if err != nil {
+				return nil, nil, err
+			}
+			return []float64{d.Mu, d.Sigma}, res, nil
+		}},
+		{"poisson", &Poisson{Lambda: 3}, []float64{3}, func(a *array.Arrayf64) ([]float64, *FitResult, error) {
+			d, res, err := FitPoisson(a)
+			if err != nil {
+				return nil, nil, err
+			}
+			return []float64{d.Lambda}, res, nil
+		}},
+		{"binomial", &Binomial{N: 20, P: .3, Q: .7}, []float64{.3}, func(a *array.Arrayf64) ([]float64, *FitResult, error) {
+			d, res, err := FitBinomial(a, 20)
+			if err != nil {
+				return nil, nil, err
+			}
+			return []float64{d.P}, res, nil
+		}},
+		{"bernoulli", &Bernoulli{P: .3, Q: .7}, []float64{.3}, func(a *array.Arrayf64) ([]float64, *FitResult, error) {
+			d, res, err := FitBernoulli(a)
+			if err != nil {
+				return nil, nil, err
+			}
+			return []float64{d.P}, res, nil
+		}},
+		{"geometric", &Geometric{P: .3, Q: .7}, []float64{.3}, func(a *array.Arrayf64) ([]float64, *FitResult, error) {
+			d, res, err := FitGeometric(a)
+			if err != nil {
+				return nil, nil, err
+			}
+			return []float64{d.P}, res, nil
+		}},
+		{"polya", &Polya{R: 5, P: .6, Q: .4}, []float64{5, .6}, func(a *array.Arrayf64) ([]float64, *FitResult, error) {
+			d, res, err := FitPolya(a)
+			if err != nil {
+				return nil, nil, err
+			}
+			return []float64{d.R, d.P}, res, nil
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			params, res, err := tc.Fit(sample(tc.Dist, 2000, 1))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Observations != 2000 || math.IsNaN(res.LogLikelihood) || math.IsInf(res.LogLikelihood, 0) {
+				t.Errorf("invalid fit result %+v", res)
+			}
+			for i, p := range tc.Params {
+				if math.Abs(params[i]-p) > 4*res.StdErr[i] {
+					t.Errorf("parameter %d: estimated %f ± %f, expected %f", i, params[i], res.StdErr[i], p)
+				}
+			}
+		})
+	}
+}
+
+func TestFitErrors(t *testing.T) {
+	a := &array.Arrayf64{}
+	a.Init(array.Optionf64{})
+	if _, _, err := FitNormal(a); err != util.ErrEmptyArray {
+		t.Errorf("expected %v, got %v", util.ErrEmptyArray, err)
+	}
+
+	a.InsertSlice([]float64{-1, 2.5, 3})
+	if _, _, err := FitGamma(a); err != util.ErrFitSupport {
+		t.Errorf("expected %v, got %v", util.ErrFitSupport, err)
+	}
+	if _, _, err := FitPoisson(a); err != util.ErrFitSupport {
+		t.Errorf("expected %v, got %v", util.ErrFitSupport, err)
+	}
+	if _, res, err := FitNormal(a); err != nil || res.LogLikelihood >= 0 {
+		t.Errorf("unexpected fit %+v, %v", res, err)
+	}
+
+	// Underdispersed counts have no finite Polya estimate
+	b := &array.Arrayf64{}
+	b.Init(array.Optionf64{Degree: 2})
+	b.InsertSlice([]float64{2, 3, 2, 3, 2, 3})
+	if _, _, err := FitPolya(b); err != util.ErrFitConvergence {
+		t.Errorf("expected %v, got %v", util.ErrFitConvergence, err)
+	}
+}
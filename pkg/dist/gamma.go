@@ -1,65 +1,111 @@
 package dist
 
 import (
-	"fmt"
 	"math"
+	"math/cmplx"
 	"math/rand"
 
+	"github.com/ichbinfrog/statistics/pkg/array"
+	"github.com/ichbinfrog/statistics/pkg/specfun"
 	"github.com/ichbinfrog/statistics/pkg/util"
-	"gonum.org/v1/gonum/mathext"
 )
 
-// Gamma represents a gamme distribution
+// Gamma represents a gamma distribution
 // Continuous distribution function as follows:
 //		X ~	Γ(α, β), α > 0, β > 0
 //
 //		f(x,α,β) = (β^α*x^(α-1)*exp(-β*x))/Γ(α)
 //
 type Gamma struct {
+	source
 	Alpha, Beta float64
 }
 
 // Generate creates one sample of the Gamma distribution
 func (g *Gamma) Generate() float64 {
-	// PRESS, William H., TEUKOLSKY, Saul A., VETTERLING, William T., et al. Numerical recipes in C. 1988.
-	// Direct method
-	if g.Beta < 6 {
-		x := 1.0
-		for i := 0.0; i < g.Beta; i++ {
-			x *= rand.Float64()
-		}
-		return -math.Log(x)
+	return g.Rand(g.rng())
+}
+
+// GenerateN creates n samples of the Gamma distribution
+func (g *Gamma) GenerateN(n int) []float64 {
+	return GenerateN(g, g.rng(), n)
+}
+
+// Fill fills dst with samples of the Gamma distribution
+func (g *Gamma) Fill(dst []float64) {
+	Fill(g, g.rng(), dst)
+}
+
+// Rand creates one sample of the Gamma distribution using the given generator
+// Algorithm: a sample of Γ(α, 1) is drawn then scaled by the rate β
+//		α >= 1 : Marsaglia-Tsang squeeze and rejection of d(1 + cZ)^3,
+//		d = α - 1/3, c = 1/sqrt(9d), Z ~ N(0, 1)
+//		α < 1 : Γ(α + 1, 1) * U^(1/α)
+//
+// MARSAGLIA, George, TSANG, Wai Wan. A simple method for generating gamma variables. 2000.
+// Complexity: O(1), the acceptance rate being above 95% for α >= 1
+//
+func (g *Gamma) Rand(r *rand.Rand) float64 {
+	return newGammaSampler(g.Alpha).sample(r) / g.Beta
+}
+
+// fill fills dst with samples of the Gamma distribution, sharing the
+// constants of the sampler
+func (g *Gamma) fill(r *rand.Rand, dst []float64) {
+	s := newGammaSampler(g.Alpha)
+	for i := range dst {
+		dst[i] = s.sample(r) / g.Beta
+	}
+}
+
+// gammaSampler holds the constants of the Marsaglia-Tsang sampler of the
+// Gamma distribution of shape alpha and rate 1
+type gammaSampler struct {
+	alpha, d, c float64
+	boost       bool
+}
+
+// newGammaSampler precomputes the constants of the Gamma sampler, shapes
+// below 1 being sampled from the shape alpha + 1
+func newGammaSampler(alpha float64) *gammaSampler {
+	s := &gammaSampler{alpha: alpha}
+	if alpha < 1 {
+		s.boost = true
+		alpha++
 	}
+	s.d = alpha - 1.0/3
+	s.c = 1 / math.Sqrt(9*s.d)
+	return s
+}
 
-	// Rejection method
-	var e float64
+// sample creates one sample of the Gamma distribution of rate 1
+func (s *gammaSampler) sample(r *rand.Rand) float64 {
 	for {
-		var y, x, s, am float64
-		for {
-			var v1, v2 float64
-			for {
-				v1 = rand.Float64()
-				v2 = 2.0*rand.Float64() - 1.0
-				if math.Pow(v1, 2)+math.Pow(v2, 2) <= 1.0 {
-					break
-				}
-			}
-			y = v2 / v1
-			am = g.Beta - 1
-			s = math.Sqrt(2.0*am + 1.0)
-			x = s*y + am
-			if x > 0.0 {
-				break
-			}
+		z := r.NormFloat64()
+		v := 1 + s.c*z
+		if v <= 0 {
+			continue
 		}
-		e = (1.0 + math.Pow(y, 2)) / math.Exp(am*math.Log(x/am)-s*y)
-		if rand.Float64() <= e {
+		v = v * v * v
+		u := r.Float64()
+		z2 := z * z
+		// Squeeze, avoiding the logarithms for most samples
+		if u < 1-.0331*z2*z2 || math.Log(u) < .5*z2+s.d*(1-v+math.Log(v)) {
+			x := s.d * v
+			if s.boost {
+				x *= math.Exp(math.Log(r.Float64()) / s.alpha)
+			}
 			return x
 		}
 	}
 }
 
-// Init intialises a Bernoulli distribution
+// gammaRand returns a sample of the Gamma distribution of shape alpha and rate 1
+func gammaRand(r *rand.Rand, alpha float64) float64 {
+	return newGammaSampler(alpha).sample(r)
+}
+
+// Init intialises a Gamma distribution
 func (g *Gamma) Init(alpha, beta float64) error {
 	if alpha <= 0 || beta <= 0 {
 		return util.ErrGammaParam
@@ -73,18 +119,65 @@ func (g *Gamma) Domain() (float64, float64) {
 	return 0, math.Inf(0)
 }
 
-// PMF returns the probability mass function value of a given k
-func (g *Gamma) PMF(x float64) float64 {
-	lg, _ := math.Lgamma(g.Alpha)
-	return (math.Pow(g.Beta, g.Alpha) * math.Exp(-g.Beta*x) * math.Pow(x, g.Alpha-1)) / lg
+// PDF returns the probability density function value of a given x
+func (g *Gamma) PDF(x float64) float64 {
+	return math.Exp(g.LogPDF(x))
 }
 
-// CDF returns the Cumulative distribution function value of a given k
-func (g *Gamma) CDF(x float64) float64 {
+// LogPDF returns the log of the probability density function value of a given x
+func (g *Gamma) LogPDF(x float64) float64 {
 	if x < 0 {
+		return math.Inf(-1)
+	}
+	if x == 0 {
+		switch {
+		case g.Alpha < 1:
+			return math.Inf(0)
+		case g.Alpha == 1:
+			return math.Log(g.Beta)
+		default:
+			return math.Inf(-1)
+		}
+	}
+	return g.Alpha*math.Log(g.Beta) - specfun.LogGamma(g.Alpha) + (g.Alpha-1)*math.Log(x) - g.Beta*x
+}
+
+// CDF returns the Cumulative distribution function value of a given x
+func (g *Gamma) CDF(x float64) float64 {
+	if x <= 0 {
 		return 0
 	}
-	return mathext.GammaIncReg(g.Alpha, x*g.Beta)
+	return specfun.GammaIncReg(g.Alpha, x*g.Beta)
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given x
+func (g *Gamma) LogCDF(x float64) float64 {
+	return specfun.LogGammaIncReg(g.Alpha, x*g.Beta)
+}
+
+// Survival returns the survival function value of a given x
+func (g *Gamma) Survival(x float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+	return specfun.GammaIncRegComp(g.Alpha, x*g.Beta)
+}
+
+// LogSurvival returns the log of the survival function value of a given x
+func (g *Gamma) LogSurvival(x float64) float64 {
+	return specfun.LogGammaIncRegComp(g.Alpha, x*g.Beta)
+}
+
+// Quantile returns the p-th quantile of the distribution
+func (g *Gamma) Quantile(p float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	if x := specfun.GammaIncRegInv(g.Alpha, p); !math.IsNaN(x) {
+		return x / g.Beta
+	}
+	dbeg, dend := g.Domain()
+	return continuousQuantile(g.CDF, p, dbeg, dend, g.Mean(), math.Sqrt(g.Var()))
 }
 
 // Mean returns the mean of the distribution
@@ -94,10 +187,7 @@ func (g *Gamma) Mean() float64 {
 
 // Var returns the variance of the distribution
 func (g *Gamma) Var() float64 {
-	if g.Alpha >= 1 {
-		return (g.Alpha - 1) / g.Beta
-	}
-	return math.NaN()
+	return g.Alpha / math.Pow(g.Beta, 2)
 }
 
 // Skewness returns the Pearson's moment coefficient of skewness of the distribution
@@ -122,15 +212,111 @@ func (g *Gamma) Moment(t float64) float64 {
 	return math.NaN()
 }
 
-// Summary returns a string summarising basic info about the distribution
-func (g *Gamma) Summary() string {
-	dbeg, dend := g.Domain()
-	return fmt.Sprintf(`
-	X ~ Γ(%f, %f)
-		Domain:		[ %f , %f [
-		Mean: 		%f
-		Var: 		%f
-		Skewness: 	%f
-		Kurtosis:	%f
-`, g.Alpha, g.Beta, dbeg, dend, g.Mean(), g.Var(), g.Skewness(), g.Kurtosis())
+// rawMoment returns E[X^k] = Γ(α + k) / (Γ(α) β^k)
+func (g *Gamma) rawMoment(k int) float64 {
+	kf := float64(k)
+	return math.Exp(specfun.LogGamma(g.Alpha+kf) - specfun.LogGamma(g.Alpha) - kf*math.Log(g.Beta))
+}
+
+// cumulant returns the k-th cumulant κ_k = α (k-1)! / β^k
+func (g *Gamma) cumulant(k int) float64 {
+	return g.Alpha * math.Exp(specfun.LogFactorial(float64(k-1))-float64(k)*math.Log(g.Beta))
+}
+
+// cf returns the characteristic function of the distribution at t
+//		φ(t) = (1 - it/β)^(-α)
+//
+func (g *Gamma) cf(t float64) complex128 {
+	return cmplx.Pow(complex(1, -t/g.Beta), complex(-g.Alpha, 0))
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (g *Gamma) RawMoment(k int) float64 {
+	return RawMoment(g, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (g *Gamma) CentralMoment(k int) float64 {
+	return CentralMoment(g, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (g *Gamma) Cumulant(k int) float64 {
+	return Cumulant(g, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (g *Gamma) CF(t float64) complex128 {
+	return CF(g, t)
+}
+
+// FisherI returns the Fisher Information of the distribution
+// with regards to (α, β)
+func (g *Gamma) FisherI() [][]float64 {
+	return [][]float64{
+		[]float64{specfun.Trigamma(g.Alpha), -1 / g.Beta},
+		[]float64{-1 / g.Beta, g.Alpha / math.Pow(g.Beta, 2)},
+	}
+}
+
+// Summary returns the properties of the distribution
+func (g *Gamma) Summary() *DistSummary {
+	return summarise(g, "gamma", Param{"alpha", g.Alpha}, Param{"beta", g.Beta})
+}
+
+// FitGamma returns the maximum likelihood estimation of a Gamma
+// distribution from the given observations.
+// Algorithm:
+//		s = log(E[X]) - E[log(X)]
+//		Solve log(α) - ψ(α) = s with Newton's method starting from
+//		α = (3 - s + √((s - 3)^2 + 24s)) / 12s
+//		β = α / E[X]
+//
+// MINKA, Thomas P. Estimating a gamma distribution. 2002.
+// Complexity: O(n)
+//
+func FitGamma(a *array.Arrayf64) (*Gamma, *FitResult, error) {
+	if err := checkSample(a, 2, 0, math.Inf(0), false); err != nil {
+		return nil, nil, err
+	}
+	if a.Data[0] <= 0 {
+		return nil, nil, util.ErrFitSupport
+	}
+	mean, _ := sampleMoments(a)
+	meanLog := 0.0
+	for _, v := range a.Data {
+		meanLog += math.Log(v)
+	}
+	meanLog /= a.Length
+
+	s := math.Log(mean) - meanLog
+	if !(s > 0) {
+		return nil, nil, util.ErrFitConvergence
+	}
+	alpha := (3 - s + math.Sqrt(math.Pow(s-3, 2)+24*s)) / (12 * s)
+	converged := false
+	for i := 0; i < maxIter; i++ {
+		step := (math.Log(alpha) - specfun.Digamma(alpha) - s) / (1/alpha - specfun.Trigamma(alpha))
+		if alpha-step <= 0 {
+			step = alpha / 2
+		}
+		alpha -= step
+		if math.Abs(step) <= 1e-12*alpha {
+			converged = true
+			break
+		}
+	}
+	if !converged {
+		return nil, nil, util.ErrFitConvergence
+	}
+
+	g := &Gamma{}
+	if err := g.Init(alpha, alpha/mean); err != nil {
+		return nil, nil, err
+	}
+	return g, &FitResult{
+		LogLikelihood: a.Length * (g.Alpha*math.Log(g.Beta) - specfun.LogGamma(g.Alpha) + (g.Alpha-1)*meanLog - g.Alpha),
+		StdErr:        fisherStdErr(g.FisherI(), a.Length),
+		Observations:  a.Length,
+	}, nil
 }
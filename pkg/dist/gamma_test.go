@@ -2,7 +2,11 @@ package dist
 
 import (
 	"fmt"
+	"math"
+	"math/rand"
 	"testing"
+
+	"github.com/ichbinfrog/statistics/pkg/array"
 )
 
 func TestGamma(t *testing.T) {
@@ -10,8 +14,8 @@ func TestGamma(t *testing.T) {
 	dist.Init(5, 10)
 	fmt.Println(dist.Summary())
 
-	fmt.Printf("		f(0) = %f", dist.PMF(0))
-	fmt.Printf("\n		f(1) = %f\n", dist.PMF(1))
+	fmt.Printf("		f(0) = %f", dist.PDF(0))
+	fmt.Printf("\n		f(1) = %f\n", dist.PDF(1))
 	fmt.Printf("\n		F(5) = %f", dist.CDF(5))
 	fmt.Printf("\n		Mx(0) = %f", dist.Moment(0))
 	fmt.Printf("\n		Mx(1) = %f\n", dist.Moment(1))
@@ -22,3 +26,85 @@ func TestGamma(t *testing.T) {
 	}
 	fmt.Printf("\n	Generated slice: %v\n\n", sl)
 }
+
+func TestGammaRand(t *testing.T) {
+	for _, alpha := range []float64{.05, .3, 1, 2.5, 4, 7.5, 30, 1000} {
+		t.Run(fmt.Sprint(alpha), func(t *testing.T) {
+			dist := &Gamma{Alpha: alpha, Beta: 2}
+			r := rand.New(rand.NewSource(1))
+			a := &array.Arrayf64{}
+			a.Init(array.Optionf64{})
+			for i := 0; i < 5000; i++ {
+				a.Insert(dist.Rand(r))
+			}
+			if _, p := KolmogorovSmirnov(a, dist); p < 1e-3 {
+				t.Errorf("samples do not follow Γ(%g, 2), p-value %g", alpha, p)
+			}
+		})
+	}
+}
+
+// previousGamma is the Numerical Recipes sampler replaced by
+// Marsaglia-Tsang, kept verbatim as a benchmark baseline
+func previousGamma(r *rand.Rand, alpha float64) float64 {
+	if alpha < 1 {
+		return previousGamma(r, alpha+1) * math.Pow(r.Float64(), 1/alpha)
+	}
+
+	// Direct method
+	if alpha < 6 && alpha == math.Floor(alpha) {
+		x := 1.0
+		for i := 0.0; i < alpha; i++ {
+			x *= r.Float64()
+		}
+		return -math.Log(x)
+	}
+
+	// Rejection method
+	var e float64
+	for {
+		var y, x, s, am float64
+		for {
+			var v1, v2 float64
+			for {
+				v1 = r.Float64()
+				v2 = 2.0*r.Float64() - 1.0
+				if math.Pow(v1, 2)+math.Pow(v2, 2) <= 1.0 {
+					break
+				}
+			}
+			y = v2 / v1
+			am = alpha - 1
+			s = math.Sqrt(2.0*am + 1.0)
+			x = s*y + am
+			if x > 0.0 {
+				break
+			}
+		}
+		e = (1.0 + math.Pow(y, 2)) * math.Exp(am*math.Log(x/am)-s*y)
+		if r.Float64() <= e {
+			return x
+		}
+	}
+}
+
+func BenchmarkGammaRand(b *testing.B) {
+	for _, alpha := range []float64{.5, 3, 7.5, 100} {
+		dist := &Gamma{Alpha: alpha, Beta: 1}
+		dst := make([]float64, 1000)
+		b.Run(fmt.Sprintf("marsagliatsang/α=%g", alpha), func(b *testing.B) {
+			r := rand.New(rand.NewSource(1))
+			for i := 0; i < b.N; i++ {
+				dist.fill(r, dst)
+			}
+		})
+		b.Run(fmt.Sprintf("previous/α=%g", alpha), func(b *testing.B) {
+			r := rand.New(rand.NewSource(1))
+			for i := 0; i < b.N; i++ {
+				for j := range dst {
+					dst[j] = previousGamma(r, alpha)
+				}
+			}
+		})
+	}
+}
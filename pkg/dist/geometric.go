@@ -1,10 +1,10 @@
 package dist
 
 import (
-	"fmt"
 	"math"
 	"math/rand"
 
+	"github.com/ichbinfrog/statistics/pkg/array"
 	"github.com/ichbinfrog/statistics/pkg/util"
 )
 
@@ -14,6 +14,7 @@ import (
 //		P(X = k) = q^(k - 1)p
 //
 type Geometric struct {
+	source
 	P, Q float64
 }
 
@@ -28,22 +29,83 @@ func (g *Geometric) Init(p float64) error {
 
 // Generate creates one sample of a geometric distribution
 func (g *Geometric) Generate() float64 {
-	return math.Floor(math.Log(rand.Float64()) / math.Log(g.Q))
+	return g.Rand(g.rng())
+}
+
+// GenerateN creates n samples of the geometric distribution
+func (g *Geometric) GenerateN(n int) []float64 {
+	return GenerateN(g, g.rng(), n)
+}
+
+// Fill fills dst with samples of the geometric distribution
+func (g *Geometric) Fill(dst []float64) {
+	Fill(g, g.rng(), dst)
+}
+
+// Rand creates one sample of a geometric distribution using the given generator
+func (g *Geometric) Rand(r *rand.Rand) float64 {
+	return math.Floor(math.Log(r.Float64())/math.Log(g.Q)) + 1
+}
+
+// fill fills dst with samples of the geometric distribution, computing log(q) once
+func (g *Geometric) fill(r *rand.Rand, dst []float64) {
+	lq := math.Log(g.Q)
+	for i := range dst {
+		dst[i] = math.Floor(math.Log(r.Float64())/lq) + 1
+	}
 }
 
 // Domain returns the definition domain of the distribution
 func (g *Geometric) Domain() (float64, float64) {
-	return 0, math.Inf(0)
+	return 1, math.Inf(0)
 }
 
 // PMF returns the probability mass function value of a given k
 func (g *Geometric) PMF(k float64) float64 {
-	return math.Pow(g.Q, k-1) * g.P
+	return math.Exp(g.LogPMF(k))
+}
+
+// LogPMF returns the log of the probability mass function value of a given k
+func (g *Geometric) LogPMF(k float64) float64 {
+	if k < 1 || k != math.Floor(k) {
+		return math.Inf(-1)
+	}
+	return xlogy(k-1, g.Q) + math.Log(g.P)
 }
 
 // CDF returns the Cumulative distribution function value of a given k
 func (g *Geometric) CDF(k float64) float64 {
-	return 1 - math.Pow(g.Q, k)
+	if k < 1 {
+		return 0
+	}
+	return -math.Expm1(math.Floor(k) * math.Log1p(-g.P))
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given k
+func (g *Geometric) LogCDF(k float64) float64 {
+	return math.Log(g.CDF(k))
+}
+
+// Survival returns the survival function value of a given k
+func (g *Geometric) Survival(k float64) float64 {
+	return math.Exp(g.LogSurvival(k))
+}
+
+// LogSurvival returns the log of the survival function value of a given k
+func (g *Geometric) LogSurvival(k float64) float64 {
+	if k < 1 {
+		return 0
+	}
+	return xlogy(math.Floor(k), g.Q)
+}
+
+// Quantile returns the p-th quantile of the distribution
+func (g *Geometric) Quantile(p float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	dbeg, dend := g.Domain()
+	return discreteQuantile(g.CDF, p, dbeg, dend, math.Ceil(math.Log1p(-p)/math.Log(g.Q)))
 }
 
 // Mean returns the mean of the distribution
@@ -81,17 +143,65 @@ func (g *Geometric) Moment(t float64) float64 {
 	return (g.P * math.Exp(t)) / (1 - g.Q*math.Exp(t))
 }
 
-// Summary returns a string summarising basic info about the distribution
-func (g *Geometric) Summary() string {
-	dbeg, dend := g.Domain()
-	return fmt.Sprintf(`
-	X ~ G(%f)
-		Domain:			[ %f , %f [
-		Mean: 			%f
-		Median:			%f
-		Var: 			%f
-		Skewness: 		%f
-		Entropy:		%f
-		Kurtosis:		%f
-`, g.P, dbeg, dend, g.Mean(), g.Median(), g.Var(), g.Skewness(), g.Kurtosis(), g.Entropy())
+// cf returns the characteristic function of the distribution at t
+//		φ(t) = p exp(it) / (1 - q exp(it))
+//
+func (g *Geometric) cf(t float64) complex128 {
+	e := expi(t)
+	return complex(g.P, 0) * e / (1 - complex(g.Q, 0)*e)
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (g *Geometric) RawMoment(k int) float64 {
+	return RawMoment(g, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (g *Geometric) CentralMoment(k int) float64 {
+	return CentralMoment(g, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (g *Geometric) Cumulant(k int) float64 {
+	return Cumulant(g, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (g *Geometric) CF(t float64) complex128 {
+	return CF(g, t)
+}
+
+// FisherI returns the Fisher Information of the distribution
+func (g *Geometric) FisherI() [][]float64 {
+	return [][]float64{
+		[]float64{1 / (math.Pow(g.P, 2) * g.Q)},
+	}
+}
+
+// Summary returns the properties of the distribution
+func (g *Geometric) Summary() *DistSummary {
+	return summarise(g, "geometric", Param{"p", g.P})
+}
+
+// FitGeometric returns the maximum likelihood estimation of a Geometric
+// distribution from the given observations:
+//		p = 1 / E[X]
+//
+// Complexity: O(n)
+//
+func FitGeometric(a *array.Arrayf64) (*Geometric, *FitResult, error) {
+	if err := checkSample(a, 1, 1, math.Inf(0), true); err != nil {
+		return nil, nil, err
+	}
+	mean, _ := sampleMoments(a)
+
+	g := &Geometric{}
+	if err := g.Init(1 / mean); err != nil {
+		return nil, nil, err
+	}
+	return g, &FitResult{
+		LogLikelihood: a.Length * (math.Log(g.P) + xlogy(mean-1, g.Q)),
+		StdErr:        fisherStdErr(g.FisherI(), a.Length),
+		Observations:  a.Length,
+	}, nil
 }
@@ -0,0 +1,131 @@
+package dist
+
+import (
+	"math"
+
+	"github.com/ichbinfrog/statistics/pkg/array"
+)
+
+// KolmogorovSmirnov returns the Kolmogorov-Smirnov statistic of the
+// observations against the given continuous distribution, and its
+// p-value. Since the array is sorted, the empirical cdf at the i-th
+// element is directly i / n:
+// Algorithm:
+//		D = max(i/n - F(x_i), F(x_i) - (i - 1)/n)
+//		p = Σ(k = 1; k < ∞; k++) 2(-1)^(k - 1) exp(-2k^2 λ^2)
+//		with λ = D(√n + 0.12 + 0.11/√n)
+//
+// STEPHENS, Michael A. EDF statistics for goodness of fit and some comparisons. 1974.
+// The p-value is conservative when the parameters of the distribution
+// were estimated from the same observations, or when the distribution has
+// point masses, tied observations being handled by comparing the left
+// limits of both cdfs.
+// Complexity: O(n)
+//
+func KolmogorovSmirnov(a *array.Arrayf64, d Distribution) (float64, float64) {
+	n := float64(len(a.Data))
+	if n == 0 {
+		return math.NaN(), math.NaN()
+	}
+
+	_, continuous := d.(Continuous)
+	continuous = continuous && !IsDiscrete(d)
+	stat := 0.0
+	for i := 0; i < len(a.Data); {
+		v := a.Data[i]
+		j := i + 1
+		for j < len(a.Data) && a.Data[j] == v {
+			j++
+		}
+		// Tied observations are compared with the left limit of the cdf
+		f := d.CDF(v)
+		left := f
+		if !continuous {
+			left = d.CDF(math.Nextafter(v, math.Inf(-1)))
+		}
+		stat = math.Max(stat, math.Max(float64(j)/n-f, left-float64(i)/n))
+		i = j
+	}
+	return stat, kolmogorovSurvival(stat * (math.Sqrt(n) + .12 + .11/math.Sqrt(n)))
+}
+
+// kolmogorovSurvival returns P(K > x) where K follows the Kolmogorov distribution
+func kolmogorovSurvival(x float64) float64 {
+	if x < .2 {
+		return 1
+	}
+	sum, sign := 0.0, 1.0
+	for k := 1.0; k < 100; k++ {
+		term := sign * 2 * math.Exp(-2*k*k*x*x)
+		sum += term
+		if math.Abs(term) < 1e-16 {
+			break
+		}
+		sign = -sign
+	}
+	return math.Max(0, math.Min(1, sum))
+}
+
+// ChiSquare returns Pearson's χ^2 statistic of the integer observations
+// against the given discrete distribution, and its p-value.
+// Algorithm:
+//		Count the observations of each value of the domain up to the
+//		largest observation, the last bin also holding the upper tail
+//		and the first one the lower tail of domains unbounded below
+//		Merge adjacent bins until each expects at least 5 observations
+//		χ^2 = Σ (observed - expected)^2 / expected
+//		p = P(χ(bins - 1 - params) > χ^2)
+//
+// params is the number of parameters estimated from the observations.
+// Complexity: O(n + max(X) - min(domain))
+//
+func ChiSquare(a *array.Arrayf64, d Discrete, params int) (float64, float64) {
+	n := float64(len(a.Data))
+	if n == 0 {
+		return math.NaN(), math.NaN()
+	}
+	dbeg, _ := d.Domain()
+	if math.IsInf(dbeg, -1) {
+		// The first bin holds the lower tail
+		dbeg = a.Data[0]
+	}
+	last := math.Max(dbeg, a.Data[len(a.Data)-1])
+
+	var observed, expected []float64
+	i := 0
+	obs, exp := 0.0, 0.0
+	prev := 0.0
+	for k := dbeg; k <= last; k++ {
+		for ; i < len(a.Data) && a.Data[i] <= k; i++ {
+			obs++
+		}
+		if k == last {
+			exp += n * (1 - prev)
+		} else {
+			cdf := d.CDF(k)
+			exp += n * (cdf - prev)
+			prev = cdf
+		}
+		if exp >= 5 {
+			observed, expected = append(observed, obs), append(expected, exp)
+			obs, exp = 0, 0
+		}
+	}
+	if len(expected) == 0 {
+		return math.NaN(), math.NaN()
+	}
+	// Remaining observations are merged with the last bin
+	observed[len(observed)-1] += obs
+	expected[len(expected)-1] += exp
+
+	stat := 0.0
+	for j := range observed {
+		stat += math.Pow(observed[j]-expected[j], 2) / expected[j]
+	}
+	df := float64(len(observed) - 1 - params)
+	if df <= 0 {
+		return stat, math.NaN()
+	}
+	c := &Chisq{Degree: df}
+	return stat, c.Survival(stat)
+}
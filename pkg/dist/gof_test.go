@@ -0,0 +1,35 @@
+package dist
+
+import (
+	"testing"
+)
+
+func TestKolmogorovSmirnov(t *testing.T) {
+	d := &Normal{Mu: 0, Sigma: 1}
+	a := sample(d, 1000, 5)
+
+	if stat, p := KolmogorovSmirnov(a, d); p < .01 {
+		t.Errorf("sample of N(0, 1) rejected: D = %f, p = %f", stat, p)
+	}
+	if stat, p := KolmogorovSmirnov(a, &Normal{Mu: .5, Sigma: 1}); p > .01 {
+		t.Errorf("sample of N(0, 1) accepted as N(.5, 1): D = %f, p = %f", stat, p)
+	}
+
+	// Tied observations at the point masses of a censored distribution
+	c := &Censored{Dist: d, Lower: -1, Upper: 1}
+	if stat, p := KolmogorovSmirnov(sample(c, 1000, 5), c); p < .01 {
+		t.Errorf("sample of censored N(0, 1) rejected: D = %f, p = %f", stat, p)
+	}
+}
+
+func TestChiSquare(t *testing.T) {
+	d := &Poisson{Lambda: 6}
+	a := sample(d, 1000, 5)
+
+	if stat, p := ChiSquare(a, d, 0); p < .01 {
+		t.Errorf("sample of P(6) rejected: χ2 = %f, p = %f", stat, p)
+	}
+	if stat, p := ChiSquare(a, &Poisson{Lambda: 7}, 0); p > .01 {
+		t.Errorf("sample of P(6) accepted as P(7): χ2 = %f, p = %f", stat, p)
+	}
+}
@@ -0,0 +1,347 @@
+package dist
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/ichbinfrog/statistics/pkg/specfun"
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// apery is Apéry's constant ζ(3)
+const apery = 1.2020569031595942853997381615114499907649862923405
+
+// Gumbel represents the Gumbel distribution of maxima (type I extreme
+// value distribution) with location μ and scale σ
+// Continuous probability distribution function as follows:
+//		X ~ Gumbel(μ, σ), σ > 0
+//
+//		f(x,μ,σ) = exp(-(z + exp(-z))) / σ, z = (x-μ)/σ
+//
+type Gumbel struct {
+	source
+	Mu, Sigma float64
+}
+
+// Init intialises a Gumbel distribution
+func (g *Gumbel) Init(mu, sigma float64) error {
+	if sigma <= 0 {
+		return util.ErrGumbelParam
+	}
+	g.Mu, g.Sigma = mu, sigma
+	return nil
+}
+
+// Generate creates one sample of the Gumbel distribution
+func (g *Gumbel) Generate() float64 {
+	return g.Rand(g.rng())
+}
+
+// GenerateN creates n samples of the Gumbel distribution
+func (g *Gumbel) GenerateN(n int) []float64 {
+	return GenerateN(g, g.rng(), n)
+}
+
+// Fill fills dst with samples of the Gumbel distribution
+func (g *Gumbel) Fill(dst []float64) {
+	Fill(g, g.rng(), dst)
+}
+
+// Rand creates one sample of the Gumbel distribution using the given generator
+// Algorithm:
+//		X = μ - σlog(E), E ~ Exp(1)
+//
+func (g *Gumbel) Rand(r *rand.Rand) float64 {
+	return g.Mu - g.Sigma*math.Log(r.ExpFloat64())
+}
+
+// Domain returns the definition domain of the distribution
+func (g *Gumbel) Domain() (float64, float64) {
+	return math.Inf(-1), math.Inf(0)
+}
+
+// PDF returns the probability density function value of a given x
+func (g *Gumbel) PDF(x float64) float64 {
+	return math.Exp(g.LogPDF(x))
+}
+
+// LogPDF returns the log of the probability density function value of a given x
+func (g *Gumbel) LogPDF(x float64) float64 {
+	z := (x - g.Mu) / g.Sigma
+	return -z - math.Exp(-z) - math.Log(g.Sigma)
+}
+
+// CDF returns the Cumulative distribution function value of a given x
+func (g *Gumbel) CDF(x float64) float64 {
+	return math.Exp(g.LogCDF(x))
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given x
+func (g *Gumbel) LogCDF(x float64) float64 {
+	return -math.Exp(-(x - g.Mu) / g.Sigma)
+}
+
+// Survival returns the survival function value of a given x
+func (g *Gumbel) Survival(x float64) float64 {
+	return -math.Expm1(g.LogCDF(x))
+}
+
+// LogSurvival returns the log of the survival function value of a given x
+func (g *Gumbel) LogSurvival(x float64) float64 {
+	return math.Log(g.Survival(x))
+}
+
+// Quantile returns the p-th quantile of the distribution
+func (g *Gumbel) Quantile(p float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	return g.Mu - g.Sigma*math.Log(-math.Log(p))
+}
+
+// Mean returns the mean of the distribution
+func (g *Gumbel) Mean() float64 {
+	return g.Mu + g.Sigma*eulerGamma
+}
+
+// Median returns the median of the distribution
+func (g *Gumbel) Median() float64 {
+	return g.Mu - g.Sigma*math.Log(math.Ln2)
+}
+
+// Var returns the variance of the distribution
+func (g *Gumbel) Var() float64 {
+	return math.Pow(g.Sigma*math.Pi, 2) / 6
+}
+
+// Skewness returns the Pearson's moment coefficient of skewness of the distribution
+func (g *Gumbel) Skewness() float64 {
+	return 12 * math.Sqrt(6) * apery / math.Pow(math.Pi, 3)
+}
+
+// Kurtosis returns the Kurtosis of the distribution
+func (g *Gumbel) Kurtosis() float64 {
+	return 2.4
+}
+
+// Entropy returns the Entropy of the distribution
+func (g *Gumbel) Entropy() float64 {
+	return math.Log(g.Sigma) + eulerGamma + 1
+}
+
+// Moment returns the t-th moment of the distribution, defined for t < 1/σ
+//		M(t) = Γ(1 - σt) exp(μt)
+//
+func (g *Gumbel) Moment(t float64) float64 {
+	if t >= 1/g.Sigma {
+		return math.NaN()
+	}
+	return math.Gamma(1-g.Sigma*t) * math.Exp(g.Mu*t)
+}
+
+// cumulant returns the k-th cumulant, κ_1 = μ + γσ, κ_k = (k-1)! ζ(k) σ^k
+func (g *Gumbel) cumulant(k int) float64 {
+	if k == 1 {
+		return g.Mu + eulerGamma*g.Sigma
+	}
+	return specfun.Zeta(float64(k)) * math.Exp(specfun.LogFactorial(float64(k-1))+float64(k)*math.Log(g.Sigma))
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (g *Gumbel) RawMoment(k int) float64 {
+	return RawMoment(g, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (g *Gumbel) CentralMoment(k int) float64 {
+	return CentralMoment(g, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (g *Gumbel) Cumulant(k int) float64 {
+	return Cumulant(g, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (g *Gumbel) CF(t float64) complex128 {
+	return CF(g, t)
+}
+
+// FisherI returns the Fisher Information of the distribution
+func (g *Gumbel) FisherI() [][]float64 {
+	s2 := g.Sigma * g.Sigma
+	return [][]float64{
+		[]float64{1 / s2, (eulerGamma - 1) / s2},
+		[]float64{(eulerGamma - 1) / s2, (math.Pow(1-eulerGamma, 2) + math.Pi*math.Pi/6) / s2},
+	}
+}
+
+// Summary returns the properties of the distribution
+func (g *Gumbel) Summary() *DistSummary {
+	return summarise(g, "gumbel", Param{"mu", g.Mu}, Param{"sigma", g.Sigma})
+}
+
+// GumbelMin represents the Gumbel distribution of minima with location μ
+// and scale σ, the distribution of -Y where Y ~ Gumbel(-μ, σ)
+// Continuous probability distribution function as follows:
+//		X ~ GumbelMin(μ, σ), σ > 0
+//
+//		f(x,μ,σ) = exp(z - exp(z)) / σ, z = (x-μ)/σ
+//
+type GumbelMin struct {
+	source
+	Mu, Sigma float64
+}
+
+// Init intialises a GumbelMin distribution
+func (g *GumbelMin) Init(mu, sigma float64) error {
+	if sigma <= 0 {
+		return util.ErrGumbelParam
+	}
+	g.Mu, g.Sigma = mu, sigma
+	return nil
+}
+
+// mirror returns the distribution of maxima of -X
+func (g *GumbelMin) mirror() *Gumbel {
+	return &Gumbel{Mu: -g.Mu, Sigma: g.Sigma}
+}
+
+// Generate creates one sample of the GumbelMin distribution
+func (g *GumbelMin) Generate() float64 {
+	return g.Rand(g.rng())
+}
+
+// GenerateN creates n samples of the GumbelMin distribution
+func (g *GumbelMin) GenerateN(n int) []float64 {
+	return GenerateN(g, g.rng(), n)
+}
+
+// Fill fills dst with samples of the GumbelMin distribution
+func (g *GumbelMin) Fill(dst []float64) {
+	Fill(g, g.rng(), dst)
+}
+
+// Rand creates one sample of the GumbelMin distribution using the given generator
+func (g *GumbelMin) Rand(r *rand.Rand) float64 {
+	return -g.mirror().Rand(r)
+}
+
+// Domain returns the definition domain of the distribution
+func (g *GumbelMin) Domain() (float64, float64) {
+	return math.Inf(-1), math.Inf(0)
+}
+
+// PDF returns the probability density function value of a given x
+func (g *GumbelMin) PDF(x float64) float64 {
+	return g.mirror().PDF(-x)
+}
+
+// LogPDF returns the log of the probability density function value of a given x
+func (g *GumbelMin) LogPDF(x float64) float64 {
+	return g.mirror().LogPDF(-x)
+}
+
+// CDF returns the Cumulative distribution function value of a given x
+func (g *GumbelMin) CDF(x float64) float64 {
+	return g.mirror().Survival(-x)
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given x
+func (g *GumbelMin) LogCDF(x float64) float64 {
+	return g.mirror().LogSurvival(-x)
+}
+
+// Survival returns the survival function value of a given x
+func (g *GumbelMin) Survival(x float64) float64 {
+	return g.mirror().CDF(-x)
+}
+
+// LogSurvival returns the log of the survival function value of a given x
+func (g *GumbelMin) LogSurvival(x float64) float64 {
+	return g.mirror().LogCDF(-x)
+}
+
+// Quantile returns the p-th quantile of the distribution
+func (g *GumbelMin) Quantile(p float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	return g.Mu + g.Sigma*math.Log(-math.Log1p(-p))
+}
+
+// Mean returns the mean of the distribution
+func (g *GumbelMin) Mean() float64 {
+	return -g.mirror().Mean()
+}
+
+// Median returns the median of the distribution
+func (g *GumbelMin) Median() float64 {
+	return -g.mirror().Median()
+}
+
+// Var returns the variance of the distribution
+func (g *GumbelMin) Var() float64 {
+	return g.mirror().Var()
+}
+
+// Skewness returns the Pearson's moment coefficient of skewness of the distribution
+func (g *GumbelMin) Skewness() float64 {
+	return -g.mirror().Skewness()
+}
+
+// Kurtosis returns the Kurtosis of the distribution
+func (g *GumbelMin) Kurtosis() float64 {
+	return g.mirror().Kurtosis()
+}
+
+// Entropy returns the Entropy of the distribution
+func (g *GumbelMin) Entropy() float64 {
+	return g.mirror().Entropy()
+}
+
+// Moment returns the t-th moment of the distribution, defined for t > -1/σ
+func (g *GumbelMin) Moment(t float64) float64 {
+	return g.mirror().Moment(-t)
+}
+
+// cumulant returns the k-th cumulant, that of the mirrored Gumbel
+// distribution negated for odd k
+func (g *GumbelMin) cumulant(k int) float64 {
+	if k%2 == 1 {
+		return -g.mirror().cumulant(k)
+	}
+	return g.mirror().cumulant(k)
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (g *GumbelMin) RawMoment(k int) float64 {
+	return RawMoment(g, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (g *GumbelMin) CentralMoment(k int) float64 {
+	return CentralMoment(g, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (g *GumbelMin) Cumulant(k int) float64 {
+	return Cumulant(g, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (g *GumbelMin) CF(t float64) complex128 {
+	return CF(g, t)
+}
+
+// FisherI returns the Fisher Information of the distribution
+func (g *GumbelMin) FisherI() [][]float64 {
+	fisher := g.mirror().FisherI()
+	fisher[0][1], fisher[1][0] = -fisher[0][1], -fisher[1][0]
+	return fisher
+}
+
+// Summary returns the properties of the distribution
+func (g *GumbelMin) Summary() *DistSummary {
+	return summarise(g, "gumbelmin", Param{"mu", g.Mu}, Param{"sigma", g.Sigma})
+}
@@ -0,0 +1,81 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestGumbel(t *testing.T) {
+	testCases := []struct {
+		Name string
+		Dist interface {
+			Continuous
+			Median() float64
+			Skewness() float64
+			Kurtosis() float64
+			Entropy() float64
+			Moment(t float64) float64
+			FisherI() [][]float64
+			Summary() *DistSummary
+		}
+		New func(mu, sigma float64) Continuous
+	}{
+		{"max", &Gumbel{Mu: 1, Sigma: 2}, func(mu, sigma float64) Continuous { return &Gumbel{Mu: mu, Sigma: sigma} }},
+		{"min", &GumbelMin{Mu: 1, Sigma: 2}, func(mu, sigma float64) Continuous { return &GumbelMin{Mu: mu, Sigma: sigma} }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			dist := tc.Dist
+			fmt.Println(dist.Summary())
+			moments := []struct {
+				Name     string
+				Actual   float64
+				Expected float64
+			}{
+				{"mean", expectation(dist, func(x float64) float64 { return x }, 100000), dist.Mean()},
+				{"median", dist.Quantile(.5), dist.Median()},
+				{"var", expectation(dist, func(x float64) float64 { return math.Pow(x-dist.Mean(), 2) }, 100000), dist.Var()},
+				{"skewness", expectation(dist, func(x float64) float64 { return math.Pow(x-dist.Mean(), 3) }, 100000) / math.Pow(dist.Var(), 1.5), dist.Skewness()},
+				{"kurtosis", expectation(dist, func(x float64) float64 { return math.Pow(x-dist.Mean(), 4) }, 100000)/math.Pow(dist.Var(), 2) - 3, dist.Kurtosis()},
+				{"entropy", expectation(dist, func(x float64) float64 { return -dist.LogPDF(x) }, 100000), dist.Entropy()},
+				{"mgf(.1)", expectation(dist, func(x float64) float64 { return math.Exp(.1 * x) }, 100000), dist.Moment(.1)},
+				{"mgf(-.1)", expectation(dist, func(x float64) float64 { return math.Exp(-.1 * x) }, 100000), dist.Moment(-.1)},
+			}
+			for _, m := range moments {
+				if math.Abs(m.Actual-m.Expected) > 1e-2*math.Max(1, math.Abs(m.Expected)) {
+					t.Errorf("%s %f, numerically %f", m.Name, m.Expected, m.Actual)
+				}
+			}
+
+			// Fisher information as the variance of the score of (μ, σ)
+			h := 1e-6
+			score := func(x float64) []float64 {
+				dm := (tc.New(1+h, 2).LogPDF(x) - tc.New(1-h, 2).LogPDF(x)) / (2 * h)
+				ds := (tc.New(1, 2+h).LogPDF(x) - tc.New(1, 2-h).LogPDF(x)) / (2 * h)
+				return []float64{dm, ds}
+			}
+			fisher := dist.FisherI()
+			for i := 0; i < 2; i++ {
+				for j := 0; j < 2; j++ {
+					v := expectation(dist, func(x float64) float64 { s := score(x); return s[i] * s[j] }, 100000)
+					if math.Abs(v-fisher[i][j]) > 1e-3 {
+						t.Errorf("I[%d][%d] = %f, numerically %f", i, j, fisher[i][j], v)
+					}
+				}
+			}
+		})
+	}
+
+	// The minimum of X is the opposite of the maximum of -X
+	max, min := &Gumbel{Mu: -1, Sigma: 2}, &GumbelMin{Mu: 1, Sigma: 2}
+	for _, x := range []float64{-10, 0, 1, 5} {
+		if v, e := min.CDF(x), max.Survival(-x); math.Abs(v-e) > 1e-15 {
+			t.Errorf("F(%g) = %g, expected %g", x, v, e)
+		}
+	}
+	if err := max.Init(0, 0); err == nil {
+		t.Errorf("expected error for σ = 0")
+	}
+}
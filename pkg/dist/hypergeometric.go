@@ -0,0 +1,228 @@
+package dist
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/ichbinfrog/statistics/pkg/specfun"
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// Hypergeometric represents the Hypergeometric distribution, the number
+// of successes in n draws without replacement from a population of size
+// N holding K successes
+// Discreet probability distribution function as follows:
+//		X ~ H(N, K, n), N > 0, 0 <= K <= N, 0 <= n <= N
+//		f(k,N,K,n) = {
+//			C(K, k)C(N - K, n - k) / C(N, n)
+//		}, k in [max(0, n + K - N), ..., min(n, K)]
+//
+type Hypergeometric struct {
+	source
+	Population, Successes, Draws float64
+}
+
+// Init intialises a Hypergeometric distribution
+func (h *Hypergeometric) Init(population, successes, draws float64) error {
+	if population <= 0 || successes < 0 || successes > population || draws < 0 || draws > population ||
+		population != math.Floor(population) || successes != math.Floor(successes) || draws != math.Floor(draws) {
+		return util.ErrHypergeometricParam
+	}
+	h.Population, h.Successes, h.Draws = population, successes, draws
+	return nil
+}
+
+// Generate creates one sample of the Hypergeometric distribution
+func (h *Hypergeometric) Generate() float64 {
+	return h.Rand(h.rng())
+}
+
+// GenerateN creates n samples of the Hypergeometric distribution
+func (h *Hypergeometric) GenerateN(n int) []float64 {
+	return GenerateN(h, h.rng(), n)
+}
+
+// Fill fills dst with samples of the Hypergeometric distribution
+func (h *Hypergeometric) Fill(dst []float64) {
+	Fill(h, h.rng(), dst)
+}
+
+// Rand creates one sample of the Hypergeometric distribution using the given generator
+// Algorithm: the draws are simulated one at a time, the i-th draw being a
+// success with probability (remaining successes) / (remaining population)
+// Complexity: O(n)
+//
+func (h *Hypergeometric) Rand(r *rand.Rand) float64 {
+	k, succ, pop := 0.0, h.Successes, h.Population
+	for i := 0.0; i < h.Draws; i++ {
+		if r.Float64()*pop < succ {
+			k++
+			succ--
+		}
+		pop--
+	}
+	return k
+}
+
+// Domain returns the definition domain of the distribution
+func (h *Hypergeometric) Domain() (float64, float64) {
+	return math.Max(0, h.Draws+h.Successes-h.Population), math.Min(h.Draws, h.Successes)
+}
+
+// PMF returns the probability mass function value of a given k
+func (h *Hypergeometric) PMF(k float64) float64 {
+	return math.Exp(h.LogPMF(k))
+}
+
+// LogPMF returns the log of the probability mass function value of a given k
+func (h *Hypergeometric) LogPMF(k float64) float64 {
+	dbeg, dend := h.Domain()
+	if k < dbeg || k > dend || k != math.Floor(k) {
+		return math.Inf(-1)
+	}
+	return specfun.LogChoose(h.Successes, k) + specfun.LogChoose(h.Population-h.Successes, h.Draws-k) - specfun.LogChoose(h.Population, h.Draws)
+}
+
+// mode returns the most likely value of the distribution
+func (h *Hypergeometric) mode() float64 {
+	return math.Floor((h.Draws + 1) * (h.Successes + 1) / (h.Population + 2))
+}
+
+// CDF returns the Cumulative distribution function value of a given k
+// Algorithm: the smallest of both tails is summed from k, the other one
+// being obtained by complement
+// Complexity: O(n)
+//
+func (h *Hypergeometric) CDF(k float64) float64 {
+	dbeg, dend := h.Domain()
+	if k < dbeg {
+		return 0
+	}
+	if k >= dend {
+		return 1
+	}
+	k = math.Floor(k)
+	if k < h.mode() {
+		return math.Exp(logDiscreteTail(h.LogPMF, k, -1, dbeg))
+	}
+	return 1 - h.Survival(k)
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given k
+func (h *Hypergeometric) LogCDF(k float64) float64 {
+	dbeg, _ := h.Domain()
+	if k < dbeg {
+		return math.Inf(-1)
+	}
+	if k = math.Floor(k); k < h.mode() {
+		return logDiscreteTail(h.LogPMF, k, -1, dbeg)
+	}
+	return math.Log1p(-h.Survival(k))
+}
+
+// Survival returns the survival function value of a given k
+func (h *Hypergeometric) Survival(k float64) float64 {
+	dbeg, dend := h.Domain()
+	if k < dbeg {
+		return 1
+	}
+	if k >= dend {
+		return 0
+	}
+	k = math.Floor(k)
+	if k >= h.mode() {
+		return math.Exp(logDiscreteTail(h.LogPMF, k+1, 1, dend))
+	}
+	return 1 - h.CDF(k)
+}
+
+// LogSurvival returns the log of the survival function value of a given k
+func (h *Hypergeometric) LogSurvival(k float64) float64 {
+	dbeg, dend := h.Domain()
+	if k < dbeg {
+		return 0
+	}
+	if k >= dend {
+		return math.Inf(-1)
+	}
+	if k = math.Floor(k); k >= h.mode() {
+		return logDiscreteTail(h.LogPMF, k+1, 1, dend)
+	}
+	return math.Log1p(-h.CDF(k))
+}
+
+// Quantile returns the p-th quantile of the distribution
+func (h *Hypergeometric) Quantile(p float64) float64 {
+	dbeg, dend := h.Domain()
+	sd := math.Sqrt(h.Var())
+	return discreteQuantile(h.CDF, p, dbeg, dend, cornishFisher(h.Mean(), sd, h.Skewness(), p))
+}
+
+// Mean returns the mean of the distribution
+func (h *Hypergeometric) Mean() float64 {
+	return h.Draws * h.Successes / h.Population
+}
+
+// Median returns the median of the distribution
+func (h *Hypergeometric) Median() float64 {
+	return h.Quantile(.5)
+}
+
+// Var returns the variance of the distribution
+func (h *Hypergeometric) Var() float64 {
+	N, K, n := h.Population, h.Successes, h.Draws
+	if N == 1 {
+		return 0
+	}
+	return n * K / N * (N - K) / N * (N - n) / (N - 1)
+}
+
+// Skewness returns the Pearson's moment coefficient of skewness of the distribution
+func (h *Hypergeometric) Skewness() float64 {
+	N, K, n := h.Population, h.Successes, h.Draws
+	return (N - 2*K) * math.Sqrt(N-1) * (N - 2*n) / (math.Sqrt(n*K*(N-K)*(N-n)) * (N - 2))
+}
+
+// Kurtosis returns the Kurtosis of the distribution
+func (h *Hypergeometric) Kurtosis() float64 {
+	N, K, n := h.Population, h.Successes, h.Draws
+	num := (N-1)*N*N*(N*(N+1)-6*K*(N-K)-6*n*(N-n)) + 6*n*K*(N-K)*(N-n)*(5*N-6)
+	return num / (n * K * (N - K) * (N - n) * (N - 2) * (N - 3))
+}
+
+// Moment returns the t-th moment of the distribution
+// Complexity: O(n)
+//
+func (h *Hypergeometric) Moment(t float64) float64 {
+	dbeg, dend := h.Domain()
+	sum := 0.0
+	for k := dbeg; k <= dend; k++ {
+		sum += math.Exp(h.LogPMF(k) + t*k)
+	}
+	return sum
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (h *Hypergeometric) RawMoment(k int) float64 {
+	return RawMoment(h, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (h *Hypergeometric) CentralMoment(k int) float64 {
+	return CentralMoment(h, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (h *Hypergeometric) Cumulant(k int) float64 {
+	return Cumulant(h, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (h *Hypergeometric) CF(t float64) complex128 {
+	return CF(h, t)
+}
+
+// Summary returns the properties of the distribution
+func (h *Hypergeometric) Summary() *DistSummary {
+	return summarise(h, "hypergeometric", Param{"population", h.Population}, Param{"successes", h.Successes}, Param{"draws", h.Draws})
+}
@@ -0,0 +1,68 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestHypergeometric(t *testing.T) {
+	dist := &Hypergeometric{}
+	if err := dist.Init(50, 20, 10); err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(dist.Summary())
+
+	// P(X = 3) = C(20, 3)C(30, 7) / C(50, 10)
+	if v := dist.PMF(3); math.Abs(v-1140.0*2035800/10272278170) > 1e-12 {
+		t.Errorf("f(3) = %g", v)
+	}
+
+	moments := []struct {
+		Name     string
+		Actual   float64
+		Expected float64
+	}{
+		{"mean", centralSum(dist, 1, 0), dist.Mean()},
+		{"var", centralSum(dist, 2, dist.Mean()), dist.Var()},
+		{"skewness", centralSum(dist, 3, dist.Mean()) / math.Pow(dist.Var(), 1.5), dist.Skewness()},
+		{"kurtosis", centralSum(dist, 4, dist.Mean())/math.Pow(dist.Var(), 2) - 3, dist.Kurtosis()},
+		{"mgf(.3)", expectedMgf(dist, .3), dist.Moment(.3)},
+	}
+	for _, m := range moments {
+		if math.Abs(m.Actual-m.Expected) > 1e-10*math.Max(1, math.Abs(m.Expected)) {
+			t.Errorf("%s %f, expected %f", m.Name, m.Expected, m.Actual)
+		}
+	}
+
+	// A large population behaves as a Binomial distribution
+	large, b := &Hypergeometric{Population: 1e7, Successes: 3e6, Draws: 40}, &Binomial{N: 40, P: .3, Q: .7}
+	for k := 0.0; k <= 40; k += 5 {
+		if v, e := large.CDF(k), b.CDF(k); math.Abs(v-e) > 1e-5 {
+			t.Errorf("F(%g) = %g, expected %g", k, v, e)
+		}
+	}
+
+	r := rand.New(rand.NewSource(1))
+	sum := 0.0
+	for i := 0; i < 10000; i++ {
+		sum += dist.Rand(r)
+	}
+	if mean := sum / 10000; math.Abs(mean-dist.Mean()) > 5*math.Sqrt(dist.Var()/10000) {
+		t.Errorf("sample mean %f, expected %f", mean, dist.Mean())
+	}
+	if err := dist.Init(10, 11, 2); err == nil {
+		t.Errorf("expected error for K > N")
+	}
+}
+
+// centralSum returns Σ f(k)(k - c)^n over the domain of a discrete distribution
+func centralSum(d Discrete, n, c float64) float64 {
+	dbeg, dend := d.Domain()
+	sum := 0.0
+	for k := dbeg; k <= dend; k++ {
+		sum += d.PMF(k) * math.Pow(k-c, n)
+	}
+	return sum
+}
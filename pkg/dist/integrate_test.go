@@ -0,0 +1,42 @@
+package dist
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTanhSinh(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		F        func(float64) float64
+		A, B     float64
+		Expected float64
+	}{
+		{"sin", math.Sin, 0, math.Pi, 2},
+		{"polynomial", func(x float64) float64 { return x * x * x }, -1, 3, 20},
+		{"log singularity", func(x float64) float64 { return -math.Log(x) }, 0, 1, 1},
+		{"sqrt singularity", func(x float64) float64 { return 1 / math.Sqrt(x) }, 0, 1, 2},
+		{"normal quantile", func(x float64) float64 { return math.Pow((&Normal{Mu: 0, Sigma: 1}).Quantile(x), 2) }, 0, 1, 1},
+		{"empty", math.Exp, 1, 1, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if v := tanhSinh(tc.F, tc.A, tc.B); math.Abs(v-tc.Expected) > 1e-9 {
+				t.Errorf("∫f = %.12f, expected %.12f", v, tc.Expected)
+			}
+		})
+	}
+}
+
+func TestDiscreteSum(t *testing.T) {
+	poisson := &Poisson{Lambda: 4}
+	// Rounding errors can keep the mass of a pmf below 1
+	short := func(k float64) float64 { return (1 - 1e-13) * poisson.PMF(k) }
+	if v := discreteSum(short, func(k float64) float64 { return k }, 0, math.Inf(1), 1); math.Abs(v-4) > 1e-9 {
+		t.Errorf("E[X] = %f, expected 4", v)
+	}
+	if v := discreteSum(poisson.PMF, func(k float64) float64 { return k * k }, 2, 5, 1); math.Abs(v-(4*poisson.PMF(2)+9*poisson.PMF(3)+16*poisson.PMF(4)+25*poisson.PMF(5))) > 1e-12 {
+		t.Errorf("bounded sum %f", v)
+	}
+}
@@ -0,0 +1,435 @@
+package dist
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/ichbinfrog/statistics/pkg/array"
+	"github.com/ichbinfrog/statistics/pkg/specfun"
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// Kernel is a symmetric probability density used to smooth each
+// observation of a kernel density estimation
+type Kernel struct {
+	Name string
+
+	// pdf, cdf and rand are those of the kernel with unit bandwidth
+	pdf  func(u float64) float64
+	cdf  func(u float64) float64
+	rand func(r *rand.Rand) float64
+	// window is the half width beyond which the kernel is null, or
+	// negligible for unbounded kernels
+	window  float64
+	bounded bool
+	// variance (∫u^2 K) and roughness (∫K^2) of the kernel, used to
+	// rescale the bandwidth rules
+	variance, roughness float64
+}
+
+var (
+	// GaussianKernel is the density of the standard Normal distribution
+	//		K(u) = exp(-u^2 / 2) / √(2π)
+	GaussianKernel = &Kernel{
+		Name: "gaussian",
+		pdf:  func(u float64) float64 { return math.Exp(-u*u/2) / math.Sqrt(2*math.Pi) },
+		cdf:  specfun.NormalCDF,
+		rand: func(r *rand.Rand) float64 { return r.NormFloat64() },
+		// Φ(-40) underflows
+		window:    40,
+		variance:  1,
+		roughness: 1 / (2 * math.Sqrt(math.Pi)),
+	}
+
+	// EpanechnikovKernel is the kernel minimising the asymptotic mean
+	// integrated squared error
+	//		K(u) = 3/4 (1 - u^2), |u| <= 1
+	EpanechnikovKernel = &Kernel{
+		Name: "epanechnikov",
+		pdf:  func(u float64) float64 { return .75 * (1 - u*u) },
+		cdf:  func(u float64) float64 { return .5 + u*(.75-u*u/4) },
+		// DEVROYE, Luc. Non-uniform random variate generation. 1986.
+		rand: func(r *rand.Rand) float64 {
+			u1, u2, u3 := 2*r.Float64()-1, 2*r.Float64()-1, 2*r.Float64()-1
+			if math.Abs(u3) >= math.Abs(u2) && math.Abs(u3) >= math.Abs(u1) {
+				return u2
+			}
+			return u3
+		},
+		window:    1,
+		bounded:   true,
+		variance:  1.0 / 5,
+		roughness: 3.0 / 5,
+	}
+
+	// UniformKernel is the rectangular kernel
+	//		K(u) = 1/2, |u| <= 1
+	UniformKernel = &Kernel{
+		Name:      "uniform",
+		pdf:       func(u float64) float64 { return .5 },
+		cdf:       func(u float64) float64 { return (1 + u) / 2 },
+		rand:      func(r *rand.Rand) float64 { return 2*r.Float64() - 1 },
+		window:    1,
+		bounded:   true,
+		variance:  1.0 / 3,
+		roughness: 1.0 / 2,
+	}
+
+	// TriangularKernel is the triangular kernel
+	//		K(u) = 1 - |u|, |u| <= 1
+	TriangularKernel = &Kernel{
+		Name: "triangular",
+		pdf:  func(u float64) float64 { return 1 - math.Abs(u) },
+		cdf: func(u float64) float64 {
+			if u < 0 {
+				return (1 + u) * (1 + u) / 2
+			}
+			return 1 - (1-u)*(1-u)/2
+		},
+		rand:      func(r *rand.Rand) float64 { return r.Float64() + r.Float64() - 1 },
+		window:    1,
+		bounded:   true,
+		variance:  1.0 / 6,
+		roughness: 2.0 / 3,
+	}
+
+	// BiweightKernel is the quartic kernel, the density of 2B - 1 for
+	// B ~ Beta(3, 3)
+	//		K(u) = 15/16 (1 - u^2)^2, |u| <= 1
+	BiweightKernel = &Kernel{
+		Name: "biweight",
+		pdf:  func(u float64) float64 { return 15.0 / 16 * (1 - u*u) * (1 - u*u) },
+		cdf: func(u float64) float64 {
+			u2 := u * u
+			return .5 + 15.0/16*u*(1-u2*(2.0/3-u2/5))
+		},
+		rand:      func(r *rand.Rand) float64 { return 2*(&Beta{Alpha: 3, Beta: 3}).Rand(r) - 1 },
+		window:    1,
+		bounded:   true,
+		variance:  1.0 / 7,
+		roughness: 5.0 / 7,
+	}
+)
+
+// Bandwidth is a rule selecting the bandwidth of a Gaussian kernel from
+// the observations
+type Bandwidth func(a *array.Arrayf64) float64
+
+// stddev returns the sample standard deviation of the array
+func stddev(a *array.Arrayf64) float64 {
+	_, variance := sampleMoments(a)
+	return math.Sqrt(variance * a.Length / (a.Length - 1))
+}
+
+// SilvermanBandwidth returns Silverman's rule of thumb, robust to heavy tails
+//		h = 0.9 min(σ, IQR / 1.34) n^(-1/5)
+//
+// SILVERMAN, Bernard W. Density estimation for statistics and data analysis. 1986.
+//
+func SilvermanBandwidth(a *array.Arrayf64) float64 {
+	s := stddev(a)
+	if iqr := a.IQR() / 1.34; iqr > 0 {
+		s = math.Min(s, iqr)
+	}
+	return .9 * s * math.Pow(a.Length, -.2)
+}
+
+// ScottBandwidth returns Scott's normal reference rule
+//		h = 1.06 σ n^(-1/5)
+//
+// SCOTT, David W. Multivariate density estimation. 1992.
+//
+func ScottBandwidth(a *array.Arrayf64) float64 {
+	return 1.06 * stddev(a) * math.Pow(a.Length, -.2)
+}
+
+// IQRBandwidth returns the interquartile range rule
+//		h = 0.79 IQR n^(-1/5)
+//
+// SILVERMAN, Bernard W. Density estimation for statistics and data analysis. 1986.
+//
+func IQRBandwidth(a *array.Arrayf64) float64 {
+	return .79 * a.IQR() * math.Pow(a.Length, -.2)
+}
+
+// KDE represents the kernel density estimation of a set of observations,
+// the mixture of n kernels centred on the observations
+// Continuous probability distribution function as follows:
+//		f(x) = 1/(nh) Σ K((x - x_i) / h)
+//		F(x) = 1/n Σ F_K((x - x_i) / h)
+//
+type KDE struct {
+	source
+	// Data holds the sorted observations
+	Data      []float64
+	Kernel    *Kernel
+	Bandwidth float64
+}
+
+// Init intialises a kernel density estimation from the observations of the
+// array. The bandwidth rule, expressed for a Gaussian kernel, is rescaled to
+// the chosen kernel with the canonical bandwidth ratio
+//		h_K = h (R(K) / μ2(K)^2)^(1/5) / (R(φ) / μ2(φ)^2)^(1/5)
+//
+// MARRON, James S., NOLAN, David. Canonical kernels for density estimation. 1988.
+//
+func (k *KDE) Init(a *array.Arrayf64, kernel *Kernel, rule Bandwidth) error {
+	if err := checkSample(a, 2, math.Inf(-1), math.Inf(1), false); err != nil {
+		return err
+	}
+	if kernel == nil || rule == nil {
+		return util.ErrKDEParam
+	}
+	h := rule(a) * math.Pow(kernel.roughness/(kernel.variance*kernel.variance)/GaussianKernel.roughness, .2)
+	if !(h > 0) || math.IsInf(h, 0) {
+		return util.ErrKDEParam
+	}
+	k.Data = make([]float64, len(a.Data))
+	copy(k.Data, a.Data)
+	k.Kernel, k.Bandwidth = kernel, h
+	return nil
+}
+
+// n returns the number of observations
+func (k *KDE) n() float64 {
+	return float64(len(k.Data))
+}
+
+// window returns the indices [lo, hi) of the observations whose kernel
+// does not vanish at x
+// Complexity: O(log(n))
+//
+func (k *KDE) window(x float64) (int, int) {
+	w := k.Kernel.window * k.Bandwidth
+	lo := sort.SearchFloat64s(k.Data, x-w)
+	hi := sort.Search(len(k.Data), func(i int) bool { return k.Data[i] > x+w })
+	return lo, hi
+}
+
+// Generate creates one sample of the kernel density estimation
+func (k *KDE) Generate() float64 {
+	return k.Rand(k.rng())
+}
+
+// GenerateN creates n samples of the kernel density estimation
+func (k *KDE) GenerateN(n int) []float64 {
+	return GenerateN(k, k.rng(), n)
+}
+
+// Fill fills dst with samples of the kernel density estimation
+func (k *KDE) Fill(dst []float64) {
+	Fill(k, k.rng(), dst)
+}
+
+// Rand creates one sample of the kernel density estimation using the given generator
+// Algorithm: smoothed bootstrap
+//		x = x_i + h u, i ~ U{1, n}, u ~ K
+//
+func (k *KDE) Rand(r *rand.Rand) float64 {
+	return k.Data[r.Intn(len(k.Data))] + k.Bandwidth*k.Kernel.rand(r)
+}
+
+// Domain returns the definition domain of the distribution
+func (k *KDE) Domain() (float64, float64) {
+	if !k.Kernel.bounded {
+		return math.Inf(-1), math.Inf(1)
+	}
+	w := k.Kernel.window * k.Bandwidth
+	return k.Data[0] - w, k.Data[len(k.Data)-1] + w
+}
+
+// PDF returns the probability density function value of a given x
+// Complexity: O(log(n) + m), m being the number of observations within
+// the window of the kernel
+//
+func (k *KDE) PDF(x float64) float64 {
+	lo, hi := k.window(x)
+	sum := 0.0
+	for _, v := range k.Data[lo:hi] {
+		sum += k.Kernel.pdf((x - v) / k.Bandwidth)
+	}
+	return sum / (k.n() * k.Bandwidth)
+}
+
+// LogPDF returns the log of the probability density function value of a given x
+func (k *KDE) LogPDF(x float64) float64 {
+	return math.Log(k.PDF(x))
+}
+
+// CDF returns the Cumulative distribution function value of a given x
+// Complexity: O(log(n) + m)
+//
+func (k *KDE) CDF(x float64) float64 {
+	lo, hi := k.window(x)
+	// Every kernel left of the window is entirely below x
+	sum := float64(lo)
+	for _, v := range k.Data[lo:hi] {
+		sum += k.Kernel.cdf((x - v) / k.Bandwidth)
+	}
+	return math.Min(1, sum/k.n())
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given x
+func (k *KDE) LogCDF(x float64) float64 {
+	return math.Log(k.CDF(x))
+}
+
+// Survival returns the survival function value of a given x
+func (k *KDE) Survival(x float64) float64 {
+	lo, hi := k.window(x)
+	sum := float64(len(k.Data) - hi)
+	for _, v := range k.Data[lo:hi] {
+		// The kernels are symmetric
+		sum += k.Kernel.cdf((v - x) / k.Bandwidth)
+	}
+	return math.Min(1, sum/k.n())
+}
+
+// LogSurvival returns the log of the survival function value of a given x
+func (k *KDE) LogSurvival(x float64) float64 {
+	return math.Log(k.Survival(x))
+}
+
+// Quantile returns the p-th quantile of the distribution
+// Algorithm: numerical inversion of the cdf starting from the sample quantile
+//
+func (k *KDE) Quantile(p float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	dbeg, dend := k.Domain()
+	x0 := k.Data[int(math.Min(k.n()-1, math.Floor(p*k.n())))]
+	return continuousQuantile(k.CDF, p, dbeg, dend, x0, k.Bandwidth)
+}
+
+// Grid returns the density evaluated at n evenly spaced points of [lo, hi]
+func (k *KDE) Grid(lo, hi float64, n int) ([]float64, []float64) {
+	x, y := make([]float64, n), make([]float64, n)
+	for i := range x {
+		x[i] = lo
+		if n > 1 {
+			x[i] += (hi - lo) * float64(i) / float64(n-1)
+		}
+		y[i] = k.PDF(x[i])
+	}
+	return x, y
+}
+
+// Mean returns the mean of the distribution, that of the observations
+func (k *KDE) Mean() float64 {
+	sum := 0.0
+	for _, v := range k.Data {
+		sum += v
+	}
+	return sum / k.n()
+}
+
+// Median returns the median of the distribution
+func (k *KDE) Median() float64 {
+	return k.Quantile(.5)
+}
+
+// Var returns the variance of the distribution
+//		Var(X) = σ^2 + h^2 μ2(K), σ^2 being the biased sample variance
+//
+func (k *KDE) Var() float64 {
+	mean, sum := k.Mean(), 0.0
+	for _, v := range k.Data {
+		sum += (v - mean) * (v - mean)
+	}
+	return sum/k.n() + k.Bandwidth*k.Bandwidth*k.Kernel.variance
+}
+
+// kernelIntegral returns E[g(U)] = ∫ g(u) K(u) du, U following the kernel,
+// integrated piecewise over unit intervals of its window
+func (k *KDE) kernelIntegral(g func(float64) float64) float64 {
+	f := func(u float64) float64 { return g(u) * k.Kernel.pdf(u) }
+	sum := 0.0
+	for a := -k.Kernel.window; a < k.Kernel.window; a++ {
+		sum += tanhSinh(f, a, math.Min(a+1, k.Kernel.window))
+	}
+	return sum
+}
+
+// expectation returns E[g(X)] = 1/n Σ_i E[g(x_i + hU)]
+func (k *KDE) expectation(g func(float64) float64) float64 {
+	sum := 0.0
+	for _, v := range k.Data {
+		sum += k.kernelIntegral(func(u float64) float64 { return g(v + k.Bandwidth*u) })
+	}
+	return sum / k.n()
+}
+
+// kernelMoments returns E[(hU)^j] for j <= order, U following the kernel
+// and h being the bandwidth
+func (k *KDE) kernelMoments(order int) []float64 {
+	res := make([]float64, order+1)
+	for j := range res {
+		if j%2 == 1 {
+			// Kernels are symmetric
+			continue
+		}
+		jf := float64(j)
+		res[j] = math.Pow(k.Bandwidth, jf) * k.kernelIntegral(func(u float64) float64 { return math.Pow(u, jf) })
+	}
+	return res
+}
+
+// momentAbout returns E[(X - c)^j] = 1/n Σ_i E[(x_i - c + hU)^j]
+func (k *KDE) momentAbout(c float64, j int) float64 {
+	m := k.kernelMoments(j)
+	moment := func(i int) float64 { return m[i] }
+	sum := 0.0
+	for _, v := range k.Data {
+		sum += shiftMoment(moment, v-c, j)
+	}
+	return sum / k.n()
+}
+
+// rawMoment returns E[X^j]
+func (k *KDE) rawMoment(j int) float64 {
+	return k.momentAbout(0, j)
+}
+
+// centralMoment returns E[(X - E[X])^j]
+func (k *KDE) centralMoment(j int) float64 {
+	return k.momentAbout(k.Mean(), j)
+}
+
+// cf returns the characteristic function of the distribution at t
+//		φ(t) = φ_K(ht) 1/n Σ_i exp(itx_i), φ_K(s) = ∫ cos(su) K(u) du
+//
+func (k *KDE) cf(t float64) complex128 {
+	kernel := k.kernelIntegral(func(u float64) float64 { return math.Cos(k.Bandwidth * t * u) })
+	var sum complex128
+	for _, v := range k.Data {
+		sum += expi(t * v)
+	}
+	return sum * complex(kernel/k.n(), 0)
+}
+
+// RawMoment returns the n-th raw moment E[X^n] of the distribution
+func (k *KDE) RawMoment(n int) float64 {
+	return RawMoment(k, n)
+}
+
+// CentralMoment returns the n-th central moment E[(X - E[X])^n] of the distribution
+func (k *KDE) CentralMoment(n int) float64 {
+	return CentralMoment(k, n)
+}
+
+// Cumulant returns the n-th cumulant of the distribution
+func (k *KDE) Cumulant(n int) float64 {
+	return Cumulant(k, n)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (k *KDE) CF(t float64) complex128 {
+	return CF(k, t)
+}
+
+// Summary returns the properties of the distribution
+func (k *KDE) Summary() *DistSummary {
+	return summarise(k, "kde", Param{"bandwidth", k.Bandwidth}, Param{"n", float64(len(k.Data))})
+}
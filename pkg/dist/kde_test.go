@@ -0,0 +1,125 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/ichbinfrog/statistics/pkg/array"
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+func TestKernel(t *testing.T) {
+	for _, k := range []*Kernel{GaussianKernel, EpanechnikovKernel, UniformKernel, TriangularKernel, BiweightKernel} {
+		t.Run(k.Name, func(t *testing.T) {
+			// The pdf integrates to 1 and to the cdf, and matches the moments
+			sum, variance, roughness, h := 0.0, 0.0, 0.0, 1e-4
+			for u := -k.window + h/2; u < k.window; u += h {
+				f := k.pdf(u)
+				sum += f * h
+				variance += u * u * f * h
+				roughness += f * f * h
+				if math.Abs(sum-k.cdf(u+h/2)) > 1e-6 {
+					t.Fatalf("∫K = %f, F(%f) = %f", sum, u+h/2, k.cdf(u+h/2))
+				}
+			}
+			if math.Abs(sum-1) > 1e-6 || math.Abs(variance-k.variance) > 1e-6 || math.Abs(roughness-k.roughness) > 1e-6 {
+				t.Errorf("∫K = %f, ∫u²K = %f, ∫K² = %f", sum, variance, roughness)
+			}
+
+			r := rand.New(rand.NewSource(1))
+			n, mean, sq := 50000, 0.0, 0.0
+			for i := 0; i < n; i++ {
+				u := k.rand(r)
+				if k.bounded && math.Abs(u) > 1 {
+					t.Fatalf("sample %f outside of [-1, 1]", u)
+				}
+				mean += u / float64(n)
+				sq += u * u / float64(n)
+			}
+			if math.Abs(mean) > 2e-2 || math.Abs(sq-k.variance) > 2e-2 {
+				t.Errorf("sample moments (%f, %f), expected (0, %f)", mean, sq, k.variance)
+			}
+		})
+	}
+}
+
+func TestKDE(t *testing.T) {
+	a := &array.Arrayf64{}
+	a.Init(array.Optionf64{Degree: 2})
+	a.InsertSlice([]float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+
+	sd := math.Sqrt(a.Var())
+	testCases := []struct {
+		Name     string
+		Value    float64
+		Expected float64
+	}{
+		{"silverman", SilvermanBandwidth(a), .9 * math.Min(sd, a.IQR()/1.34) * math.Pow(10, -.2)},
+		{"scott", ScottBandwidth(a), 1.06 * sd * math.Pow(10, -.2)},
+		{"iqr", IQRBandwidth(a), .79 * a.IQR() * math.Pow(10, -.2)},
+	}
+	for _, tc := range testCases {
+		if math.Abs(tc.Value-tc.Expected) > 1e-12 {
+			t.Errorf("%s = %f, expected %f", tc.Name, tc.Value, tc.Expected)
+		}
+	}
+
+	// The bandwidth rules are rescaled to the canonical bandwidth of the kernel
+	dist := &KDE{}
+	if err := dist.Init(a, EpanechnikovKernel, ScottBandwidth); err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(dist.Summary())
+	if v, e := dist.Bandwidth, ScottBandwidth(a)*math.Pow(15/GaussianKernel.roughness, .2); math.Abs(v-e) > 1e-12 {
+		t.Errorf("h = %f, expected %f", v, e)
+	}
+
+	for _, kernel := range []*Kernel{GaussianKernel, EpanechnikovKernel, BiweightKernel} {
+		d := &KDE{}
+		d.Init(a, kernel, SilvermanBandwidth)
+		x, y := d.Grid(-20, 30, 50001)
+		sum, sq := 0.0, 0.0
+		for i := range x {
+			sum += y[i] * 1e-3
+			sq += (x[i] - d.Mean()) * (x[i] - d.Mean()) * y[i] * 1e-3
+		}
+		if math.Abs(sum-1) > 1e-6 || math.Abs(sq-d.Var()) > 1e-4 {
+			t.Errorf("%s: ∫f = %f, Var(X) = %f, expected 1 and %f", kernel.Name, sum, sq, d.Var())
+		}
+		for _, p := range []float64{1e-6, .1, .5, .9} {
+			if v := d.CDF(d.Quantile(p)); math.Abs(v-p) > 1e-9 {
+				t.Errorf("%s: F(Q(%f)) = %f", kernel.Name, p, v)
+			}
+			if v := d.CDF(d.Quantile(p)) + d.Survival(d.Quantile(p)); math.Abs(v-1) > 1e-12 {
+				t.Errorf("%s: F + S = %f", kernel.Name, v)
+			}
+		}
+	}
+
+	// The estimation of a large Normal sample is close to its density
+	normal := &Normal{Mu: 5, Sigma: 2}
+	kde := &KDE{}
+	kde.Init(sample(normal, 5000, 1), GaussianKernel, SilvermanBandwidth)
+	for x := -1.0; x <= 11; x++ {
+		if v, e := kde.PDF(x), normal.PDF(x); math.Abs(v-e) > 1e-2 {
+			t.Errorf("f(%f) = %f, expected %f", x, v, e)
+		}
+	}
+	r := rand.New(rand.NewSource(1))
+	n, mean := 20000, 0.0
+	for i := 0; i < n; i++ {
+		mean += kde.Rand(r) / float64(n)
+	}
+	if math.Abs(mean-kde.Mean()) > 4*math.Sqrt(kde.Var()/float64(n)) {
+		t.Errorf("sample mean %f, expected %f", mean, kde.Mean())
+	}
+
+	constant := &array.Arrayf64{}
+	constant.Init(array.Optionf64{Degree: 2})
+	constant.InsertSlice([]float64{1, 1, 1})
+	if err := (&KDE{}).Init(constant, GaussianKernel, ScottBandwidth); err != util.ErrKDEParam {
+		t.Errorf("expected %v, got %v", util.ErrKDEParam, err)
+	}
+}
@@ -0,0 +1,234 @@
+package dist
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/ichbinfrog/statistics/pkg/array"
+	"github.com/ichbinfrog/statistics/pkg/specfun"
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// Laplace represents the Laplace (double exponential) distribution with
+// location μ and scale σ
+// Continuous probability distribution function as follows:
+//		X ~ L(μ, σ), σ > 0
+//
+//		f(x,μ,σ) = exp(-|x-μ|/σ) / 2σ
+//
+type Laplace struct {
+	source
+	Mu, Sigma float64
+}
+
+// Init intialises a Laplace distribution
+func (l *Laplace) Init(mu, sigma float64) error {
+	if sigma <= 0 {
+		return util.ErrLaplaceParam
+	}
+	l.Mu, l.Sigma = mu, sigma
+	return nil
+}
+
+// Generate creates one sample of the Laplace distribution
+func (l *Laplace) Generate() float64 {
+	return l.Rand(l.rng())
+}
+
+// GenerateN creates n samples of the Laplace distribution
+func (l *Laplace) GenerateN(n int) []float64 {
+	return GenerateN(l, l.rng(), n)
+}
+
+// Fill fills dst with samples of the Laplace distribution
+func (l *Laplace) Fill(dst []float64) {
+	Fill(l, l.rng(), dst)
+}
+
+// Rand creates one sample of the Laplace distribution using the given generator
+// Algorithm:
+//		X = μ + σ(E1 - E2), E1, E2 ~ Exp(1)
+//
+func (l *Laplace) Rand(r *rand.Rand) float64 {
+	return l.Mu + l.Sigma*(r.ExpFloat64()-r.ExpFloat64())
+}
+
+// Domain returns the definition domain of the distribution
+func (l *Laplace) Domain() (float64, float64) {
+	return math.Inf(-1), math.Inf(0)
+}
+
+// PDF returns the probability density function value of a given x
+func (l *Laplace) PDF(x float64) float64 {
+	return math.Exp(l.LogPDF(x))
+}
+
+// LogPDF returns the log of the probability density function value of a given x
+func (l *Laplace) LogPDF(x float64) float64 {
+	return -math.Abs(x-l.Mu)/l.Sigma - math.Log(2*l.Sigma)
+}
+
+// CDF returns the Cumulative distribution function value of a given x
+func (l *Laplace) CDF(x float64) float64 {
+	z := (x - l.Mu) / l.Sigma
+	if z < 0 {
+		return math.Exp(z) / 2
+	}
+	return 1 - math.Exp(-z)/2
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given x
+func (l *Laplace) LogCDF(x float64) float64 {
+	z := (x - l.Mu) / l.Sigma
+	if z < 0 {
+		return z - math.Ln2
+	}
+	return math.Log1p(-math.Exp(-z) / 2)
+}
+
+// Survival returns the survival function value of a given x
+func (l *Laplace) Survival(x float64) float64 {
+	return l.CDF(2*l.Mu - x)
+}
+
+// LogSurvival returns the log of the survival function value of a given x
+func (l *Laplace) LogSurvival(x float64) float64 {
+	return l.LogCDF(2*l.Mu - x)
+}
+
+// Quantile returns the p-th quantile of the distribution
+func (l *Laplace) Quantile(p float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	if p < .5 {
+		return l.Mu + l.Sigma*math.Log(2*p)
+	}
+	return l.Mu - l.Sigma*math.Log(2*(1-p))
+}
+
+// Mean returns the mean of the distribution
+func (l *Laplace) Mean() float64 {
+	return l.Mu
+}
+
+// Median returns the median of the distribution
+func (l *Laplace) Median() float64 {
+	return l.Mu
+}
+
+// Var returns the variance of the distribution
+func (l *Laplace) Var() float64 {
+	return 2 * l.Sigma * l.Sigma
+}
+
+// Skewness returns the Pearson's moment coefficient of skewness of the distribution
+func (l *Laplace) Skewness() float64 {
+	return 0
+}
+
+// Kurtosis returns the Kurtosis of the distribution
+func (l *Laplace) Kurtosis() float64 {
+	return 3
+}
+
+// Entropy returns the Entropy of the distribution
+func (l *Laplace) Entropy() float64 {
+	return math.Log(2 * math.E * l.Sigma)
+}
+
+// Moment returns the t-th moment of the distribution, defined for |t| < 1/σ
+func (l *Laplace) Moment(t float64) float64 {
+	if math.Abs(t) >= 1/l.Sigma {
+		return math.NaN()
+	}
+	return math.Exp(l.Mu*t) / (1 - l.Sigma*l.Sigma*t*t)
+}
+
+// centralMoment returns E[(X - μ)^k] = k! σ^k for even k, 0 otherwise
+func (l *Laplace) centralMoment(k int) float64 {
+	if k%2 == 1 {
+		return 0
+	}
+	return math.Exp(specfun.LogFactorial(float64(k)) + float64(k)*math.Log(l.Sigma))
+}
+
+// cumulant returns the k-th cumulant, κ_1 = μ, κ_k = 2 (k-1)! σ^k for even k
+// and 0 otherwise
+func (l *Laplace) cumulant(k int) float64 {
+	switch {
+	case k == 1:
+		return l.Mu
+	case k%2 == 1:
+		return 0
+	}
+	return 2 * math.Exp(specfun.LogFactorial(float64(k-1))+float64(k)*math.Log(l.Sigma))
+}
+
+// cf returns the characteristic function of the distribution at t
+//		φ(t) = exp(iμt) / (1 + σ^2 t^2)
+//
+func (l *Laplace) cf(t float64) complex128 {
+	return expi(l.Mu*t) / complex(1+l.Sigma*l.Sigma*t*t, 0)
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (l *Laplace) RawMoment(k int) float64 {
+	return RawMoment(l, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (l *Laplace) CentralMoment(k int) float64 {
+	return CentralMoment(l, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (l *Laplace) Cumulant(k int) float64 {
+	return Cumulant(l, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (l *Laplace) CF(t float64) complex128 {
+	return CF(l, t)
+}
+
+// FisherI returns the Fisher Information of the distribution
+func (l *Laplace) FisherI() [][]float64 {
+	return [][]float64{
+		[]float64{1 / (l.Sigma * l.Sigma), 0},
+		[]float64{0, 1 / (l.Sigma * l.Sigma)},
+	}
+}
+
+// Summary returns the properties of the distribution
+func (l *Laplace) Summary() *DistSummary {
+	return summarise(l, "laplace", Param{"mu", l.Mu}, Param{"sigma", l.Sigma})
+}
+
+// FitLaplace returns the maximum likelihood estimation of a Laplace
+// distribution from the given observations:
+//		μ = median(X), σ = E[|X - μ|]
+//
+// Complexity: O(n)
+//
+func FitLaplace(a *array.Arrayf64) (*Laplace, *FitResult, error) {
+	if err := checkSample(a, 2, math.Inf(-1), math.Inf(0), false); err != nil {
+		return nil, nil, err
+	}
+	mu := a.Median()
+	dev := 0.0
+	for _, v := range a.Data {
+		dev += math.Abs(v - mu)
+	}
+	dev /= a.Length
+
+	l := &Laplace{}
+	if err := l.Init(mu, dev); err != nil {
+		return nil, nil, util.ErrFitConvergence
+	}
+	return l, &FitResult{
+		LogLikelihood: -a.Length * (math.Log(2*dev) + 1),
+		StdErr:        fisherStdErr(l.FisherI(), a.Length),
+		Observations:  a.Length,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestLaplace(t *testing.T) {
+	dist := &Laplace{}
+	if err := dist.Init(1, 2); err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(dist.Summary())
+
+	moments := []struct {
+		Name     string
+		Actual   float64
+		Expected float64
+	}{
+		{"mean", expectation(dist, func(x float64) float64 { return x }, 100000), dist.Mean()},
+		{"var", expectation(dist, func(x float64) float64 { return math.Pow(x-dist.Mean(), 2) }, 100000), dist.Var()},
+		{"kurtosis", expectation(dist, func(x float64) float64 { return math.Pow(x-dist.Mean(), 4) }, 100000)/math.Pow(dist.Var(), 2) - 3, dist.Kurtosis()},
+		{"entropy", expectation(dist, func(x float64) float64 { return -dist.LogPDF(x) }, 100000), dist.Entropy()},
+		{"mgf(.2)", expectation(dist, func(x float64) float64 { return math.Exp(.2 * x) }, 100000), dist.Moment(.2)},
+	}
+	for _, m := range moments {
+		if math.Abs(m.Actual-m.Expected) > 1e-2*math.Max(1, math.Abs(m.Expected)) {
+			t.Errorf("%s %f, numerically %f", m.Name, m.Expected, m.Actual)
+		}
+	}
+	if !math.IsNaN(dist.Moment(.5)) {
+		t.Errorf("Mx(.5) = %f, expected NaN", dist.Moment(.5))
+	}
+	if v, e := dist.LogCDF(-1000), -1001.0/2-math.Ln2; math.Abs(v-e) > 1e-12 {
+		t.Errorf("log F(-1000) = %f, expected %f", v, e)
+	}
+	if err := dist.Init(0, -1); err == nil {
+		t.Errorf("expected error for σ < 0")
+	}
+}
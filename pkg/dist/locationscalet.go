@@ -0,0 +1,163 @@
+package dist
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// LocationScaleT represents the location-scale Student's t distribution,
+// X = μ + σT where T follows the Student's t distribution with ν degrees
+// of freedom
+// Continuous probability distribution function as follows:
+//		X ~ t(ν, μ, σ), ν > 0, σ > 0
+//
+//		f(x,ν,μ,σ) = Γ((ν+1)/2) / (σ√(νπ) Γ(ν/2)) * (1 + ((x-μ)/σ)^2/ν)^(-(ν+1)/2)
+//
+type LocationScaleT struct {
+	source
+	Nu, Mu, Sigma float64
+}
+
+// Init intialises a location-scale Student's t distribution
+func (l *LocationScaleT) Init(nu, mu, sigma float64) error {
+	if nu <= 0 || sigma <= 0 {
+		return util.ErrLocationScaleTParam
+	}
+	l.Nu, l.Mu, l.Sigma = nu, mu, sigma
+	return nil
+}
+
+// standard returns the Student's t distribution of (X - μ) / σ
+func (l *LocationScaleT) standard() *StudentT {
+	return &StudentT{Nu: l.Nu}
+}
+
+// Generate creates one sample of the location-scale Student's t distribution
+func (l *LocationScaleT) Generate() float64 {
+	return l.Rand(l.rng())
+}
+
+// GenerateN creates n samples of the location-scale Student's t distribution
+func (l *LocationScaleT) GenerateN(n int) []float64 {
+	return GenerateN(l, l.rng(), n)
+}
+
+// Fill fills dst with samples of the location-scale Student's t distribution
+func (l *LocationScaleT) Fill(dst []float64) {
+	Fill(l, l.rng(), dst)
+}
+
+// Rand creates one sample of the location-scale Student's t distribution using the given generator
+func (l *LocationScaleT) Rand(r *rand.Rand) float64 {
+	return l.Mu + l.Sigma*l.standard().Rand(r)
+}
+
+// Domain returns the definition domain of the distribution
+func (l *LocationScaleT) Domain() (float64, float64) {
+	return math.Inf(-1), math.Inf(0)
+}
+
+// PDF returns the probability density function value of a given x
+func (l *LocationScaleT) PDF(x float64) float64 {
+	return math.Exp(l.LogPDF(x))
+}
+
+// LogPDF returns the log of the probability density function value of a given x
+func (l *LocationScaleT) LogPDF(x float64) float64 {
+	return l.standard().LogPDF((x-l.Mu)/l.Sigma) - math.Log(l.Sigma)
+}
+
+// CDF returns the Cumulative distribution function value of a given x
+func (l *LocationScaleT) CDF(x float64) float64 {
+	return l.standard().CDF((x - l.Mu) / l.Sigma)
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given x
+func (l *LocationScaleT) LogCDF(x float64) float64 {
+	return l.standard().LogCDF((x - l.Mu) / l.Sigma)
+}
+
+// Survival returns the survival function value of a given x
+func (l *LocationScaleT) Survival(x float64) float64 {
+	return l.standard().Survival((x - l.Mu) / l.Sigma)
+}
+
+// LogSurvival returns the log of the survival function value of a given x
+func (l *LocationScaleT) LogSurvival(x float64) float64 {
+	return l.standard().LogSurvival((x - l.Mu) / l.Sigma)
+}
+
+// Quantile returns the p-th quantile of the distribution
+func (l *LocationScaleT) Quantile(p float64) float64 {
+	return l.Mu + l.Sigma*l.standard().Quantile(p)
+}
+
+// Mean returns the mean of the distribution, undefined for ν <= 1
+func (l *LocationScaleT) Mean() float64 {
+	return l.Mu + l.standard().Mean()
+}
+
+// Median returns the median of the distribution
+func (l *LocationScaleT) Median() float64 {
+	return l.Mu
+}
+
+// Var returns the variance of the distribution, infinite for 1 < ν <= 2
+// and undefined for ν <= 1
+func (l *LocationScaleT) Var() float64 {
+	return l.Sigma * l.Sigma * l.standard().Var()
+}
+
+// Skewness returns the Pearson's moment coefficient of skewness of the distribution
+func (l *LocationScaleT) Skewness() float64 {
+	return l.standard().Skewness()
+}
+
+// Kurtosis returns the Kurtosis of the distribution
+func (l *LocationScaleT) Kurtosis() float64 {
+	return l.standard().Kurtosis()
+}
+
+// Entropy returns the Entropy of the distribution
+func (l *LocationScaleT) Entropy() float64 {
+	return l.standard().Entropy() + math.Log(l.Sigma)
+}
+
+// centralMoment returns E[(X - μ)^k] = σ^k E[T^k]
+func (l *LocationScaleT) centralMoment(k int) float64 {
+	return math.Pow(l.Sigma, float64(k)) * l.standard().centralMoment(k)
+}
+
+// cf returns the characteristic function of the distribution at t
+//		φ(t) = exp(iμt) φ_T(σt)
+//
+func (l *LocationScaleT) cf(t float64) complex128 {
+	return expi(l.Mu*t) * CF(l.standard(), l.Sigma*t)
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (l *LocationScaleT) RawMoment(k int) float64 {
+	return RawMoment(l, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (l *LocationScaleT) CentralMoment(k int) float64 {
+	return CentralMoment(l, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (l *LocationScaleT) Cumulant(k int) float64 {
+	return Cumulant(l, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (l *LocationScaleT) CF(t float64) complex128 {
+	return CF(l, t)
+}
+
+// Summary returns the properties of the distribution
+func (l *LocationScaleT) Summary() *DistSummary {
+	return summarise(l, "locationscalet", Param{"nu", l.Nu}, Param{"mu", l.Mu}, Param{"sigma", l.Sigma})
+}
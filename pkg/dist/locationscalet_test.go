@@ -0,0 +1,39 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestLocationScaleT(t *testing.T) {
+	dist := &LocationScaleT{}
+	if err := dist.Init(5, 2, 3); err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(dist.Summary())
+
+	// X = μ + σT
+	std := &StudentT{Nu: 5}
+	for _, x := range []float64{-30, -2, 0, 2, 3.5, 30} {
+		z := (x - dist.Mu) / dist.Sigma
+		if v, e := dist.PDF(x), std.PDF(z)/dist.Sigma; math.Abs(v-e) > 1e-14 {
+			t.Errorf("f(%g) = %g, expected %g", x, v, e)
+		}
+		if v, e := dist.CDF(x), std.CDF(z); math.Abs(v-e) > 1e-14 {
+			t.Errorf("F(%g) = %g, expected %g", x, v, e)
+		}
+		if v := dist.Quantile(dist.CDF(x)); math.Abs(v-x) > 1e-9*math.Max(1, math.Abs(x)) {
+			t.Errorf("Q(F(%g)) = %g", x, v)
+		}
+	}
+	if v := dist.Var(); math.Abs(v-9*5./3) > 1e-14 {
+		t.Errorf("variance %g, expected %g", v, 9*5./3)
+	}
+	if h := expectation(dist, func(x float64) float64 { return -dist.LogPDF(x) }, 100000); math.Abs(h-dist.Entropy()) > 1e-3 {
+		t.Errorf("entropy %f, numerically %f", dist.Entropy(), h)
+	}
+	if err := dist.Init(5, 0, 0); err == nil {
+		t.Error("expected an error for σ = 0")
+	}
+}
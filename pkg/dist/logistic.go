@@ -0,0 +1,199 @@
+package dist
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/ichbinfrog/statistics/pkg/specfun"
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// Logistic represents the logistic distribution with location μ and scale σ
+// Continuous probability distribution function as follows:
+//		X ~ Logistic(μ, σ), σ > 0
+//
+//		f(x,μ,σ) = exp(-z) / (σ(1 + exp(-z))^2), z = (x-μ)/σ
+//
+type Logistic struct {
+	source
+	Mu, Sigma float64
+}
+
+// Init intialises a Logistic distribution
+func (l *Logistic) Init(mu, sigma float64) error {
+	if sigma <= 0 {
+		return util.ErrLogisticParam
+	}
+	l.Mu, l.Sigma = mu, sigma
+	return nil
+}
+
+// Generate creates one sample of the Logistic distribution
+func (l *Logistic) Generate() float64 {
+	return l.Rand(l.rng())
+}
+
+// GenerateN creates n samples of the Logistic distribution
+func (l *Logistic) GenerateN(n int) []float64 {
+	return GenerateN(l, l.rng(), n)
+}
+
+// Fill fills dst with samples of the Logistic distribution
+func (l *Logistic) Fill(dst []float64) {
+	Fill(l, l.rng(), dst)
+}
+
+// Rand creates one sample of the Logistic distribution using the given generator
+func (l *Logistic) Rand(r *rand.Rand) float64 {
+	return l.Quantile(r.Float64())
+}
+
+// Domain returns the definition domain of the distribution
+func (l *Logistic) Domain() (float64, float64) {
+	return math.Inf(-1), math.Inf(0)
+}
+
+// softplus returns log(1 + exp(x)) without overflowing
+func softplus(x float64) float64 {
+	if x > 0 {
+		return x + math.Log1p(math.Exp(-x))
+	}
+	return math.Log1p(math.Exp(x))
+}
+
+// PDF returns the probability density function value of a given x
+func (l *Logistic) PDF(x float64) float64 {
+	return math.Exp(l.LogPDF(x))
+}
+
+// LogPDF returns the log of the probability density function value of a given x
+func (l *Logistic) LogPDF(x float64) float64 {
+	z := math.Abs(x-l.Mu) / l.Sigma
+	return -z - math.Log(l.Sigma) - 2*math.Log1p(math.Exp(-z))
+}
+
+// CDF returns the Cumulative distribution function value of a given x
+func (l *Logistic) CDF(x float64) float64 {
+	return 1 / (1 + math.Exp(-(x-l.Mu)/l.Sigma))
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given x
+func (l *Logistic) LogCDF(x float64) float64 {
+	return -softplus(-(x - l.Mu) / l.Sigma)
+}
+
+// Survival returns the survival function value of a given x
+func (l *Logistic) Survival(x float64) float64 {
+	return 1 / (1 + math.Exp((x-l.Mu)/l.Sigma))
+}
+
+// LogSurvival returns the log of the survival function value of a given x
+func (l *Logistic) LogSurvival(x float64) float64 {
+	return -softplus((x - l.Mu) / l.Sigma)
+}
+
+// Quantile returns the p-th quantile of the distribution
+func (l *Logistic) Quantile(p float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	return l.Mu + l.Sigma*(math.Log(p)-math.Log1p(-p))
+}
+
+// Mean returns the mean of the distribution
+func (l *Logistic) Mean() float64 {
+	return l.Mu
+}
+
+// Median returns the median of the distribution
+func (l *Logistic) Median() float64 {
+	return l.Mu
+}
+
+// Var returns the variance of the distribution
+func (l *Logistic) Var() float64 {
+	return math.Pow(l.Sigma*math.Pi, 2) / 3
+}
+
+// Skewness returns the Pearson's moment coefficient of skewness of the distribution
+func (l *Logistic) Skewness() float64 {
+	return 0
+}
+
+// Kurtosis returns the Kurtosis of the distribution
+func (l *Logistic) Kurtosis() float64 {
+	return 1.2
+}
+
+// Entropy returns the Entropy of the distribution
+func (l *Logistic) Entropy() float64 {
+	return math.Log(l.Sigma) + 2
+}
+
+// Moment returns the t-th moment of the distribution, defined for |t| < 1/σ
+//		M(t) = exp(μt) B(1 - σt, 1 + σt) = exp(μt) πσt / sin(πσt)
+//
+func (l *Logistic) Moment(t float64) float64 {
+	if math.Abs(t) >= 1/l.Sigma {
+		return math.NaN()
+	}
+	if t == 0 {
+		return 1
+	}
+	st := math.Pi * l.Sigma * t
+	return math.Exp(l.Mu*t) * st / math.Sin(st)
+}
+
+// cumulant returns the k-th cumulant, κ_1 = μ, κ_k = 2 (k-1)! ζ(k) σ^k for
+// even k and 0 otherwise
+func (l *Logistic) cumulant(k int) float64 {
+	switch {
+	case k == 1:
+		return l.Mu
+	case k%2 == 1:
+		return 0
+	}
+	return 2 * specfun.Zeta(float64(k)) * math.Exp(specfun.LogFactorial(float64(k-1))+float64(k)*math.Log(l.Sigma))
+}
+
+// cf returns the characteristic function of the distribution at t
+//		φ(t) = exp(iμt) πσt / sinh(πσt)
+//
+func (l *Logistic) cf(t float64) complex128 {
+	x := math.Pi * l.Sigma * t
+	return expi(l.Mu*t) * complex(x/math.Sinh(x), 0)
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (l *Logistic) RawMoment(k int) float64 {
+	return RawMoment(l, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (l *Logistic) CentralMoment(k int) float64 {
+	return CentralMoment(l, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (l *Logistic) Cumulant(k int) float64 {
+	return Cumulant(l, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (l *Logistic) CF(t float64) complex128 {
+	return CF(l, t)
+}
+
+// FisherI returns the Fisher Information of the distribution
+func (l *Logistic) FisherI() [][]float64 {
+	s2 := l.Sigma * l.Sigma
+	return [][]float64{
+		[]float64{1 / (3 * s2), 0},
+		[]float64{0, (math.Pi*math.Pi + 3) / (9 * s2)},
+	}
+}
+
+// Summary returns the properties of the distribution
+func (l *Logistic) Summary() *DistSummary {
+	return summarise(l, "logistic", Param{"mu", l.Mu}, Param{"sigma", l.Sigma})
+}
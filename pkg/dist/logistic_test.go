@@ -0,0 +1,48 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestLogistic(t *testing.T) {
+	dist := &Logistic{}
+	if err := dist.Init(1, 2); err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(dist.Summary())
+
+	moments := []struct {
+		Name     string
+		Actual   float64
+		Expected float64
+	}{
+		{"mean", expectation(dist, func(x float64) float64 { return x }, 100000), dist.Mean()},
+		{"var", expectation(dist, func(x float64) float64 { return math.Pow(x-dist.Mean(), 2) }, 100000), dist.Var()},
+		{"kurtosis", expectation(dist, func(x float64) float64 { return math.Pow(x-dist.Mean(), 4) }, 100000)/math.Pow(dist.Var(), 2) - 3, dist.Kurtosis()},
+		{"entropy", expectation(dist, func(x float64) float64 { return -dist.LogPDF(x) }, 100000), dist.Entropy()},
+		{"mgf(.2)", expectation(dist, func(x float64) float64 { return math.Exp(.2 * x) }, 100000), dist.Moment(.2)},
+	}
+	for _, m := range moments {
+		if math.Abs(m.Actual-m.Expected) > 1e-2*math.Max(1, math.Abs(m.Expected)) {
+			t.Errorf("%s %f, numerically %f", m.Name, m.Expected, m.Actual)
+		}
+	}
+
+	// The density is the derivative of the cdf
+	for _, x := range []float64{-800, -5, 0, 3, 800} {
+		if v, e := dist.LogCDF(x), math.Log(dist.CDF(x)); x > -700 && math.Abs(v-e) > 1e-12 {
+			t.Errorf("log F(%g) = %g, expected %g", x, v, e)
+		}
+		if v, e := dist.PDF(x), dist.CDF(x)*dist.Survival(x)/dist.Sigma; math.Abs(v-e) > 1e-15 {
+			t.Errorf("f(%g) = %g, expected %g", x, v, e)
+		}
+	}
+	if v := dist.LogCDF(-2001); math.Abs(v+1001) > 1e-12 {
+		t.Errorf("log F(-2001) = %f, expected -1001", v)
+	}
+	if err := dist.Init(0, 0); err == nil {
+		t.Errorf("expected error for σ = 0")
+	}
+}
@@ -0,0 +1,226 @@
+package dist
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/ichbinfrog/statistics/pkg/array"
+	"github.com/ichbinfrog/statistics/pkg/specfun"
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// LogNormal represents the log-normal distribution, the distribution
+// of exp(Y) where Y ~ N(μ, σ)
+// Continuous probability distribution function as follows:
+//		X ~ LogN(μ, σ), σ > 0
+//
+//		f(x,μ,σ) = exp(-(log(x) - μ)^2 / 2σ^2) / (xσ√(2π))
+//
+type LogNormal struct {
+	source
+	Mu, Sigma float64
+}
+
+// Init intialises a LogNormal distribution
+func (l *LogNormal) Init(mu, sigma float64) error {
+	if sigma <= 0 {
+		return util.ErrLogNormalParam
+	}
+	l.Mu, l.Sigma = mu, sigma
+	return nil
+}
+
+// Generate creates one sample of the LogNormal distribution
+func (l *LogNormal) Generate() float64 {
+	return l.Rand(l.rng())
+}
+
+// GenerateN creates n samples of the LogNormal distribution
+func (l *LogNormal) GenerateN(n int) []float64 {
+	return GenerateN(l, l.rng(), n)
+}
+
+// Fill fills dst with samples of the LogNormal distribution
+func (l *LogNormal) Fill(dst []float64) {
+	Fill(l, l.rng(), dst)
+}
+
+// Rand creates one sample of the LogNormal distribution using the given generator
+func (l *LogNormal) Rand(r *rand.Rand) float64 {
+	return math.Exp(r.NormFloat64()*l.Sigma + l.Mu)
+}
+
+// Domain returns the definition domain of the distribution
+func (l *LogNormal) Domain() (float64, float64) {
+	return 0, math.Inf(0)
+}
+
+// PDF returns the probability density function value of a given x
+func (l *LogNormal) PDF(x float64) float64 {
+	return math.Exp(l.LogPDF(x))
+}
+
+// LogPDF returns the log of the probability density function value of a given x
+func (l *LogNormal) LogPDF(x float64) float64 {
+	if x <= 0 {
+		return math.Inf(-1)
+	}
+	lx := math.Log(x)
+	return -math.Pow((lx-l.Mu)/l.Sigma, 2)/2 - lx - math.Log(l.Sigma) - math.Log(2*math.Pi)/2
+}
+
+// CDF returns the Cumulative distribution function value of a given x
+func (l *LogNormal) CDF(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	return specfun.NormalCDF((math.Log(x) - l.Mu) / l.Sigma)
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given x
+func (l *LogNormal) LogCDF(x float64) float64 {
+	if x <= 0 {
+		return math.Inf(-1)
+	}
+	return specfun.LogNormalCDF((math.Log(x) - l.Mu) / l.Sigma)
+}
+
+// Survival returns the survival function value of a given x
+func (l *LogNormal) Survival(x float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+	return specfun.NormalSurvival((math.Log(x) - l.Mu) / l.Sigma)
+}
+
+// LogSurvival returns the log of the survival function value of a given x
+func (l *LogNormal) LogSurvival(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	return specfun.LogNormalSurvival((math.Log(x) - l.Mu) / l.Sigma)
+}
+
+// Quantile returns the p-th quantile of the distribution
+func (l *LogNormal) Quantile(p float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	return math.Exp(l.Mu + l.Sigma*specfun.NormalQuantile(p))
+}
+
+// Mean returns the mean of the distribution
+func (l *LogNormal) Mean() float64 {
+	return math.Exp(l.Mu + l.Sigma*l.Sigma/2)
+}
+
+// Median returns the median of the distribution
+func (l *LogNormal) Median() float64 {
+	return math.Exp(l.Mu)
+}
+
+// Var returns the variance of the distribution
+func (l *LogNormal) Var() float64 {
+	s2 := l.Sigma * l.Sigma
+	return math.Expm1(s2) * math.Exp(2*l.Mu+s2)
+}
+
+// Skewness returns the Pearson's moment coefficient of skewness of the distribution
+func (l *LogNormal) Skewness() float64 {
+	s2 := l.Sigma * l.Sigma
+	return (math.Exp(s2) + 2) * math.Sqrt(math.Expm1(s2))
+}
+
+// Kurtosis returns the Kurtosis of the distribution
+func (l *LogNormal) Kurtosis() float64 {
+	s2 := l.Sigma * l.Sigma
+	return math.Exp(4*s2) + 2*math.Exp(3*s2) + 3*math.Exp(2*s2) - 6
+}
+
+// Entropy returns the Entropy of the distribution
+func (l *LogNormal) Entropy() float64 {
+	return l.Mu + math.Log(2*math.Pi*math.E*l.Sigma*l.Sigma)/2
+}
+
+// Moment returns the t-th moment of the distribution, the moment
+// generating function of the log-normal distribution does not exist
+// for t > 0 and has no closed form for t < 0
+func (l *LogNormal) Moment(t float64) float64 {
+	if t == 0 {
+		return 1
+	}
+	return math.NaN()
+}
+
+// rawMoment returns E[X^k] = exp(kμ + k^2 σ^2 / 2)
+func (l *LogNormal) rawMoment(k int) float64 {
+	kf := float64(k)
+	return math.Exp(kf*l.Mu + kf*kf*l.Sigma*l.Sigma/2)
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (l *LogNormal) RawMoment(k int) float64 {
+	return RawMoment(l, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (l *LogNormal) CentralMoment(k int) float64 {
+	return CentralMoment(l, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (l *LogNormal) Cumulant(k int) float64 {
+	return Cumulant(l, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (l *LogNormal) CF(t float64) complex128 {
+	return CF(l, t)
+}
+
+// FisherI returns the Fisher Information of the distribution
+func (l *LogNormal) FisherI() [][]float64 {
+	return [][]float64{
+		[]float64{1 / math.Pow(l.Sigma, 2), 0},
+		[]float64{0, 2 / math.Pow(l.Sigma, 2)},
+	}
+}
+
+// Summary returns the properties of the distribution
+func (l *LogNormal) Summary() *DistSummary {
+	return summarise(l, "lognormal", Param{"mu", l.Mu}, Param{"sigma", l.Sigma})
+}
+
+// FitLogNormal returns the maximum likelihood estimation of a LogNormal
+// distribution from the given observations:
+//		μ = E[log(X)], σ^2 = E[(log(X) - μ)^2]
+//
+// Complexity: O(n)
+//
+func FitLogNormal(a *array.Arrayf64) (*LogNormal, *FitResult, error) {
+	if err := checkSample(a, 2, 0, math.Inf(0), false); err != nil {
+		return nil, nil, err
+	}
+	if a.Data[0] <= 0 {
+		return nil, nil, util.ErrFitSupport
+	}
+	mean, variance := 0.0, 0.0
+	for _, v := range a.Data {
+		mean += math.Log(v)
+	}
+	mean /= a.Length
+	for _, v := range a.Data {
+		variance += math.Pow(math.Log(v)-mean, 2)
+	}
+	variance /= a.Length
+
+	l := &LogNormal{}
+	if err := l.Init(mean, math.Sqrt(variance)); err != nil {
+		return nil, nil, err
+	}
+	return l, &FitResult{
+		LogLikelihood: -a.Length*(math.Log(2*math.Pi*variance)+1)/2 - a.Length*mean,
+		StdErr:        fisherStdErr(l.FisherI(), a.Length),
+		Observations:  a.Length,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestLogNormal(t *testing.T) {
+	dist := &LogNormal{}
+	if err := dist.Init(1, .5); err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(dist.Summary())
+
+	// log(X) ~ N(μ, σ)
+	n := &Normal{Mu: 1, Sigma: .5}
+	for _, x := range []float64{.1, 1, 2.5, 10, 100} {
+		if v, e := dist.CDF(x), n.CDF(math.Log(x)); math.Abs(v-e) > 1e-15 {
+			t.Errorf("F(%g) = %g, expected %g", x, v, e)
+		}
+		if v, e := dist.PDF(x), n.PDF(math.Log(x))/x; math.Abs(v-e) > 1e-12 {
+			t.Errorf("f(%g) = %g, expected %g", x, v, e)
+		}
+	}
+
+	moments := []struct {
+		Name     string
+		Actual   float64
+		Expected float64
+	}{
+		{"mean", expectation(dist, func(x float64) float64 { return x }, 100000), dist.Mean()},
+		{"var", expectation(dist, func(x float64) float64 { return math.Pow(x-dist.Mean(), 2) }, 100000), dist.Var()},
+		{"skewness", expectation(dist, func(x float64) float64 { return math.Pow(x-dist.Mean(), 3) }, 100000) / math.Pow(dist.Var(), 1.5), dist.Skewness()},
+		{"entropy", expectation(dist, func(x float64) float64 { return -dist.LogPDF(x) }, 100000), dist.Entropy()},
+	}
+	for _, m := range moments {
+		if math.Abs(m.Actual-m.Expected) > 1e-2*math.Abs(m.Expected) {
+			t.Errorf("%s %f, numerically %f", m.Name, m.Expected, m.Actual)
+		}
+	}
+	if err := dist.Init(0, 0); err == nil {
+		t.Errorf("expected error for σ = 0")
+	}
+}
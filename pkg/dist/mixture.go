@@ -0,0 +1,573 @@
+package dist
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/ichbinfrog/statistics/pkg/array"
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// Mixture represents a finite mixture of distributions of the package,
+// a sample being drawn from the i-th component with probability w_i
+// Probability distribution function as follows:
+//		X ~ Σ w_i D_i, w_i >= 0, Σ w_i = 1
+//
+//		f(x) = Σ w_i f_i(x)
+//		F(x) = Σ w_i F_i(x)
+//
+// The density of a component is its PDF when it is continuous and its
+// PMF when it is discrete, so that Mixture implements both interfaces.
+type Mixture struct {
+	source
+	Components []Distribution
+	Weights    []float64
+}
+
+// Init intialises a Mixture distribution from its components and non
+// negative weights, which are normalised to sum up to 1
+func (m *Mixture) Init(components []Distribution, weights []float64) error {
+	if len(components) == 0 || len(components) != len(weights) {
+		return util.ErrMixtureParam
+	}
+	total := 0.0
+	for i, w := range weights {
+		if components[i] == nil || w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return util.ErrMixtureParam
+		}
+		total += w
+	}
+	if total <= 0 {
+		return util.ErrMixtureParam
+	}
+
+	m.Components = make([]Distribution, len(components))
+	copy(m.Components, components)
+	m.Weights = make([]float64, len(weights))
+	for i, w := range weights {
+		m.Weights[i] = w / total
+	}
+	return nil
+}
+
+// Generate creates one sample of the Mixture distribution
+func (m *Mixture) Generate() float64 {
+	return m.Rand(m.rng())
+}
+
+// GenerateN creates n samples of the Mixture distribution
+func (m *Mixture) GenerateN(n int) []float64 {
+	return GenerateN(m, m.rng(), n)
+}
+
+// Fill fills dst with samples of the Mixture distribution
+func (m *Mixture) Fill(dst []float64) {
+	Fill(m, m.rng(), dst)
+}
+
+// Rand creates one sample of the Mixture distribution using the given generator
+// Algorithm: a component is picked according to the weights, then sampled
+// with the same generator
+// Complexity: O(k) + the sampling of the component
+//
+func (m *Mixture) Rand(r *rand.Rand) float64 {
+	u := r.Float64()
+	for i, w := range m.Weights {
+		if u < w || i == len(m.Weights)-1 {
+			return m.Components[i].Rand(r)
+		}
+		u -= w
+	}
+	return math.NaN()
+}
+
+// Domain returns the definition domain of the distribution
+func (m *Mixture) Domain() (float64, float64) {
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for i, c := range m.Components {
+		if m.Weights[i] == 0 {
+			continue
+		}
+		dbeg, dend := c.Domain()
+		lo, hi = math.Min(lo, dbeg), math.Max(hi, dend)
+	}
+	return lo, hi
+}
+
+// logDensity returns the log of the PDF of a continuous distribution
+// or the log of the PMF of a discrete one
+func logDensity(d Distribution, x float64) float64 {
+	switch v := d.(type) {
+	case Continuous:
+		return v.LogPDF(x)
+	case Discrete:
+		return v.LogPMF(x)
+	}
+	return math.NaN()
+}
+
+// IsDiscrete returns true when the distribution only has a PMF, or is a
+// mixture, a truncation or an integer affine transform of such
+// distributions, or the step cdf of observations
+func IsDiscrete(d Distribution) bool {
+	if e, ok := d.(*Empirical); ok {
+		return !e.interpolated()
+	}
+	if t, ok := d.(*Truncated); ok {
+		return IsDiscrete(t.Dist)
+	}
+	if a, ok := d.(*Affine); ok {
+		return IsDiscrete(a.Dist) && isInteger(a.A) && isInteger(a.B)
+	}
+	if m, ok := d.(*Maximum); ok {
+		return IsDiscrete(m.Dist)
+	}
+	if m, ok := d.(*Minimum); ok {
+		return IsDiscrete(m.Dist)
+	}
+	if m, ok := d.(*Mixture); ok {
+		for _, c := range m.Components {
+			if !IsDiscrete(c) {
+				return false
+			}
+		}
+		return true
+	}
+	_, discrete := d.(Discrete)
+	_, continuous := d.(Continuous)
+	return discrete && !continuous
+}
+
+// logSumExp returns log(Σ exp(v_i)) without overflowing
+func logSumExp(values []float64) float64 {
+	max := math.Inf(-1)
+	for _, v := range values {
+		max = math.Max(max, v)
+	}
+	if math.IsInf(max, 0) {
+		return max
+	}
+	sum := 0.0
+	for _, v := range values {
+		sum += math.Exp(v - max)
+	}
+	return max + math.Log(sum)
+}
+
+// weighted returns log(Σ w_i exp(f(D_i)))
+func (m *Mixture) weighted(f func(d Distribution) float64) float64 {
+	terms := make([]float64, len(m.Components))
+	for i, c := range m.Components {
+		terms[i] = math.Inf(-1)
+		if m.Weights[i] > 0 {
+			terms[i] = math.Log(m.Weights[i]) + f(c)
+		}
+	}
+	return logSumExp(terms)
+}
+
+// PDF returns the probability density function value of a given x
+func (m *Mixture) PDF(x float64) float64 {
+	return math.Exp(m.LogPDF(x))
+}
+
+// LogPDF returns the log of the probability density function value of a given x
+func (m *Mixture) LogPDF(x float64) float64 {
+	return m.weighted(func(d Distribution) float64 { return logDensity(d, x) })
+}
+
+// PMF returns the probability mass function value of a given k
+func (m *Mixture) PMF(k float64) float64 {
+	return m.PDF(k)
+}
+
+// LogPMF returns the log of the probability mass function value of a given k
+func (m *Mixture) LogPMF(k float64) float64 {
+	return m.LogPDF(k)
+}
+
+// CDF returns the Cumulative distribution function value of a given x
+func (m *Mixture) CDF(x float64) float64 {
+	sum := 0.0
+	for i, c := range m.Components {
+		if m.Weights[i] > 0 {
+			sum += m.Weights[i] * c.CDF(x)
+		}
+	}
+	return math.Min(sum, 1)
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given x
+func (m *Mixture) LogCDF(x float64) float64 {
+	return m.weighted(func(d Distribution) float64 { return d.LogCDF(x) })
+}
+
+// Survival returns the survival function value of a given x
+func (m *Mixture) Survival(x float64) float64 {
+	sum := 0.0
+	for i, c := range m.Components {
+		if m.Weights[i] > 0 {
+			sum += m.Weights[i] * c.Survival(x)
+		}
+	}
+	return math.Min(sum, 1)
+}
+
+// LogSurvival returns the log of the survival function value of a given x
+func (m *Mixture) LogSurvival(x float64) float64 {
+	return m.weighted(func(d Distribution) float64 { return d.LogSurvival(x) })
+}
+
+// Quantile returns the p-th quantile of the distribution
+// Algorithm: numerical inversion of the cdf starting from the mean
+//
+func (m *Mixture) Quantile(p float64) float64 {
+	dbeg, dend := m.Domain()
+	if IsDiscrete(m) {
+		return discreteQuantile(m.CDF, p, dbeg, dend, m.Mean())
+	}
+	return continuousQuantile(m.CDF, p, dbeg, dend, m.Mean(), math.Sqrt(m.Var()))
+}
+
+// Mean returns the mean of the distribution
+//		E[X] = Σ w_i E[X_i]
+//
+func (m *Mixture) Mean() float64 {
+	sum := 0.0
+	for i, c := range m.Components {
+		if m.Weights[i] > 0 {
+			sum += m.Weights[i] * c.Mean()
+		}
+	}
+	return sum
+}
+
+// Median returns the median of the distribution
+func (m *Mixture) Median() float64 {
+	return m.Quantile(.5)
+}
+
+// Var returns the variance of the distribution
+//		Var(X) = Σ w_i (Var(X_i) + E[X_i]^2) - E[X]^2
+//
+func (m *Mixture) Var() float64 {
+	mean, sum := m.Mean(), 0.0
+	for i, c := range m.Components {
+		if m.Weights[i] > 0 {
+			mu := c.Mean()
+			sum += m.Weights[i] * (c.Var() + (mu-mean)*(mu-mean))
+		}
+	}
+	return sum
+}
+
+// Moment returns the t-th moment of the distribution, or NaN when one of
+// the components does not provide its moment generating function
+//		M(t) = Σ w_i M_i(t)
+//
+func (m *Mixture) Moment(t float64) float64 {
+	sum := 0.0
+	for i, c := range m.Components {
+		mgf, ok := c.(interface{ Moment(float64) float64 })
+		if !ok {
+			return math.NaN()
+		}
+		if m.Weights[i] > 0 {
+			sum += m.Weights[i] * mgf.Moment(t)
+		}
+	}
+	return sum
+}
+
+// expectation returns E[g(X)] = Σ w_i E[g(X_i)]
+func (m *Mixture) expectation(g func(float64) float64) float64 {
+	sum := 0.0
+	for i, c := range m.Components {
+		if m.Weights[i] > 0 {
+			sum += m.Weights[i] * expect(c, g)
+		}
+	}
+	return sum
+}
+
+// rawMoment returns E[X^k] = Σ w_i E[X_i^k]
+func (m *Mixture) rawMoment(k int) float64 {
+	sum := 0.0
+	for i, c := range m.Components {
+		sum += m.Weights[i] * RawMoment(c, k)
+	}
+	return sum
+}
+
+// centralMoment returns E[(X - μ)^k] = Σ w_i E[((X_i - μ_i) + μ_i - μ)^k]
+func (m *Mixture) centralMoment(k int) float64 {
+	mean, sum := m.Mean(), 0.0
+	for i, c := range m.Components {
+		central := func(j int) float64 { return CentralMoment(c, j) }
+		sum += m.Weights[i] * shiftMoment(central, c.Mean()-mean, k)
+	}
+	return sum
+}
+
+// cf returns the characteristic function of the distribution at t
+//		φ(t) = Σ w_i φ_i(t)
+//
+func (m *Mixture) cf(t float64) complex128 {
+	var sum complex128
+	for i, c := range m.Components {
+		sum += complex(m.Weights[i], 0) * CF(c, t)
+	}
+	return sum
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (m *Mixture) RawMoment(k int) float64 {
+	return RawMoment(m, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (m *Mixture) CentralMoment(k int) float64 {
+	return CentralMoment(m, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (m *Mixture) Cumulant(k int) float64 {
+	return Cumulant(m, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (m *Mixture) CF(t float64) complex128 {
+	return CF(m, t)
+}
+
+// Summary returns the properties of the distribution
+func (m *Mixture) Summary() *DistSummary {
+	s := summarise(m, "mixture", vectorParams("weight", m.Weights)...)
+	for _, c := range m.Components {
+		s.Components = append(s.Components, summaryOf(c))
+	}
+	return s
+}
+
+// MixtureFitResult groups the goodness of a mixture fitted with the
+// expectation maximisation algorithm. StdErr is left empty as the
+// algorithm does not provide the Fisher Information of the estimates.
+type MixtureFitResult struct {
+	FitResult
+	// Iterations is the number of EM iterations until convergence
+	Iterations int `json:"iterations"`
+	// Responsibilities holds for each observation, in the (sorted) order
+	// of the array, the posterior probability of each component
+	Responsibilities [][]float64 `json:"responsibilities"`
+}
+
+// mixtureStep returns the maximum likelihood estimation of a component
+// from the observations weighted by their responsibilities r_i, where
+// total = Σ r_i
+type mixtureStep func(data, r []float64, total float64) (Distribution, error)
+
+// fitMixture runs the expectation maximisation algorithm from the given
+// initial components.
+// Algorithm:
+//		E step: r_ij = w_j f_j(x_i) / Σ_l w_l f_l(x_i)
+//		M step: w_j = Σ_i r_ij / n and every component is refitted by
+//		weighted maximum likelihood
+//		Stop when the log-likelihood increases by less than 1e-10 in
+//		relative terms
+//
+// DEMPSTER, Arthur P., LAIRD, Nan M., RUBIN, Donald B. Maximum likelihood from incomplete data via the EM algorithm. 1977.
+// Complexity: O(n * k) per iteration
+//
+func fitMixture(a *array.Arrayf64, components []Distribution, step mixtureStep) (*Mixture, *MixtureFitResult, error) {
+	n, k := len(a.Data), len(components)
+	m := &Mixture{}
+	weights := make([]float64, k)
+	for j := range weights {
+		weights[j] = 1
+	}
+	if err := m.Init(components, weights); err != nil {
+		return nil, nil, err
+	}
+
+	resp := make([][]float64, n)
+	for i := range resp {
+		resp[i] = make([]float64, k)
+	}
+	terms := make([]float64, k)
+	ll := math.Inf(-1)
+	for iter := 1; iter <= maxIter; iter++ {
+		// E step
+		cur := 0.0
+		for i, x := range a.Data {
+			for j, c := range m.Components {
+				terms[j] = math.Inf(-1)
+				if m.Weights[j] > 0 {
+					terms[j] = math.Log(m.Weights[j]) + logDensity(c, x)
+				}
+			}
+			norm := logSumExp(terms)
+			if math.IsInf(norm, 0) || math.IsNaN(norm) {
+				return nil, nil, util.ErrFitConvergence
+			}
+			for j := range terms {
+				resp[i][j] = math.Exp(terms[j] - norm)
+			}
+			cur += norm
+		}
+
+		if iter > 1 && math.Abs(cur-ll) <= 1e-10*math.Abs(cur) {
+			return m, &MixtureFitResult{
+				FitResult: FitResult{
+					LogLikelihood: cur,
+					Observations:  a.Length,
+				},
+				Iterations:       iter,
+				Responsibilities: resp,
+			}, nil
+		}
+		ll = cur
+
+		// M step
+		col := make([]float64, n)
+		for j := range m.Components {
+			total := 0.0
+			for i := range resp {
+				col[i] = resp[i][j]
+				total += col[i]
+			}
+			if total <= 0 {
+				return nil, nil, util.ErrFitConvergence
+			}
+			c, err := step(a.Data, col, total)
+			if err != nil {
+				return nil, nil, util.ErrFitConvergence
+			}
+			m.Components[j], m.Weights[j] = c, total/float64(n)
+		}
+	}
+	return nil, nil, util.ErrFitConvergence
+}
+
+// blockMeans splits the sorted observations into k blocks of equal size
+// and returns their means, used as starting points of the EM algorithm
+func blockMeans(a *array.Arrayf64, k int) []float64 {
+	res := make([]float64, k)
+	n := len(a.Data)
+	for j := range res {
+		lo, hi := j*n/k, (j+1)*n/k
+		for _, v := range a.Data[lo:hi] {
+			res[j] += v
+		}
+		res[j] /= float64(hi - lo)
+	}
+	return res
+}
+
+// checkMixture checks the number of components and the observations
+// of a mixture fit
+func checkMixture(a *array.Arrayf64, k int, lo, hi float64, discrete bool) error {
+	if k < 1 {
+		return util.ErrMixtureParam
+	}
+	return checkSample(a, 2*float64(k), lo, hi, discrete)
+}
+
+// FitNormalMixture returns the maximum likelihood estimation of a mixture
+// of k Normal distributions from the given observations.
+// Algorithm: expectation maximisation starting from the means of k blocks
+// of the sorted observations and the overall standard deviation
+//		μ_j = Σ r_ij x_i / Σ r_ij
+//		σ_j^2 = Σ r_ij (x_i - μ_j)^2 / Σ r_ij
+//
+// Complexity: O(n * k) per iteration
+//
+func FitNormalMixture(a *array.Arrayf64, k int) (*Mixture, *MixtureFitResult, error) {
+	if err := checkMixture(a, k, math.Inf(-1), math.Inf(1), false); err != nil {
+		return nil, nil, err
+	}
+	_, variance := sampleMoments(a)
+	if !(variance > 0) {
+		return nil, nil, util.ErrFitSupport
+	}
+	components := make([]Distribution, k)
+	for j, mu := range blockMeans(a, k) {
+		components[j] = &Normal{Mu: mu, Sigma: math.Sqrt(variance)}
+	}
+	return fitMixture(a, components, func(data, r []float64, total float64) (Distribution, error) {
+		mu, sigma := 0.0, 0.0
+		for i, x := range data {
+			mu += r[i] * x
+		}
+		mu /= total
+		for i, x := range data {
+			sigma += r[i] * (x - mu) * (x - mu)
+		}
+		d := &Normal{}
+		if err := d.Init(mu, math.Sqrt(sigma/total)); err != nil {
+			return nil, err
+		}
+		return d, nil
+	})
+}
+
+// FitExponentialMixture returns the maximum likelihood estimation of a
+// mixture of k Exponential distributions from the given observations.
+// Algorithm: expectation maximisation starting from the means of k blocks
+// of the sorted observations
+//		λ_j = Σ r_ij / Σ r_ij x_i
+//
+// Complexity: O(n * k) per iteration
+//
+func FitExponentialMixture(a *array.Arrayf64, k int) (*Mixture, *MixtureFitResult, error) {
+	if err := checkMixture(a, k, 0, math.Inf(1), false); err != nil {
+		return nil, nil, err
+	}
+	components := make([]Distribution, k)
+	for j, mu := range blockMeans(a, k) {
+		if !(mu > 0) {
+			return nil, nil, util.ErrFitSupport
+		}
+		components[j] = &Exponential{Lambda: 1 / mu}
+	}
+	return fitMixture(a, components, func(data, r []float64, total float64) (Distribution, error) {
+		sum := 0.0
+		for i, x := range data {
+			sum += r[i] * x
+		}
+		d := &Exponential{}
+		if err := d.Init(total / sum); err != nil {
+			return nil, err
+		}
+		return d, nil
+	})
+}
+
+// FitPoissonMixture returns the maximum likelihood estimation of a
+// mixture of k Poisson distributions from the given observations.
+// Algorithm: expectation maximisation starting from the means of k blocks
+// of the sorted observations
+//		λ_j = Σ r_ij x_i / Σ r_ij
+//
+// Complexity: O(n * k) per iteration
+//
+func FitPoissonMixture(a *array.Arrayf64, k int) (*Mixture, *MixtureFitResult, error) {
+	if err := checkMixture(a, k, 0, math.Inf(1), true); err != nil {
+		return nil, nil, err
+	}
+	components := make([]Distribution, k)
+	for j, mu := range blockMeans(a, k) {
+		// Blocks of zeros would start from a degenerate component
+		components[j] = &Poisson{Lambda: math.Max(mu, .5)}
+	}
+	return fitMixture(a, components, func(data, r []float64, total float64) (Distribution, error) {
+		sum := 0.0
+		for i, x := range data {
+			sum += r[i] * x
+		}
+		d := &Poisson{}
+		if err := d.Init(sum / total); err != nil {
+			return nil, err
+		}
+		return d, nil
+	})
+}
@@ -0,0 +1,150 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/ichbinfrog/statistics/pkg/array"
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// mixtureSample returns an array holding n samples of the given mixture
+func mixtureSample(m *Mixture, n int, seed int64) *array.Arrayf64 {
+	r := rand.New(rand.NewSource(seed))
+	a := &array.Arrayf64{}
+	a.Init(array.Optionf64{
+		Degree: 2,
+	})
+	for i := 0; i < n; i++ {
+		a.Insert(m.Rand(r))
+	}
+	return a
+}
+
+func TestMixture(t *testing.T) {
+	dist := &Mixture{}
+	err := dist.Init([]Distribution{&Normal{Mu: -2, Sigma: 1}, &Normal{Mu: 3, Sigma: .5}}, []float64{3, 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(dist.Summary())
+
+	// The pdf integrates to 1 and to the cdf
+	sum, h := 0.0, 1e-3
+	for x := -10 + h/2; x < 10; x += h {
+		sum += dist.PDF(x) * h
+		if x > -5 && x < -5+h || x > 2.9 && x < 2.9+h {
+			if math.Abs(sum-dist.CDF(x+h/2)) > 1e-6 {
+				t.Errorf("∫f = %f, F(%f) = %f", sum, x+h/2, dist.CDF(x+h/2))
+			}
+		}
+	}
+	if math.Abs(sum-1) > 1e-6 {
+		t.Errorf("∫f = %f, expected 1", sum)
+	}
+	for _, p := range []float64{1e-6, .1, .3, .5, .9, 1 - 1e-9} {
+		if v := dist.CDF(dist.Quantile(p)); math.Abs(v-p) > 1e-9 {
+			t.Errorf("F(Q(%f)) = %f", p, v)
+		}
+	}
+	// The tail of the first component dominates far from the modes
+	if v := dist.LogSurvival(12); math.Abs(v-(math.Log(.3)+(&Normal{Mu: -2, Sigma: 1}).LogSurvival(12))) > 1e-9 {
+		t.Errorf("log S(12) = %f", v)
+	}
+	if v := dist.Mean(); math.Abs(v-1.5) > 1e-12 {
+		t.Errorf("E[X] = %f, expected 1.5", v)
+	}
+	if v, e := dist.Var(), .3*(1+4)+.7*(.25+9)-1.5*1.5; math.Abs(v-e) > 1e-12 {
+		t.Errorf("Var(X) = %f, expected %f", v, e)
+	}
+
+	a := mixtureSample(dist, 20000, 1)
+	if math.Abs(a.Mean()-dist.Mean()) > 3e-2 || math.Abs(a.Var()-dist.Var()) > 1e-1 {
+		t.Errorf("sample moments (%f, %f), expected (%f, %f)", a.Mean(), a.Var(), dist.Mean(), dist.Var())
+	}
+
+	// A mixture of discrete distributions is discrete
+	discrete := &Mixture{}
+	discrete.Init([]Distribution{&Poisson{Lambda: 2}, &Binomial{N: 10, P: .8, Q: .2}}, []float64{1, 1})
+	sum = 0
+	for k := 0.0; k < 50; k++ {
+		sum += discrete.PMF(k)
+	}
+	if math.Abs(sum-1) > 1e-12 || discrete.PMF(1.5) != 0 {
+		t.Errorf("Σ f(k) = %f, expected 1", sum)
+	}
+	if v := discrete.Quantile(.5); v != math.Floor(v) || discrete.CDF(v) < .5 || discrete.CDF(v-1) >= .5 {
+		t.Errorf("Q(.5) = %f", v)
+	}
+
+	for _, c := range []struct {
+		d []Distribution
+		w []float64
+	}{{nil, nil}, {[]Distribution{&Normal{Mu: 0, Sigma: 1}}, []float64{1, 2}}, {[]Distribution{&Normal{Mu: 0, Sigma: 1}}, []float64{0}}, {[]Distribution{nil}, []float64{1}}} {
+		if err := (&Mixture{}).Init(c.d, c.w); err != util.ErrMixtureParam {
+			t.Errorf("expected %v, got %v", util.ErrMixtureParam, err)
+		}
+	}
+}
+
+func TestFitMixture(t *testing.T) {
+	testCases := []struct {
+		Name    string
+		Dist    *Mixture
+		Params  []float64
+		Fit     func(a *array.Arrayf64, k int) (*Mixture, *MixtureFitResult, error)
+		Param   func(d Distribution) float64
+		Epsilon float64
+	}{
+		{"normal", &Mixture{Components: []Distribution{&Normal{Mu: 120, Sigma: 15}, &Normal{Mu: 300, Sigma: 40}}, Weights: []float64{.6, .4}},
+			[]float64{120, 300}, FitNormalMixture, func(d Distribution) float64 { return d.(*Normal).Mu }, 5e-2},
+		{"exponential", &Mixture{Components: []Distribution{&Exponential{Lambda: 10}, &Exponential{Lambda: .5}}, Weights: []float64{.5, .5}},
+			[]float64{.5, 10}, FitExponentialMixture, func(d Distribution) float64 { return d.(*Exponential).Lambda }, 2e-1},
+		{"poisson", &Mixture{Components: []Distribution{&Poisson{Lambda: 1.5}, &Poisson{Lambda: 9}}, Weights: []float64{.3, .7}},
+			[]float64{1.5, 9}, FitPoissonMixture, func(d Distribution) float64 { return d.(*Poisson).Lambda }, 1e-1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := mixtureSample(tc.Dist, 5000, 1)
+			m, res, err := tc.Fit(a, 2)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			params := []float64{tc.Param(m.Components[0]), tc.Param(m.Components[1])}
+			sort.Float64s(params)
+			for i, p := range params {
+				if math.Abs(p-tc.Params[i]) > tc.Epsilon*tc.Params[i] {
+					t.Errorf("param %d = %f, expected %f", i, p, tc.Params[i])
+				}
+			}
+			if len(res.Responsibilities) != len(a.Data) {
+				t.Fatalf("%d responsibilities for %d observations", len(res.Responsibilities), len(a.Data))
+			}
+			for _, r := range res.Responsibilities {
+				if math.Abs(r[0]+r[1]-1) > 1e-12 {
+					t.Fatalf("responsibilities %v do not sum up to 1", r)
+				}
+			}
+
+			ll := 0.0
+			for _, x := range a.Data {
+				ll += m.LogPDF(x)
+			}
+			if math.Abs(ll-res.LogLikelihood) > 1e-6*math.Abs(ll) {
+				t.Errorf("log-likelihood = %f, expected %f", res.LogLikelihood, ll)
+			}
+		})
+	}
+
+	if _, _, err := FitNormalMixture(sample(&Normal{Mu: 0, Sigma: 1}, 10, 1), 0); err != util.ErrMixtureParam {
+		t.Errorf("expected %v, got %v", util.ErrMixtureParam, err)
+	}
+	if _, _, err := FitExponentialMixture(sample(&Normal{Mu: 0, Sigma: 1}, 10, 1), 2); err != util.ErrFitSupport {
+		t.Errorf("expected %v, got %v", util.ErrFitSupport, err)
+	}
+}
@@ -0,0 +1,204 @@
+package dist
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// rawMomenter, centralMomenter and cumulanter are implemented by the
+// distributions with closed form moments or cumulants, the other ones
+// being derived from them or integrated numerically
+type rawMomenter interface {
+	rawMoment(k int) float64
+}
+
+type centralMomenter interface {
+	centralMoment(k int) float64
+}
+
+type cumulanter interface {
+	cumulant(k int) float64
+}
+
+// characteristic is implemented by the distributions with a closed form
+// characteristic function
+type characteristic interface {
+	cf(t float64) complex128
+}
+
+// expecter is implemented by the distributions computing their own
+// expectations E[g(X)]
+type expecter interface {
+	expectation(g func(float64) float64) float64
+}
+
+// expect returns E[g(X)], summed over the integers of the support of
+// discrete distributions bounded below and integrated over the quantile
+// function otherwise
+func expect(d Distribution, g func(float64) float64) float64 {
+	if e, ok := d.(expecter); ok {
+		return e.expectation(g)
+	}
+	if p, ok := d.(Discrete); ok && IsDiscrete(d) {
+		if dbeg, dend := d.Domain(); !math.IsInf(dbeg, -1) {
+			return discreteSum(p.PMF, g, dbeg, dend, 1)
+		}
+	}
+	return quantileIntegral(d.Quantile, g, 0, 1)
+}
+
+// binomialCoefficient returns C(n, k) for small n
+func binomialCoefficient(n, k int) float64 {
+	res := 1.0
+	for i := 0; i < k; i++ {
+		res = res * float64(n-i) / float64(i+1)
+	}
+	return math.Round(res)
+}
+
+// shiftMoment returns E[(Y + c)^k] from the moments of Y
+//		E[(Y + c)^k] = Σ_j C(k, j) c^(k-j) E[Y^j]
+//
+func shiftMoment(moment func(j int) float64, c float64, k int) float64 {
+	if c == 0 {
+		return moment(k)
+	}
+	sum := 0.0
+	for j := 0; j <= k; j++ {
+		sum += binomialCoefficient(k, j) * math.Pow(c, float64(k-j)) * moment(j)
+	}
+	return sum
+}
+
+// cumulantMoment returns the k-th raw moment from the cumulants
+//		μ'_n = Σ_(j=1..n) C(n-1, j-1) κ_j μ'_(n-j)
+//
+func cumulantMoment(cumulant func(j int) float64, k int) float64 {
+	moments := make([]float64, k+1)
+	moments[0] = 1
+	for n := 1; n <= k; n++ {
+		for j := 1; j <= n; j++ {
+			moments[n] += binomialCoefficient(n-1, j-1) * cumulant(j) * moments[n-j]
+		}
+	}
+	return moments[k]
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+// Algorithm:
+//		Closed forms of the distribution when known
+//		E[X^k] = Σ_j C(k, j) E[X]^(k-j) E[(X - E[X])^j] from closed form
+//		central moments or cumulants
+//		E[X^k] = Σ_x x^k P(X = x) for discrete distributions bounded below,
+//		∫(0, 1) Q(u)^k du otherwise
+//
+// Moments of heavy tailed distributions are +Inf or NaN when they do not exist.
+//
+func RawMoment(d Distribution, k int) float64 {
+	switch {
+	case k < 0:
+		return math.NaN()
+	case k == 0:
+		return 1
+	}
+	if m, ok := d.(rawMomenter); ok {
+		return m.rawMoment(k)
+	}
+	_, central := d.(centralMomenter)
+	_, cumulants := d.(cumulanter)
+	if central || cumulants {
+		return shiftMoment(func(j int) float64 { return CentralMoment(d, j) }, d.Mean(), k)
+	}
+	return expect(d, func(x float64) float64 { return math.Pow(x, float64(k)) })
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+// Algorithm:
+//		Closed forms of the distribution when known
+//		μ_n = Σ_(j=2..n) C(n-1, j-1) κ_j μ_(n-j) from closed form cumulants
+//		μ_k = Σ_j C(k, j) (-E[X])^(k-j) E[X^j] from closed form raw moments
+//		E[(X - E[X])^k] computed as E[X^k] otherwise
+//
+func CentralMoment(d Distribution, k int) float64 {
+	switch {
+	case k < 0:
+		return math.NaN()
+	case k == 0:
+		return 1
+	}
+	mean := d.Mean()
+	if math.IsNaN(mean) || math.IsInf(mean, 0) {
+		return math.NaN()
+	}
+	if k == 1 {
+		return 0
+	}
+	if m, ok := d.(centralMomenter); ok {
+		return m.centralMoment(k)
+	}
+	if c, ok := d.(cumulanter); ok {
+		return cumulantMoment(func(j int) float64 {
+			if j == 1 {
+				return 0
+			}
+			return c.cumulant(j)
+		}, k)
+	}
+	if _, ok := d.(rawMomenter); ok {
+		return shiftMoment(func(j int) float64 { return RawMoment(d, j) }, -mean, k)
+	}
+	return expect(d, func(x float64) float64 { return math.Pow(x-mean, float64(k)) })
+}
+
+// Cumulant returns the k-th cumulant of the distribution, the k-th
+// derivative at 0 of its cumulant generating function log(E[exp(tX)])
+// Algorithm:
+//		κ_1 = E[X]
+//		κ_n = μ_n - Σ_(j=2..n-2) C(n-1, j-1) κ_j μ_(n-j), μ_n being the
+//		central moments
+//
+func Cumulant(d Distribution, k int) float64 {
+	if k < 1 {
+		return math.NaN()
+	}
+	if c, ok := d.(cumulanter); ok {
+		return c.cumulant(k)
+	}
+	if k == 1 {
+		return d.Mean()
+	}
+	central := make([]float64, k+1)
+	for n := 2; n <= k; n++ {
+		central[n] = CentralMoment(d, n)
+	}
+	cumulants := make([]float64, k+1)
+	for n := 2; n <= k; n++ {
+		cumulants[n] = central[n]
+		for j := 2; j <= n-2; j++ {
+			cumulants[n] -= binomialCoefficient(n-1, j-1) * cumulants[j] * central[n-j]
+		}
+	}
+	return cumulants[k]
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+// Algorithm:
+//		Closed forms of the distribution when known
+//		E[cos(tX)] + i E[sin(tX)] otherwise
+//
+func CF(d Distribution, t float64) complex128 {
+	if t == 0 {
+		return 1
+	}
+	if c, ok := d.(characteristic); ok {
+		return c.cf(t)
+	}
+	re := expect(d, func(x float64) float64 { return math.Cos(t * x) })
+	im := expect(d, func(x float64) float64 { return math.Sin(t * x) })
+	return complex(re, im)
+}
+
+// expi returns exp(ix)
+func expi(x float64) complex128 {
+	return cmplx.Rect(1, x)
+}
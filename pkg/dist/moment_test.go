@@ -0,0 +1,209 @@
+package dist
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+
+	"github.com/ichbinfrog/statistics/pkg/array"
+)
+
+// powerSums returns an array of n samples of d holding the sums of their
+// first powers up to degree
+func powerSums(d Distribution, n int, seed int64, degree int) *array.Arrayf64 {
+	r := rand.New(rand.NewSource(seed))
+	a := &array.Arrayf64{}
+	a.Init(array.Optionf64{
+		Degree: degree,
+	})
+	for i := 0; i < n; i++ {
+		a.Insert(d.Rand(r))
+	}
+	return a
+}
+
+// near returns true when v and e agree within the relative tolerance tol
+func near(v, e, tol float64) bool {
+	return math.Abs(v-e) <= tol*math.Max(1, math.Abs(e))
+}
+
+func TestMoments(t *testing.T) {
+	empirical := &Empirical{}
+	empirical.Init(observations(1.5, -2, 3.25, 0, 7, 3.25, -1))
+	interpolated := &Empirical{Interpolate: true}
+	interpolated.Init(observations(1.5, -2, 3.25, 0, 7, 3.25, -1))
+	kde := &KDE{}
+	kde.Init(sample(&Gamma{Alpha: 3, Beta: 1}, 200, 1), EpanechnikovKernel, SilvermanBandwidth)
+	gaussian := &KDE{}
+	gaussian.Init(sample(&Normal{Mu: 1, Sigma: 2}, 30, 1), GaussianKernel, SilvermanBandwidth)
+	mixture := &Mixture{}
+	mixture.Init([]Distribution{&Normal{Mu: -2, Sigma: 1}, &Gamma{Alpha: 4, Beta: 2}}, []float64{.3, .7})
+
+	for _, tc := range []struct {
+		name string
+		dist Distribution
+	}{
+		{"normal", &Normal{Mu: 1.5, Sigma: 2}},
+		{"exponential", &Exponential{Lambda: 2}},
+		{"gamma", &Gamma{Alpha: 2.5, Beta: 1.5}},
+		{"chisq", &Chisq{Degree: 5}},
+		{"uniform", &Uniform{A: -1, B: 3}},
+		{"triangular", &Triangular{A: 0, B: 4, C: 1}},
+		{"studentt", &StudentT{Nu: 14}},
+		{"locationscalet", &LocationScaleT{Nu: 13, Mu: 2, Sigma: .5}},
+		{"laplace", &Laplace{Mu: -1, Sigma: 2}},
+		{"logistic", &Logistic{Mu: 1, Sigma: .5}},
+		{"gumbel", &Gumbel{Mu: 1, Sigma: 2}},
+		{"gumbelmin", &GumbelMin{Mu: 1, Sigma: 2}},
+		{"weibull", &Weibull{K: 1.5, Lambda: 2, Theta: 1}},
+		{"lognormal", &LogNormal{Mu: 0, Sigma: .25}},
+		{"pareto", &Pareto{Xm: 1, Alpha: 14}},
+		{"lomax", &Lomax{Alpha: 14, Lambda: 2}},
+		{"beta", &Beta{Alpha: 2, Beta: 5}},
+		{"fisherf", &FisherF{D1: 5, D2: 40}},
+		{"noncentralt", &NoncentralT{Nu: 30, Delta: 1}},
+		{"bernoulli", &Bernoulli{P: .3, Q: .7}},
+		{"binomial", &Binomial{N: 12, P: .4, Q: .6}},
+		{"geometric", &Geometric{P: .3, Q: .7}},
+		{"poisson", &Poisson{Lambda: 3.5}},
+		{"polya", &Polya{R: 3, P: .4, Q: .6}},
+		{"hypergeometric", &Hypergeometric{Population: 30, Successes: 12, Draws: 8}},
+		{"discreteuniform", &DiscreteUniform{A: -2, B: 5}},
+		{"categorical", categorical([]float64{1, 5, 0, 2, 2})},
+		{"betabinomial", &BetaBinomial{N: 15, Alpha: 2, Beta: 3}},
+		{"empirical", empirical},
+		{"empirical interpolated", interpolated},
+		{"kde", kde},
+		{"kde gaussian", gaussian},
+		{"mixture", mixture},
+		{"truncated", &Truncated{Dist: &Normal{Mu: 0, Sigma: 1}, Lower: -.5, Upper: 2}},
+		{"censored", &Censored{Dist: &Exponential{Lambda: 1}, Lower: .2, Upper: 2}},
+		{"affine", &Affine{Dist: &Gamma{Alpha: 3, Beta: 2}, A: -2, B: 1}},
+		{"affine discrete", &Affine{Dist: &Poisson{Lambda: 4}, A: -1, B: 2}},
+		{"maximum", &Maximum{Dist: &Normal{Mu: 0, Sigma: 1}, N: 4}},
+		{"minimum", &Minimum{Dist: &Poisson{Lambda: 6}, N: 3}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := tc.dist
+			// Closed forms match the numerical expectations
+			for k := 1; k <= 4; k++ {
+				kf := float64(k)
+				if v, e := RawMoment(d, k), expect(d, func(x float64) float64 { return math.Pow(x, kf) }); !near(v, e, 1e-6) {
+					t.Errorf("E[X^%d] = %.10g, expected %.10g", k, v, e)
+				}
+				mean := d.Mean()
+				if v, e := CentralMoment(d, k), expect(d, func(x float64) float64 { return math.Pow(x-mean, kf) }); !near(v, e, 1e-6) {
+					t.Errorf("E[(X - E[X])^%d] = %.10g, expected %.10g", k, v, e)
+				}
+			}
+
+			variance := d.Var()
+			if v := Cumulant(d, 1); !near(v, d.Mean(), 1e-9) {
+				t.Errorf("κ_1 = %g, expected %g", v, d.Mean())
+			}
+			if v := Cumulant(d, 2); !near(v, variance, 1e-8) {
+				t.Errorf("κ_2 = %g, expected %g", v, variance)
+			}
+			if s, ok := d.(interface{ Skewness() float64 }); ok {
+				if v := Cumulant(d, 3) / math.Pow(variance, 1.5); !near(v, s.Skewness(), 1e-6) {
+					t.Errorf("κ_3 / κ_2^(3/2) = %g, expected the skewness %g", v, s.Skewness())
+				}
+			}
+			if s, ok := d.(interface{ Kurtosis() float64 }); ok {
+				if v := Cumulant(d, 4) / (variance * variance); !near(v, s.Kurtosis(), 1e-6) {
+					t.Errorf("κ_4 / κ_2^2 = %g, expected the kurtosis %g", v, s.Kurtosis())
+				}
+			}
+
+			if v := CF(d, 0); v != 1 {
+				t.Errorf("φ(0) = %v, expected 1", v)
+			}
+			for _, u := range []float64{-.7, .3, 1.2} {
+				e := complex(
+					expect(d, func(x float64) float64 { return math.Cos(u * x) }),
+					expect(d, func(x float64) float64 { return math.Sin(u * x) }),
+				)
+				if v := CF(d, u); cmplx.Abs(v-e) > 1e-6 || cmplx.Abs(v) > 1+1e-12 {
+					t.Errorf("φ(%g) = %v, expected %v", u, v, e)
+				}
+			}
+
+			// The power sums of a sample converge to the raw moments
+			n := 5000
+			a := powerSums(d, n, 1, 3)
+			for k := 1; k <= 3; k++ {
+				m := RawMoment(d, k)
+				se := math.Sqrt((RawMoment(d, 2*k) - m*m) / float64(n))
+				if v := a.Sum[k-1] / float64(n); math.Abs(v-m) > 5*se+1e-12 {
+					t.Errorf("Σ x^%d / n = %g, expected %g ± %g", k, v, m, 5*se)
+				}
+			}
+		})
+	}
+}
+
+func TestMomentsUndefined(t *testing.T) {
+	cauchy := &Cauchy{Mu: 1, Sigma: 2}
+	if v := cauchy.RawMoment(1); !math.IsNaN(v) {
+		t.Errorf("E[X] = %f, expected NaN", v)
+	}
+	if v := cauchy.Cumulant(2); !math.IsNaN(v) {
+		t.Errorf("κ_2 = %f, expected NaN", v)
+	}
+	if v, e := cauchy.CF(1.5), cmplx.Exp(complex(-3, 1.5)); cmplx.Abs(v-e) > 1e-15 {
+		t.Errorf("φ(1.5) = %v, expected %v", v, e)
+	}
+
+	// Moments of order above the degrees of freedom diverge
+	student := &StudentT{Nu: 3}
+	if v := student.RawMoment(2); !near(v, 3, 1e-12) {
+		t.Errorf("E[X^2] = %f, expected 3", v)
+	}
+	if v := student.CentralMoment(4); !math.IsInf(v, 1) {
+		t.Errorf("E[X^4] = %f, expected +Inf", v)
+	}
+	if v := student.RawMoment(3); !math.IsNaN(v) {
+		t.Errorf("E[X^3] = %f, expected NaN", v)
+	}
+	if v := (&Pareto{Xm: 1, Alpha: 2.5}).RawMoment(3); !math.IsInf(v, 1) {
+		t.Errorf("E[X^3] = %f, expected +Inf", v)
+	}
+	maximum := &Maximum{Dist: &Pareto{Xm: 1, Alpha: 1.5}, N: 3}
+	if v := maximum.RawMoment(2); !math.IsInf(v, 1) {
+		t.Errorf("E[M^2] = %f, expected +Inf", v)
+	}
+	if v := RawMoment(&Normal{Mu: 0, Sigma: 1}, -1); !math.IsNaN(v) {
+		t.Errorf("E[X^-1] = %f, expected NaN", v)
+	}
+	if v := Cumulant(&Normal{Mu: 0, Sigma: 1}, 0); !math.IsNaN(v) {
+		t.Errorf("κ_0 = %f, expected NaN", v)
+	}
+}
+
+func TestCumulantRecursion(t *testing.T) {
+	// Cumulants recovered from the moments of the Poisson distribution
+	// are all λ
+	poisson := &Poisson{Lambda: 2.5}
+	p := make([]float64, 40)
+	for k := range p {
+		p[k] = poisson.PMF(float64(k))
+	}
+	table := categorical(p)
+	for k := 1; k <= 6; k++ {
+		if v := table.Cumulant(k); !near(v, 2.5, 1e-9) {
+			t.Errorf("κ_%d = %.12f, expected 2.5", k, v)
+		}
+		if v, e := poisson.RawMoment(k), table.RawMoment(k); !near(v, e, 1e-9) {
+			t.Errorf("E[X^%d] = %.12f, expected %.12f", k, v, e)
+		}
+	}
+	// The Normal distribution has no cumulant beyond the variance
+	normal := &Normal{Mu: 1, Sigma: 2}
+	if v := normal.CentralMoment(6); v != 15*64 {
+		t.Errorf("E[(X - μ)^6] = %f, expected %d", v, 15*64)
+	}
+	if v := normal.RawMoment(3); !near(v, 1+3*4, 1e-12) {
+		t.Errorf("E[X^3] = %f, expected 13", v)
+	}
+}
@@ -0,0 +1,164 @@
+package dist
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/ichbinfrog/statistics/pkg/matrix"
+	"github.com/ichbinfrog/statistics/pkg/specfun"
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// Multinomial represents the Multinomial distribution, the counts of
+// each of k categories in n independent trials
+// Discreet multivariate probability distribution function as follows:
+//		X ~ Mult(n, p_1, ..., p_k), Σ p_i = 1
+//		f(x,n,p) = {
+//			n! / (x_1!...x_k!) p_1^x_1...p_k^x_k
+//		}, x_i in [0, ..., n], Σ x_i = n
+//
+type Multinomial struct {
+	source
+	N float64
+	P []float64
+}
+
+// Init intialises a Multinomial distribution from non negative weights,
+// which are normalised to sum up to 1
+func (m *Multinomial) Init(n float64, p []float64) error {
+	if n < 0 || n != math.Floor(n) || len(p) == 0 {
+		return util.ErrMultinomialParam
+	}
+	total := 0.0
+	for _, v := range p {
+		if v < 0 || math.IsNaN(v) || math.IsInf(v, 0) {
+			return util.ErrMultinomialParam
+		}
+		total += v
+	}
+	if total <= 0 {
+		return util.ErrMultinomialParam
+	}
+
+	m.N = n
+	m.P = make([]float64, len(p))
+	for i, v := range p {
+		m.P[i] = v / total
+	}
+	return nil
+}
+
+// Generate creates one sample of the Multinomial distribution
+func (m *Multinomial) Generate() []float64 {
+	return m.Rand(m.rng())
+}
+
+// GenerateN creates n samples of the Multinomial distribution
+func (m *Multinomial) GenerateN(n int) [][]float64 {
+	res := make([][]float64, n)
+	rng := m.rng()
+	for i := range res {
+		res[i] = m.Rand(rng)
+	}
+	return res
+}
+
+// Rand creates one sample of the Multinomial distribution using the given generator
+// Algorithm: conditional binomial method
+//		x_i ~ B(n - x_1 - ... - x_(i-1), p_i / (p_i + ... + p_k))
+//
+// Complexity: k binomial samples
+//
+func (m *Multinomial) Rand(r *rand.Rand) []float64 {
+	x := make([]float64, len(m.P))
+	n, rest := m.N, 1.0
+	for i, p := range m.P {
+		if n == 0 {
+			break
+		}
+		if i == len(m.P)-1 || p >= rest {
+			x[i] = n
+			break
+		}
+		if q := p / rest; q > 0 {
+			b := &Binomial{N: n, P: q, Q: 1 - q}
+			x[i] = b.Rand(r)
+		}
+		n -= x[i]
+		rest -= p
+	}
+	return x
+}
+
+// PMF returns the probability mass function value of a given vector of counts
+func (m *Multinomial) PMF(x []float64) float64 {
+	return math.Exp(m.LogPMF(x))
+}
+
+// LogPMF returns the log of the probability mass function value of a given vector of counts
+// Algorithm:
+//		log f(x) = log(n!) - Σ log(x_i!) + Σ x_i log(p_i)
+//
+func (m *Multinomial) LogPMF(x []float64) float64 {
+	if len(x) != len(m.P) {
+		return math.Inf(-1)
+	}
+	lg := specfun.LogFactorial(m.N)
+	sum := 0.0
+	for i, v := range x {
+		if v < 0 || v != math.Floor(v) {
+			return math.Inf(-1)
+		}
+		lg += xlogy(v, m.P[i]) - specfun.LogFactorial(v)
+		sum += v
+	}
+	if sum != m.N {
+		return math.Inf(-1)
+	}
+	return lg
+}
+
+// Marginal returns the Binomial distribution of the count of the i-th category
+func (m *Multinomial) Marginal(i int) *Binomial {
+	return &Binomial{N: m.N, P: m.P[i], Q: 1 - m.P[i]}
+}
+
+// Mean returns the mean of the count of each category
+func (m *Multinomial) Mean() []float64 {
+	res := make([]float64, len(m.P))
+	for i, p := range m.P {
+		res[i] = m.N * p
+	}
+	return res
+}
+
+// Var returns the variance of the count of each category
+func (m *Multinomial) Var() []float64 {
+	res := make([]float64, len(m.P))
+	for i, p := range m.P {
+		res[i] = m.N * p * (1 - p)
+	}
+	return res
+}
+
+// Cov returns the covariance matrix of the counts
+//		Cov(X_i, X_j) = n p_i (δ_ij - p_j)
+//
+func (m *Multinomial) Cov() *matrix.Matrixf64 {
+	k := len(m.P)
+	res := &matrix.Matrixf64{}
+	res.Init(k, k)
+	for i := 0; i < k; i++ {
+		for j := 0; j < k; j++ {
+			res.Data[i][j] = -m.N * m.P[i] * m.P[j]
+		}
+		res.Data[i][i] += m.N * m.P[i]
+	}
+	return res
+}
+
+// Summary returns the properties of the distribution
+func (m *Multinomial) Summary() *DistSummary {
+	params := append([]Param{{"n", m.N}}, vectorParams("p", m.P)...)
+	return summariseMultivariate("multinomial", 0, m.N, m.Mean(), m.Cov(), math.NaN(), params...)
+}
@@ -0,0 +1,88 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+func TestMultinomial(t *testing.T) {
+	dist := &Multinomial{}
+	if err := dist.Init(6, []float64{1, 2, 0, 3}); err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(dist.Summary())
+
+	// The pmf sums up to 1 over the support
+	sum := 0.0
+	for a := 0.0; a <= 6; a++ {
+		for b := 0.0; a+b <= 6; b++ {
+			sum += dist.PMF([]float64{a, b, 0, 6 - a - b})
+		}
+	}
+	if math.Abs(sum-1) > 1e-12 {
+		t.Errorf("Σ f(x) = %f, expected 1", sum)
+	}
+	for _, x := range [][]float64{{1, 1, 1, 3}, {1, 1, 4}, {-1, 2, 0, 5}, {1.5, 1.5, 0, 3}, {1, 1, 0, 3}} {
+		if v := dist.PMF(x); v != 0 {
+			t.Errorf("f(%v) = %f, expected 0", x, v)
+		}
+	}
+
+	// Two categories reduce to the Binomial distribution
+	binom := &Multinomial{}
+	binom.Init(10, []float64{.3, .7})
+	for k := 0.0; k <= 10; k++ {
+		expected := (&Binomial{N: 10, P: .3, Q: .7}).PMF(k)
+		if v := binom.PMF([]float64{k, 10 - k}); math.Abs(v-expected) > 1e-12 {
+			t.Errorf("f(%f) = %f, expected %f", k, v, expected)
+		}
+	}
+
+	// Sample moments match the mean and the covariance matrix
+	r := rand.New(rand.NewSource(1))
+	n := 50000
+	mean := make([]float64, 4)
+	cov := [4][4]float64{}
+	for i := 0; i < n; i++ {
+		x := dist.Rand(r)
+		total := 0.0
+		for j := range x {
+			total += x[j]
+			mean[j] += x[j] / float64(n)
+			for l := range x {
+				cov[j][l] += x[j] * x[l] / float64(n)
+			}
+		}
+		if total != dist.N {
+			t.Fatalf("sample %v does not sum up to %f", x, dist.N)
+		}
+	}
+	expectedMean, expectedCov := dist.Mean(), dist.Cov()
+	for j := range mean {
+		if math.Abs(mean[j]-expectedMean[j]) > 2e-2 {
+			t.Errorf("mean[%d] = %f, expected %f", j, mean[j], expectedMean[j])
+		}
+		for l := range mean {
+			c := cov[j][l] - mean[j]*mean[l]
+			if e := *expectedCov.At(j, l); math.Abs(c-e) > 3e-2 {
+				t.Errorf("cov[%d][%d] = %f, expected %f", j, l, c, e)
+			}
+		}
+	}
+	if v := dist.Marginal(3).Mean(); v != expectedMean[3] {
+		t.Errorf("marginal mean = %f, expected %f", v, expectedMean[3])
+	}
+
+	for _, c := range []struct {
+		n float64
+		p []float64
+	}{{-1, []float64{1}}, {1.5, []float64{1}}, {1, []float64{}}, {1, []float64{0, 0}}, {1, []float64{1, -1}}} {
+		if err := (&Multinomial{}).Init(c.n, c.p); err != util.ErrMultinomialParam {
+			t.Errorf("Mult(%f, %v): expected %v, got %v", c.n, c.p, util.ErrMultinomialParam, err)
+		}
+	}
+}
@@ -0,0 +1,322 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/ichbinfrog/statistics/pkg/matrix"
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// MultivariateNormal represents the multivariate Normal distribution of
+// dimension k. It must be built with Init, which factorises the
+// covariance matrix.
+// Continuous multivariate probability distribution function as follows:
+//		X ~ N(μ, Σ), Σ symmetric positive definite
+//
+//		f(x,μ,Σ) = exp(-(x - μ)^T Σ^-1 (x - μ) / 2) / sqrt((2π)^k |Σ|)
+//
+type MultivariateNormal struct {
+	source
+	Mu    []float64
+	Sigma *matrix.Matrixf64
+
+	// chol holds the lower triangular Cholesky factor L of Σ = L L^T
+	chol *matrix.Matrixf64
+}
+
+// Init intialises a multivariate Normal distribution
+func (n *MultivariateNormal) Init(mu []float64, sigma *matrix.Matrixf64) error {
+	k := len(mu)
+	if k == 0 || sigma == nil || sigma.Height() != k || sigma.Width() != k {
+		return util.ErrMultivariateNormalParam
+	}
+	cov := &matrix.Matrixf64{}
+	cov.Init(k, k)
+	for i := 0; i < k; i++ {
+		for j := 0; j < k; j++ {
+			a, b := *sigma.At(i, j), *sigma.At(j, i)
+			if math.Abs(a-b) > 1e-12*math.Max(math.Abs(a), math.Abs(b)) {
+				return util.ErrMultivariateNormalParam
+			}
+			cov.Data[i][j] = a
+		}
+	}
+	chol := matrix.Cholesky(cov)
+	if chol == nil {
+		return util.ErrMultivariateNormalParam
+	}
+
+	n.Mu = make([]float64, k)
+	copy(n.Mu, mu)
+	n.Sigma, n.chol = cov, chol
+	return nil
+}
+
+// Dim returns the dimension of the distribution
+func (n *MultivariateNormal) Dim() int {
+	return len(n.Mu)
+}
+
+// Generate creates one sample of the multivariate Normal distribution
+func (n *MultivariateNormal) Generate() []float64 {
+	return n.Rand(n.rng())
+}
+
+// GenerateN creates size samples of the multivariate Normal distribution
+func (n *MultivariateNormal) GenerateN(size int) [][]float64 {
+	res := make([][]float64, size)
+	rng := n.rng()
+	for i := range res {
+		res[i] = n.Rand(rng)
+	}
+	return res
+}
+
+// Rand creates one sample of the multivariate Normal distribution using the given generator
+// Algorithm: correlating independent standard normal draws with the Cholesky factor
+//		z_i ~ N(0, 1)
+//		x = μ + L z
+//
+// Complexity: O(k^2)
+//
+func (n *MultivariateNormal) Rand(r *rand.Rand) []float64 {
+	k := n.Dim()
+	z := make([]float64, k)
+	for i := range z {
+		z[i] = r.NormFloat64()
+	}
+	x := make([]float64, k)
+	for i := 0; i < k; i++ {
+		x[i] = n.Mu[i]
+		for j := 0; j <= i; j++ {
+			x[i] += n.chol.Data[i][j] * z[j]
+		}
+	}
+	return x
+}
+
+// forwardSubstitution solves L y = b for a lower triangular L
+func forwardSubstitution(l *matrix.Matrixf64, b []float64) []float64 {
+	y := make([]float64, len(b))
+	for i := range b {
+		sum := b[i]
+		for j := 0; j < i; j++ {
+			sum -= l.Data[i][j] * y[j]
+		}
+		y[i] = sum / l.Data[i][i]
+	}
+	return y
+}
+
+// backSubstitution solves L^T x = y for a lower triangular L
+func backSubstitution(l *matrix.Matrixf64, y []float64) []float64 {
+	x := make([]float64, len(y))
+	for i := len(y) - 1; i >= 0; i-- {
+		sum := y[i]
+		for j := i + 1; j < len(y); j++ {
+			sum -= l.Data[j][i] * x[j]
+		}
+		x[i] = sum / l.Data[i][i]
+	}
+	return x
+}
+
+// logDet returns log |Σ| = 2 Σ log L_ii
+func (n *MultivariateNormal) logDet() float64 {
+	sum := 0.0
+	for i := range n.Mu {
+		sum += math.Log(n.chol.Data[i][i])
+	}
+	return 2 * sum
+}
+
+// Mahalanobis returns the Mahalanobis distance of a given point to the mean
+//		d(x) = sqrt((x - μ)^T Σ^-1 (x - μ)) = ||L^-1 (x - μ)||
+//
+func (n *MultivariateNormal) Mahalanobis(x []float64) float64 {
+	if len(x) != n.Dim() {
+		return math.NaN()
+	}
+	diff := make([]float64, len(x))
+	for i := range x {
+		diff[i] = x[i] - n.Mu[i]
+	}
+	sum := 0.0
+	for _, v := range forwardSubstitution(n.chol, diff) {
+		sum += v * v
+	}
+	return math.Sqrt(sum)
+}
+
+// PDF returns the probability density function value of a given point
+func (n *MultivariateNormal) PDF(x []float64) float64 {
+	return math.Exp(n.LogPDF(x))
+}
+
+// LogPDF returns the log of the probability density function value of a given point
+// Algorithm:
+//		log f(x) = -(k log(2π) + log |Σ| + d(x)^2) / 2
+//
+// Complexity: O(k^2)
+//
+func (n *MultivariateNormal) LogPDF(x []float64) float64 {
+	d := n.Mahalanobis(x)
+	if math.IsNaN(d) {
+		return math.Inf(-1)
+	}
+	return -(float64(n.Dim())*math.Log(2*math.Pi) + n.logDet() + d*d) / 2
+}
+
+// Mean returns the mean vector of the distribution
+func (n *MultivariateNormal) Mean() []float64 {
+	res := make([]float64, n.Dim())
+	copy(res, n.Mu)
+	return res
+}
+
+// Var returns the variance of each component
+func (n *MultivariateNormal) Var() []float64 {
+	res := make([]float64, n.Dim())
+	for i := range res {
+		res[i] = n.Sigma.Data[i][i]
+	}
+	return res
+}
+
+// Cov returns the covariance matrix of the distribution
+func (n *MultivariateNormal) Cov() *matrix.Matrixf64 {
+	return n.sub(allIndices(n.Dim()), allIndices(n.Dim()))
+}
+
+// Entropy returns the Entropy of the distribution
+func (n *MultivariateNormal) Entropy() float64 {
+	return (float64(n.Dim())*(1+math.Log(2*math.Pi)) + n.logDet()) / 2
+}
+
+// Component returns the univariate Normal distribution of the i-th component
+func (n *MultivariateNormal) Component(i int) *Normal {
+	return &Normal{Mu: n.Mu[i], Sigma: math.Sqrt(n.Sigma.Data[i][i])}
+}
+
+// allIndices returns [0, ..., k - 1]
+func allIndices(k int) []int {
+	res := make([]int, k)
+	for i := range res {
+		res[i] = i
+	}
+	return res
+}
+
+// validIndices checks that the indices are distinct components of the distribution
+func (n *MultivariateNormal) validIndices(idx []int) bool {
+	seen := make(map[int]bool, len(idx))
+	for _, i := range idx {
+		if i < 0 || i >= n.Dim() || seen[i] {
+			return false
+		}
+		seen[i] = true
+	}
+	return true
+}
+
+// sub returns the sub covariance matrix Σ[rows, cols]
+func (n *MultivariateNormal) sub(rows, cols []int) *matrix.Matrixf64 {
+	res := &matrix.Matrixf64{}
+	res.Init(len(rows), len(cols))
+	for i, r := range rows {
+		for j, c := range cols {
+			res.Data[i][j] = n.Sigma.Data[r][c]
+		}
+	}
+	return res
+}
+
+// Marginal returns the multivariate Normal distribution of the given components
+//		X_a ~ N(μ_a, Σ_aa)
+//
+func (n *MultivariateNormal) Marginal(idx []int) (*MultivariateNormal, error) {
+	if len(idx) == 0 || !n.validIndices(idx) {
+		return nil, util.ErrDimension
+	}
+	mu := make([]float64, len(idx))
+	for i, c := range idx {
+		mu[i] = n.Mu[c]
+	}
+	res := &MultivariateNormal{}
+	if err := res.Init(mu, n.sub(idx, idx)); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Conditional returns the multivariate Normal distribution of the remaining
+// components given that the components idx take the given values
+//		X_a | X_b = x_b ~ N(μ_a + Σ_ab Σ_bb^-1 (x_b - μ_b), Σ_aa - Σ_ab Σ_bb^-1 Σ_ba)
+//
+// Complexity: O(k^3)
+//
+func (n *MultivariateNormal) Conditional(idx []int, values []float64) (*MultivariateNormal, error) {
+	if len(idx) != len(values) || len(idx) >= n.Dim() || !n.validIndices(idx) {
+		return nil, util.ErrDimension
+	}
+	given := make(map[int]bool, len(idx))
+	for _, i := range idx {
+		given[i] = true
+	}
+	rest := []int{}
+	for i := 0; i < n.Dim(); i++ {
+		if !given[i] {
+			rest = append(rest, i)
+		}
+	}
+
+	sigmaAB := n.sub(rest, idx)
+	cholB := matrix.Cholesky(n.sub(idx, idx))
+	if cholB == nil {
+		return nil, util.ErrMultivariateNormalParam
+	}
+	diff := make([]float64, len(idx))
+	for i, c := range idx {
+		diff[i] = values[i] - n.Mu[c]
+	}
+	// w = Σ_bb^-1 (x_b - μ_b)
+	w := backSubstitution(cholB, forwardSubstitution(cholB, diff))
+	// v_i = L_bb^-1 Σ_ba[:, i], so that Σ_ab Σ_bb^-1 Σ_ba = V^T V
+	v := make([][]float64, len(rest))
+	for i := range rest {
+		v[i] = forwardSubstitution(cholB, sigmaAB.Data[i])
+	}
+
+	mu := make([]float64, len(rest))
+	sigma := n.sub(rest, rest)
+	for i, c := range rest {
+		mu[i] = n.Mu[c]
+		for j := range idx {
+			mu[i] += sigmaAB.Data[i][j] * w[j]
+		}
+		for j := range rest {
+			for l := range idx {
+				sigma.Data[i][j] -= v[i][l] * v[j][l]
+			}
+		}
+	}
+
+	res := &MultivariateNormal{}
+	if err := res.Init(mu, sigma); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Summary returns the properties of the distribution
+func (n *MultivariateNormal) Summary() *DistSummary {
+	params := vectorParams("mu", n.Mu)
+	for i, row := range n.Sigma.Data {
+		for j, v := range row {
+			params = append(params, Param{fmt.Sprintf("sigma[%d,%d]", i, j), v})
+		}
+	}
+	return summariseMultivariate("multivariatenormal", math.Inf(-1), math.Inf(1), n.Mean(), n.Cov(), n.Entropy(), params...)
+}
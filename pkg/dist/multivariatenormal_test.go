@@ -0,0 +1,112 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/ichbinfrog/statistics/pkg/matrix"
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// covariance returns a matrix holding the given rows
+func covariance(rows [][]float64) *matrix.Matrixf64 {
+	m := &matrix.Matrixf64{}
+	m.Init(len(rows), len(rows[0]))
+	for i := range rows {
+		copy(m.Data[i], rows[i])
+	}
+	return m
+}
+
+func TestMultivariateNormal(t *testing.T) {
+	dist := &MultivariateNormal{}
+	err := dist.Init([]float64{1, -2, .5}, covariance([][]float64{
+		{4, 1.2, -.8},
+		{1.2, 1, .3},
+		{-.8, .3, 2},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(dist.Summary())
+
+	// A diagonal covariance factorises into independent Normal densities
+	diag := &MultivariateNormal{}
+	diag.Init([]float64{1, -2}, covariance([][]float64{{4, 0}, {0, .25}}))
+	for _, x := range [][]float64{{0, 0}, {1, -2}, {3.5, -1.2}} {
+		expected := (&Normal{Mu: 1, Sigma: 2}).LogPDF(x[0]) + (&Normal{Mu: -2, Sigma: .5}).LogPDF(x[1])
+		if v := diag.LogPDF(x); math.Abs(v-expected) > 1e-12 {
+			t.Errorf("log f(%v) = %f, expected %f", x, v, expected)
+		}
+	}
+	if v := dist.LogPDF([]float64{1, 2}); !math.IsInf(v, -1) {
+		t.Errorf("log f of a point of the wrong dimension = %f, expected -Inf", v)
+	}
+
+	// Sample moments match the mean and the covariance matrix
+	r := rand.New(rand.NewSource(1))
+	n := 50000
+	mean := make([]float64, 3)
+	cov := [3][3]float64{}
+	for i := 0; i < n; i++ {
+		x := dist.Rand(r)
+		for j := range x {
+			mean[j] += x[j] / float64(n)
+			for l := range x {
+				cov[j][l] += x[j] * x[l] / float64(n)
+			}
+		}
+	}
+	expectedCov := dist.Cov()
+	for j := range mean {
+		if math.Abs(mean[j]-dist.Mu[j]) > 3e-2 {
+			t.Errorf("mean[%d] = %f, expected %f", j, mean[j], dist.Mu[j])
+		}
+		for l := range mean {
+			c := cov[j][l] - mean[j]*mean[l]
+			if e := *expectedCov.At(j, l); math.Abs(c-e) > 5e-2 {
+				t.Errorf("cov[%d][%d] = %f, expected %f", j, l, c, e)
+			}
+		}
+	}
+
+	marginal, err := dist.Marginal([]int{2, 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if marginal.Mu[0] != .5 || marginal.Sigma.Data[0][1] != -.8 || marginal.Sigma.Data[1][1] != 4 {
+		t.Errorf("unexpected marginal %s", marginal.Summary())
+	}
+
+	// Bivariate conditional: N(μ_1 + ρσ_1/σ_2 (x_2 - μ_2), σ_1^2 (1 - ρ^2))
+	biv := &MultivariateNormal{}
+	biv.Init([]float64{1, 3}, covariance([][]float64{{4, 1.5}, {1.5, 1}}))
+	cond, err := biv.Conditional([]int{1}, []float64{5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(cond.Mu[0]-4) > 1e-12 || math.Abs(cond.Sigma.Data[0][0]-1.75) > 1e-12 {
+		t.Errorf("conditional N(%v, %v), expected N(4, 1.75)", cond.Mu, cond.Sigma.Data)
+	}
+	// Conditioning on a component of the trivariate leaves a valid bivariate
+	if c, err := dist.Conditional([]int{1}, []float64{0}); err != nil || c.Dim() != 2 {
+		t.Errorf("conditional: %v", err)
+	}
+	if c := dist.Component(1); c.Mu != -2 || c.Sigma != 1 {
+		t.Errorf("component N(%f, %f), expected N(-2, 1)", c.Mu, c.Sigma)
+	}
+
+	if _, err := dist.Marginal([]int{0, 0}); err != util.ErrDimension {
+		t.Errorf("expected %v, got %v", util.ErrDimension, err)
+	}
+	if _, err := dist.Conditional([]int{0, 1, 2}, []float64{0, 0, 0}); err != util.ErrDimension {
+		t.Errorf("expected %v, got %v", util.ErrDimension, err)
+	}
+	for _, sigma := range [][][]float64{{{1, 2}, {2, 1}}, {{1, .5}, {0, 1}}, {{1}}} {
+		if err := (&MultivariateNormal{}).Init([]float64{0, 0}, covariance(sigma)); err != util.ErrMultivariateNormalParam {
+			t.Errorf("Σ = %v: expected %v, got %v", sigma, util.ErrMultivariateNormalParam, err)
+		}
+	}
+}
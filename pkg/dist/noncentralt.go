@@ -0,0 +1,248 @@
+package dist
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/ichbinfrog/statistics/pkg/specfun"
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// NoncentralT represents the noncentral Student's t distribution, the
+// distribution of (Z + δ) / √(V/ν) where Z ~ N(0, 1) and V ~ χ(ν)
+// Continuous probability distribution function as follows:
+//		X ~ t(ν, δ), ν > 0
+//
+type NoncentralT struct {
+	source
+	Nu, Delta float64
+}
+
+// Init intialises a noncentral Student's t distribution
+func (n *NoncentralT) Init(nu, delta float64) error {
+	if nu <= 0 {
+		return util.ErrNoncentralTParam
+	}
+	n.Nu, n.Delta = nu, delta
+	return nil
+}
+
+// Generate creates one sample of the noncentral Student's t distribution
+func (n *NoncentralT) Generate() float64 {
+	return n.Rand(n.rng())
+}
+
+// GenerateN creates size samples of the noncentral Student's t distribution
+func (n *NoncentralT) GenerateN(size int) []float64 {
+	return GenerateN(n, n.rng(), size)
+}
+
+// Fill fills dst with samples of the noncentral Student's t distribution
+func (n *NoncentralT) Fill(dst []float64) {
+	Fill(n, n.rng(), dst)
+}
+
+// Rand creates one sample of the noncentral Student's t distribution using the given generator
+// Algorithm:
+//		(Z + δ) / √(V/ν), Z ~ N(0, 1), V ~ χ(ν) = Γ(ν/2, 1/2)
+//
+// Complexity: O(1), the cost of a sample of the Gamma distribution
+//
+func (n *NoncentralT) Rand(r *rand.Rand) float64 {
+	return n.ratio(r, newGammaSampler(n.Nu/2))
+}
+
+// fill fills dst with samples of the noncentral Student's t distribution,
+// sharing the constants of the Gamma sampler
+func (n *NoncentralT) fill(r *rand.Rand, dst []float64) {
+	s := newGammaSampler(n.Nu / 2)
+	for i := range dst {
+		dst[i] = n.ratio(r, s)
+	}
+}
+
+// ratio returns (Z + δ) / √(V/ν), V being twice a sample of s
+func (n *NoncentralT) ratio(r *rand.Rand, s *gammaSampler) float64 {
+	return (r.NormFloat64() + n.Delta) / math.Sqrt(2*s.sample(r)/n.Nu)
+}
+
+// Domain returns the definition domain of the distribution
+func (n *NoncentralT) Domain() (float64, float64) {
+	return math.Inf(-1), math.Inf(0)
+}
+
+// PDF returns the probability density function value of a given x
+// Algorithm:
+//		f(0) = Γ((ν+1)/2) exp(-δ^2/2) / (√(νπ) Γ(ν/2))
+//		f(x) = ν/x (F(x√(1 + 2/ν); ν + 2, δ) - F(x; ν, δ))
+//
+func (n *NoncentralT) PDF(x float64) float64 {
+	if x == 0 {
+		return math.Exp(-specfun.LogBeta(n.Nu/2, .5) - n.Delta*n.Delta/2 - math.Log(n.Nu)/2)
+	}
+	if math.IsInf(x, 0) {
+		return 0
+	}
+	upper := &NoncentralT{Nu: n.Nu + 2, Delta: n.Delta}
+	return math.Max(0, n.Nu/x*(upper.CDF(x*math.Sqrt(1+2/n.Nu))-n.CDF(x)))
+}
+
+// LogPDF returns the log of the probability density function value of a given x
+func (n *NoncentralT) LogPDF(x float64) float64 {
+	return math.Log(n.PDF(x))
+}
+
+// CDF returns the Cumulative distribution function value of a given x
+// Algorithm: AS 243, the cdf is written as a Poisson weighted series of
+// incomplete beta functions evaluated by recurrence
+//
+// LENTH, Russell V. Algorithm AS 243: Cumulative distribution function
+// of the non-central t distribution. 1989.
+// The series loses accuracy when |δ| > 37.
+func (n *NoncentralT) CDF(x float64) float64 {
+	if math.IsInf(x, 0) {
+		if x > 0 {
+			return 1
+		}
+		return 0
+	}
+	del, neg := n.Delta, false
+	if x < 0 {
+		del, neg = -del, true
+	}
+
+	tnc := 0.0
+	if y := x * x / (x*x + n.Nu); y > 0 {
+		lambda := del * del
+		p := math.Exp(-lambda/2) / 2
+		q := math.Sqrt(2/math.Pi) * p * del
+		s := .5 - p
+		a, b := .5, n.Nu/2
+		rxb := math.Pow(1-y, b)
+		albeta := specfun.LogBeta(a, b)
+		xodd := specfun.RegIncBeta(a, b, y)
+		godd := 2 * rxb * math.Exp(a*math.Log(y)-albeta)
+		xeven := 1 - rxb
+		geven := b * y * rxb
+		tnc = p*xodd + q*xeven
+		for en := 1.0; en <= maxIter; en++ {
+			a++
+			xodd -= godd
+			xeven -= geven
+			godd *= y * (a + b - 1) / a
+			geven *= y * (a + b - .5) / (a + .5)
+			p *= lambda / (2 * en)
+			q *= lambda / (2*en + 1)
+			s -= p
+			tnc += p*xodd + q*xeven
+			if 2*s*(xodd-godd) <= 1e-12 {
+				break
+			}
+		}
+	}
+	tnc += specfun.NormalSurvival(del)
+	if neg {
+		return 1 - tnc
+	}
+	return math.Min(1, tnc)
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given x
+func (n *NoncentralT) LogCDF(x float64) float64 {
+	return math.Log(n.CDF(x))
+}
+
+// Survival returns the survival function value of a given x
+func (n *NoncentralT) Survival(x float64) float64 {
+	mirror := &NoncentralT{Nu: n.Nu, Delta: -n.Delta}
+	return mirror.CDF(-x)
+}
+
+// LogSurvival returns the log of the survival function value of a given x
+func (n *NoncentralT) LogSurvival(x float64) float64 {
+	return math.Log(n.Survival(x))
+}
+
+// Quantile returns the p-th quantile of the distribution
+func (n *NoncentralT) Quantile(p float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	dbeg, dend := n.Domain()
+	return continuousQuantile(n.CDF, p, dbeg, dend, n.Delta, math.Max(1, math.Abs(n.Delta)/2))
+}
+
+// rawMoment returns E[X^k] for k < ν
+//		E[X^k] = (ν/2)^(k/2) Γ((ν-k)/2) / Γ(ν/2) E[(Z + δ)^k]
+//
+func (n *NoncentralT) rawMoment(k int) float64 {
+	kf := float64(k)
+	if kf >= n.Nu {
+		return math.NaN()
+	}
+	z := shiftMoment(normalMoment, n.Delta, k)
+	lg := specfun.LogGamma((n.Nu-kf)/2) - specfun.LogGamma(n.Nu/2)
+	return math.Pow(n.Nu/2, kf/2) * math.Exp(lg) * z
+}
+
+// Mean returns the mean of the distribution, undefined for ν <= 1
+func (n *NoncentralT) Mean() float64 {
+	return n.rawMoment(1)
+}
+
+// Median returns the median of the distribution
+func (n *NoncentralT) Median() float64 {
+	return n.Quantile(.5)
+}
+
+// Var returns the variance of the distribution, undefined for ν <= 2
+func (n *NoncentralT) Var() float64 {
+	return n.rawMoment(2) - math.Pow(n.rawMoment(1), 2)
+}
+
+// Skewness returns the Pearson's moment coefficient of skewness of the
+// distribution, undefined for ν <= 3
+func (n *NoncentralT) Skewness() float64 {
+	m1, m2, m3 := n.rawMoment(1), n.rawMoment(2), n.rawMoment(3)
+	return (m3 - 3*m1*m2 + 2*m1*m1*m1) / math.Pow(m2-m1*m1, 1.5)
+}
+
+// Kurtosis returns the Kurtosis of the distribution, undefined for ν <= 4
+func (n *NoncentralT) Kurtosis() float64 {
+	m1, m2, m3, m4 := n.rawMoment(1), n.rawMoment(2), n.rawMoment(3), n.rawMoment(4)
+	return (m4-4*m1*m3+6*m1*m1*m2-3*math.Pow(m1, 4))/math.Pow(m2-m1*m1, 2) - 3
+}
+
+// Moment returns the t-th moment of the distribution, the moment
+// generating function of the noncentral t distribution only exists at 0
+func (n *NoncentralT) Moment(t float64) float64 {
+	if t == 0 {
+		return 1
+	}
+	return math.NaN()
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (n *NoncentralT) RawMoment(k int) float64 {
+	return RawMoment(n, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (n *NoncentralT) CentralMoment(k int) float64 {
+	return CentralMoment(n, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (n *NoncentralT) Cumulant(k int) float64 {
+	return Cumulant(n, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (n *NoncentralT) CF(t float64) complex128 {
+	return CF(n, t)
+}
+
+// Summary returns the properties of the distribution
+func (n *NoncentralT) Summary() *DistSummary {
+	return summarise(n, "noncentralt", Param{"nu", n.Nu}, Param{"delta", n.Delta})
+}
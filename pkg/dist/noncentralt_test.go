@@ -0,0 +1,73 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestNoncentralT(t *testing.T) {
+	dist := &NoncentralT{}
+	if err := dist.Init(8, 1.5); err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(dist.Summary())
+
+	// δ = 0 is the central Student's t distribution
+	central, st := &NoncentralT{Nu: 5, Delta: 0}, &StudentT{Nu: 5}
+	for _, x := range []float64{-10, -1, 0, .5, 3} {
+		if v, e := central.CDF(x), st.CDF(x); math.Abs(v-e) > 1e-12 {
+			t.Errorf("t(5, 0): F(%g) = %g, expected %g", x, v, e)
+		}
+		if v, e := central.PDF(x), st.PDF(x); math.Abs(v-e) > 1e-9 {
+			t.Errorf("t(5, 0): f(%g) = %g, expected %g", x, v, e)
+		}
+	}
+
+	// The density is the derivative of the cdf
+	for _, x := range []float64{-2, .3, 1.5, 4} {
+		h := 1e-5
+		if v, e := dist.PDF(x), (dist.CDF(x+h)-dist.CDF(x-h))/(2*h); math.Abs(v-e) > 1e-7 {
+			t.Errorf("f(%g) = %g, expected %g", x, v, e)
+		}
+	}
+
+	moments := []struct {
+		Name     string
+		Actual   float64
+		Expected float64
+	}{
+		{"mean", expectation(dist, func(x float64) float64 { return x }, 20000), dist.Mean()},
+		{"var", expectation(dist, func(x float64) float64 { return math.Pow(x-dist.Mean(), 2) }, 20000), dist.Var()},
+		{"skewness", expectation(dist, func(x float64) float64 { return math.Pow(x-dist.Mean(), 3) }, 20000) / math.Pow(dist.Var(), 1.5), dist.Skewness()},
+	}
+	for _, m := range moments {
+		if math.Abs(m.Actual-m.Expected) > 2e-2*math.Abs(m.Expected) {
+			t.Errorf("%s %f, numerically %f", m.Name, m.Expected, m.Actual)
+		}
+	}
+	if err := dist.Init(0, 1); err == nil {
+		t.Errorf("expected error for ν = 0")
+	}
+}
+
+func BenchmarkNoncentralTRand(b *testing.B) {
+	dist := &NoncentralT{}
+	dist.Init(8, 1.5)
+	dst := make([]float64, 1000)
+	b.Run("construction", func(b *testing.B) {
+		r := rand.New(rand.NewSource(1))
+		for i := 0; i < b.N; i++ {
+			Fill(dist, r, dst)
+		}
+	})
+	b.Run("inversion", func(b *testing.B) {
+		r := rand.New(rand.NewSource(1))
+		for i := 0; i < b.N; i++ {
+			for j := range dst {
+				dst[j] = dist.Quantile(r.Float64())
+			}
+		}
+	})
+}
@@ -1,22 +1,25 @@
 package dist
 
 import (
-	"fmt"
 	"math"
+	"math/cmplx"
 	"math/rand"
 
+	"github.com/ichbinfrog/statistics/pkg/array"
+	"github.com/ichbinfrog/statistics/pkg/specfun"
 	"github.com/ichbinfrog/statistics/pkg/util"
 )
 
-// Normal represents the Poisson distribution
+// Normal represents the Normal distribution
 // Continuous probability distribution function as follows:
 // 		X ~ N(μ	, σ)
 //
 type Normal struct {
+	source
 	Mu, Sigma float64
 }
 
-// Init intialises a Bernoulli distribution
+// Init intialises a Normal distribution
 func (n *Normal) Init(mu, sigma float64) error {
 	if sigma <= 0 {
 		return util.ErrNormalParam
@@ -25,9 +28,28 @@ func (n *Normal) Init(mu, sigma float64) error {
 	return nil
 }
 
-// Generate creates one sample of the Poisson distribution
+// Generate creates one sample of the Normal distribution
 func (n *Normal) Generate() float64 {
-	return rand.NormFloat64()*n.Sigma + n.Mu
+	return n.Rand(n.rng())
+}
+
+// GenerateN creates size samples of the Normal distribution
+func (n *Normal) GenerateN(size int) []float64 {
+	return GenerateN(n, n.rng(), size)
+}
+
+// Fill fills dst with samples of the Normal distribution
+func (n *Normal) Fill(dst []float64) {
+	Fill(n, n.rng(), dst)
+}
+
+// Rand creates one sample of the Normal distribution using the given generator
+// Algorithm: ziggurat sampling of N(0, 1) as done by rand.NormFloat64
+//
+// MARSAGLIA, George, TSANG, Wai Wan. The ziggurat method for generating random variables. 2000.
+//
+func (n *Normal) Rand(r *rand.Rand) float64 {
+	return r.NormFloat64()*n.Sigma + n.Mu
 }
 
 // Domain returns the definition domain of the distribution
@@ -35,14 +57,34 @@ func (n *Normal) Domain() (float64, float64) {
 	return math.Inf(-1), math.Inf(0)
 }
 
-// PMF returns the probability mass function value of a given k
-func (n *Normal) PMF(x float64) float64 {
-	return math.Exp(-math.Pow(((x-n.Mu)/n.Sigma), 2) / 2)
+// PDF returns the probability density function value of a given x
+func (n *Normal) PDF(x float64) float64 {
+	return math.Exp(n.LogPDF(x))
+}
+
+// LogPDF returns the log of the probability density function value of a given x
+func (n *Normal) LogPDF(x float64) float64 {
+	return -math.Pow((x-n.Mu)/n.Sigma, 2)/2 - math.Log(n.Sigma) - math.Log(2*math.Pi)/2
 }
 
-// CDF returns the Cumulative distribution function value of a given k
+// CDF returns the Cumulative distribution function value of a given x
 func (n *Normal) CDF(x float64) float64 {
-	return (.5 + .5*math.Erf((x-n.Mu)/(n.Sigma*math.Sqrt(2))))
+	return specfun.NormalCDF((x - n.Mu) / n.Sigma)
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given x
+func (n *Normal) LogCDF(x float64) float64 {
+	return specfun.LogNormalCDF((x - n.Mu) / n.Sigma)
+}
+
+// Survival returns the survival function value of a given x
+func (n *Normal) Survival(x float64) float64 {
+	return specfun.NormalSurvival((x - n.Mu) / n.Sigma)
+}
+
+// LogSurvival returns the log of the survival function value of a given x
+func (n *Normal) LogSurvival(x float64) float64 {
+	return specfun.LogNormalSurvival((x - n.Mu) / n.Sigma)
 }
 
 // Mean returns the mean of the distribution
@@ -50,9 +92,12 @@ func (n *Normal) Mean() float64 {
 	return n.Mu
 }
 
-// Quantile returns the pth-quantile of the distribution
+// Quantile returns the p-th quantile of the distribution
 func (n *Normal) Quantile(p float64) float64 {
-	return n.Mu + n.Sigma*math.Sqrt(2)*math.Erfinv(2*p-1)
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	return n.Mu + n.Sigma*specfun.NormalQuantile(p)
 }
 
 // Median returns the median of the distribution
@@ -62,7 +107,7 @@ func (n *Normal) Median() float64 {
 
 // Var returns the variance of the distribution
 func (n *Normal) Var() float64 {
-	return math.Sqrt(n.Sigma)
+	return math.Pow(n.Sigma, 2)
 }
 
 // Skewness returns the Pearson's moment coefficient of skewness of the distribution
@@ -77,7 +122,7 @@ func (n *Normal) Kurtosis() float64 {
 
 // Entropy returns the Entropy of the distribution
 func (n *Normal) Entropy() float64 {
-	return (math.Log(2 * math.Pi * math.Exp(1) * math.Pow(n.Sigma, 2)))
+	return math.Log(2*math.Pi*math.E*math.Pow(n.Sigma, 2)) / 2
 }
 
 // Moment returns the t-th moment of the distribution
@@ -85,6 +130,63 @@ func (n *Normal) Moment(t float64) float64 {
 	return math.Exp(n.Mu*t + math.Pow(n.Sigma, 2)*math.Pow(t, 2)/2)
 }
 
+// normalMoment returns the k-th moment of the standard Normal distribution
+//		E[Z^k] = (k-1)!! for even k, 0 otherwise
+//
+func normalMoment(k int) float64 {
+	if k%2 == 1 {
+		return 0
+	}
+	res := 1.0
+	for j := k - 1; j > 1; j -= 2 {
+		res *= float64(j)
+	}
+	return res
+}
+
+// centralMoment returns E[(X - μ)^k] = σ^k E[Z^k]
+func (n *Normal) centralMoment(k int) float64 {
+	return math.Pow(n.Sigma, float64(k)) * normalMoment(k)
+}
+
+// cumulant returns the k-th cumulant, null beyond the variance
+func (n *Normal) cumulant(k int) float64 {
+	switch k {
+	case 1:
+		return n.Mu
+	case 2:
+		return n.Sigma * n.Sigma
+	}
+	return 0
+}
+
+// cf returns the characteristic function of the distribution at t
+//		φ(t) = exp(iμt - σ^2 t^2 / 2)
+//
+func (n *Normal) cf(t float64) complex128 {
+	return cmplx.Exp(complex(-n.Sigma*n.Sigma*t*t/2, n.Mu*t))
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (n *Normal) RawMoment(k int) float64 {
+	return RawMoment(n, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (n *Normal) CentralMoment(k int) float64 {
+	return CentralMoment(n, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (n *Normal) Cumulant(k int) float64 {
+	return Cumulant(n, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (n *Normal) CF(t float64) complex128 {
+	return CF(n, t)
+}
+
 // FisherI returns the Fisher Information of the distribution
 func (n *Normal) FisherI() [][]float64 {
 	return [][]float64{
@@ -93,17 +195,30 @@ func (n *Normal) FisherI() [][]float64 {
 	}
 }
 
-// Summary returns a string summarising basic info about the distribution
-func (n *Normal) Summary() string {
-	dbeg, dend := n.Domain()
-	return fmt.Sprintf(`
-	X ~ N(%f, %f)
-		Domain:			] %f , %f [
-		Mean: 			%f
-		Median:			%f
-		Var: 			%f
-		Skewness: 		%f
-		Kurtosis:		%f
-		FisherInfo:		%v
-`, n.Mu, n.Sigma, dbeg, dend, n.Mean(), n.Median(), n.Var(), n.Skewness(), n.Kurtosis(), n.FisherI())
+// Summary returns the properties of the distribution
+func (n *Normal) Summary() *DistSummary {
+	return summarise(n, "normal", Param{"mu", n.Mu}, Param{"sigma", n.Sigma})
+}
+
+// FitNormal returns the maximum likelihood estimation of a Normal
+// distribution from the given observations:
+//		μ = E[X], σ^2 = E[(X - μ)^2]
+//
+// Complexity: O(1) if the array maintains a degree >= 2, O(n) otherwise
+//
+func FitNormal(a *array.Arrayf64) (*Normal, *FitResult, error) {
+	if err := checkSample(a, 2, math.Inf(-1), math.Inf(0), false); err != nil {
+		return nil, nil, err
+	}
+	mean, variance := sampleMoments(a)
+
+	n := &Normal{}
+	if err := n.Init(mean, math.Sqrt(variance)); err != nil {
+		return nil, nil, err
+	}
+	return n, &FitResult{
+		LogLikelihood: -a.Length * (math.Log(2*math.Pi*variance) + 1) / 2,
+		StdErr:        fisherStdErr(n.FisherI(), a.Length),
+		Observations:  a.Length,
+	}, nil
 }
@@ -2,6 +2,8 @@ package dist
 
 import (
 	"fmt"
+	"math"
+	"math/rand"
 	"testing"
 )
 
@@ -10,8 +12,8 @@ func TestNormal(t *testing.T) {
 	dist.Init(0, 1)
 	fmt.Println(dist.Summary())
 
-	fmt.Printf("		f(0) = %f", dist.PMF(0))
-	fmt.Printf("\n		f(1) = %f\n", dist.PMF(1))
+	fmt.Printf("		f(0) = %f", dist.PDF(0))
+	fmt.Printf("\n		f(1) = %f\n", dist.PDF(1))
 	fmt.Printf("\n		F(-1) = %f", dist.CDF(-1))
 	fmt.Printf("\n		F(.3) = %f", dist.CDF(.3))
 	fmt.Printf("\n		F(.5) = %f", dist.CDF(.5))
@@ -25,3 +27,25 @@ func TestNormal(t *testing.T) {
 	}
 	fmt.Printf("\n	Generated slice: %v\n\n", sl)
 }
+
+func BenchmarkNormalRand(b *testing.B) {
+	dist := &Normal{}
+	dist.Init(1, 2)
+	dst := make([]float64, 1000)
+	b.Run("ziggurat", func(b *testing.B) {
+		r := rand.New(rand.NewSource(1))
+		for i := 0; i < b.N; i++ {
+			Fill(dist, r, dst)
+		}
+	})
+	b.Run("boxmuller", func(b *testing.B) {
+		r := rand.New(rand.NewSource(1))
+		for i := 0; i < b.N; i++ {
+			for j := 0; j+1 < len(dst); j += 2 {
+				rad := math.Sqrt(-2 * math.Log(1-r.Float64()))
+				sin, cos := math.Sincos(2 * math.Pi * r.Float64())
+				dst[j], dst[j+1] = dist.Mu+dist.Sigma*rad*cos, dist.Mu+dist.Sigma*rad*sin
+			}
+		}
+	})
+}
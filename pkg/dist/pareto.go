@@ -0,0 +1,411 @@
+package dist
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/ichbinfrog/statistics/pkg/array"
+	"github.com/ichbinfrog/statistics/pkg/specfun"
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// Pareto represents the Pareto Type I distribution with scale xm and shape α
+// Continuous probability distribution function as follows:
+//		X ~ P(xm, α), xm > 0, α > 0
+//
+//		f(x,xm,α) = α xm^α / x^(α+1), x >= xm
+//
+type Pareto struct {
+	source
+	Xm, Alpha float64
+}
+
+// Init intialises a Pareto distribution
+func (p *Pareto) Init(xm, alpha float64) error {
+	if xm <= 0 || alpha <= 0 {
+		return util.ErrParetoParam
+	}
+	p.Xm, p.Alpha = xm, alpha
+	return nil
+}
+
+// Generate creates one sample of the Pareto distribution
+func (p *Pareto) Generate() float64 {
+	return p.Rand(p.rng())
+}
+
+// GenerateN creates n samples of the Pareto distribution
+func (p *Pareto) GenerateN(n int) []float64 {
+	return GenerateN(p, p.rng(), n)
+}
+
+// Fill fills dst with samples of the Pareto distribution
+func (p *Pareto) Fill(dst []float64) {
+	Fill(p, p.rng(), dst)
+}
+
+// Rand creates one sample of the Pareto distribution using the given generator
+// Algorithm:
+//		X = xm exp(E/α), E ~ Exp(1)
+//
+func (p *Pareto) Rand(r *rand.Rand) float64 {
+	return p.Xm * math.Exp(r.ExpFloat64()/p.Alpha)
+}
+
+// Domain returns the definition domain of the distribution
+func (p *Pareto) Domain() (float64, float64) {
+	return p.Xm, math.Inf(0)
+}
+
+// PDF returns the probability density function value of a given x
+func (p *Pareto) PDF(x float64) float64 {
+	return math.Exp(p.LogPDF(x))
+}
+
+// LogPDF returns the log of the probability density function value of a given x
+func (p *Pareto) LogPDF(x float64) float64 {
+	if x < p.Xm {
+		return math.Inf(-1)
+	}
+	return math.Log(p.Alpha/p.Xm) - (p.Alpha+1)*math.Log(x/p.Xm)
+}
+
+// CDF returns the Cumulative distribution function value of a given x
+func (p *Pareto) CDF(x float64) float64 {
+	return -math.Expm1(p.LogSurvival(x))
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given x
+func (p *Pareto) LogCDF(x float64) float64 {
+	return math.Log(p.CDF(x))
+}
+
+// Survival returns the survival function value of a given x
+func (p *Pareto) Survival(x float64) float64 {
+	return math.Exp(p.LogSurvival(x))
+}
+
+// LogSurvival returns the log of the survival function value of a given x
+func (p *Pareto) LogSurvival(x float64) float64 {
+	if x <= p.Xm {
+		return 0
+	}
+	return -p.Alpha * math.Log(x/p.Xm)
+}
+
+// Quantile returns the p-th quantile of the distribution
+func (p *Pareto) Quantile(q float64) float64 {
+	if !validProbability(q) {
+		return math.NaN()
+	}
+	return p.Xm * math.Exp(-math.Log1p(-q)/p.Alpha)
+}
+
+// Mean returns the mean of the distribution, infinite for α <= 1
+func (p *Pareto) Mean() float64 {
+	if p.Alpha <= 1 {
+		return math.Inf(0)
+	}
+	return p.Alpha * p.Xm / (p.Alpha - 1)
+}
+
+// Median returns the median of the distribution
+func (p *Pareto) Median() float64 {
+	return p.Xm * math.Pow(2, 1/p.Alpha)
+}
+
+// Var returns the variance of the distribution, infinite for α <= 2
+func (p *Pareto) Var() float64 {
+	if p.Alpha <= 2 {
+		return math.Inf(0)
+	}
+	return p.Xm * p.Xm * p.Alpha / (math.Pow(p.Alpha-1, 2) * (p.Alpha - 2))
+}
+
+// Skewness returns the Pearson's moment coefficient of skewness of the
+// distribution, undefined for α <= 3
+func (p *Pareto) Skewness() float64 {
+	return paretoSkewness(p.Alpha)
+}
+
+// Kurtosis returns the Kurtosis of the distribution, undefined for α <= 4
+func (p *Pareto) Kurtosis() float64 {
+	return paretoKurtosis(p.Alpha)
+}
+
+// Entropy returns the Entropy of the distribution
+func (p *Pareto) Entropy() float64 {
+	return math.Log(p.Xm/p.Alpha) + 1/p.Alpha + 1
+}
+
+// Moment returns the t-th moment of the distribution, the moment
+// generating function of the Pareto distribution does not exist for t > 0
+// and has no closed form for t < 0
+func (p *Pareto) Moment(t float64) float64 {
+	if t == 0 {
+		return 1
+	}
+	return math.NaN()
+}
+
+// rawMoment returns E[X^k] = α xm^k / (α - k) for k < α, +Inf otherwise
+func (p *Pareto) rawMoment(k int) float64 {
+	kf := float64(k)
+	if kf >= p.Alpha {
+		return math.Inf(1)
+	}
+	return p.Alpha * math.Pow(p.Xm, kf) / (p.Alpha - kf)
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (p *Pareto) RawMoment(k int) float64 {
+	return RawMoment(p, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (p *Pareto) CentralMoment(k int) float64 {
+	return CentralMoment(p, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (p *Pareto) Cumulant(k int) float64 {
+	return Cumulant(p, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (p *Pareto) CF(t float64) complex128 {
+	return CF(p, t)
+}
+
+// Summary returns the properties of the distribution
+func (p *Pareto) Summary() *DistSummary {
+	return summarise(p, "pareto", Param{"xm", p.Xm}, Param{"alpha", p.Alpha})
+}
+
+// FitPareto returns the maximum likelihood estimation of a Pareto
+// distribution from the given observations:
+//		xm = min(X), α = n / Σ log(x / xm)
+//
+// Since the support depends on xm, its standard error is the standard
+// deviation of the minimum of n observations, distributed as P(xm, nα).
+// Complexity: O(n)
+//
+func FitPareto(a *array.Arrayf64) (*Pareto, *FitResult, error) {
+	if err := checkSample(a, 2, 0, math.Inf(0), false); err != nil {
+		return nil, nil, err
+	}
+	xm := a.Data[0]
+	if xm <= 0 {
+		return nil, nil, util.ErrFitSupport
+	}
+	sumLog := 0.0
+	for _, v := range a.Data {
+		sumLog += math.Log(v / xm)
+	}
+	if sumLog == 0 {
+		return nil, nil, util.ErrFitConvergence
+	}
+
+	p := &Pareto{}
+	if err := p.Init(xm, a.Length/sumLog); err != nil {
+		return nil, nil, err
+	}
+	min := &Pareto{Xm: xm, Alpha: a.Length * p.Alpha}
+	return p, &FitResult{
+		LogLikelihood: a.Length*math.Log(p.Alpha/xm) - (p.Alpha+1)*sumLog,
+		StdErr:        []float64{math.Sqrt(min.Var()), p.Alpha / math.Sqrt(a.Length)},
+		Observations:  a.Length,
+	}, nil
+}
+
+// Lomax represents the Lomax (Pareto Type II) distribution with shape α
+// and scale λ, a Pareto Type I distribution shifted to start at 0
+// Continuous probability distribution function as follows:
+//		X ~ Lomax(α, λ), α > 0, λ > 0
+//
+//		f(x,α,λ) = (α/λ) (1 + x/λ)^(-(α+1)), x >= 0
+//
+type Lomax struct {
+	source
+	Alpha, Lambda float64
+}
+
+// Init intialises a Lomax distribution
+func (l *Lomax) Init(alpha, lambda float64) error {
+	if alpha <= 0 || lambda <= 0 {
+		return util.ErrLomaxParam
+	}
+	l.Alpha, l.Lambda = alpha, lambda
+	return nil
+}
+
+// Generate creates one sample of the Lomax distribution
+func (l *Lomax) Generate() float64 {
+	return l.Rand(l.rng())
+}
+
+// GenerateN creates n samples of the Lomax distribution
+func (l *Lomax) GenerateN(n int) []float64 {
+	return GenerateN(l, l.rng(), n)
+}
+
+// Fill fills dst with samples of the Lomax distribution
+func (l *Lomax) Fill(dst []float64) {
+	Fill(l, l.rng(), dst)
+}
+
+// Rand creates one sample of the Lomax distribution using the given generator
+// Algorithm:
+//		X = λ(exp(E/α) - 1), E ~ Exp(1)
+//
+func (l *Lomax) Rand(r *rand.Rand) float64 {
+	return l.Lambda * math.Expm1(r.ExpFloat64()/l.Alpha)
+}
+
+// Domain returns the definition domain of the distribution
+func (l *Lomax) Domain() (float64, float64) {
+	return 0, math.Inf(0)
+}
+
+// PDF returns the probability density function value of a given x
+func (l *Lomax) PDF(x float64) float64 {
+	return math.Exp(l.LogPDF(x))
+}
+
+// LogPDF returns the log of the probability density function value of a given x
+func (l *Lomax) LogPDF(x float64) float64 {
+	if x < 0 {
+		return math.Inf(-1)
+	}
+	return math.Log(l.Alpha/l.Lambda) - (l.Alpha+1)*math.Log1p(x/l.Lambda)
+}
+
+// CDF returns the Cumulative distribution function value of a given x
+func (l *Lomax) CDF(x float64) float64 {
+	return -math.Expm1(l.LogSurvival(x))
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given x
+func (l *Lomax) LogCDF(x float64) float64 {
+	return math.Log(l.CDF(x))
+}
+
+// Survival returns the survival function value of a given x
+func (l *Lomax) Survival(x float64) float64 {
+	return math.Exp(l.LogSurvival(x))
+}
+
+// LogSurvival returns the log of the survival function value of a given x
+func (l *Lomax) LogSurvival(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	return -l.Alpha * math.Log1p(x/l.Lambda)
+}
+
+// Quantile returns the p-th quantile of the distribution
+func (l *Lomax) Quantile(p float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	return l.Lambda * math.Expm1(-math.Log1p(-p)/l.Alpha)
+}
+
+// Mean returns the mean of the distribution, infinite for α <= 1
+func (l *Lomax) Mean() float64 {
+	if l.Alpha <= 1 {
+		return math.Inf(0)
+	}
+	return l.Lambda / (l.Alpha - 1)
+}
+
+// Median returns the median of the distribution
+func (l *Lomax) Median() float64 {
+	return l.Lambda * math.Expm1(math.Ln2/l.Alpha)
+}
+
+// Var returns the variance of the distribution, infinite for α <= 2
+func (l *Lomax) Var() float64 {
+	if l.Alpha <= 2 {
+		return math.Inf(0)
+	}
+	return l.Lambda * l.Lambda * l.Alpha / (math.Pow(l.Alpha-1, 2) * (l.Alpha - 2))
+}
+
+// Skewness returns the Pearson's moment coefficient of skewness of the
+// distribution, undefined for α <= 3
+func (l *Lomax) Skewness() float64 {
+	return paretoSkewness(l.Alpha)
+}
+
+// Kurtosis returns the Kurtosis of the distribution, undefined for α <= 4
+func (l *Lomax) Kurtosis() float64 {
+	return paretoKurtosis(l.Alpha)
+}
+
+// Entropy returns the Entropy of the distribution
+func (l *Lomax) Entropy() float64 {
+	return math.Log(l.Lambda/l.Alpha) + 1/l.Alpha + 1
+}
+
+// Moment returns the t-th moment of the distribution, the moment
+// generating function of the Lomax distribution does not exist for t > 0
+// and has no closed form for t < 0
+func (l *Lomax) Moment(t float64) float64 {
+	if t == 0 {
+		return 1
+	}
+	return math.NaN()
+}
+
+// rawMoment returns E[X^k] = λ^k k! Γ(α - k) / Γ(α) for k < α, +Inf otherwise
+func (l *Lomax) rawMoment(k int) float64 {
+	kf := float64(k)
+	if kf >= l.Alpha {
+		return math.Inf(1)
+	}
+	return math.Exp(kf*math.Log(l.Lambda) + specfun.LogFactorial(kf) + specfun.LogGamma(l.Alpha-kf) - specfun.LogGamma(l.Alpha))
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (l *Lomax) RawMoment(k int) float64 {
+	return RawMoment(l, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (l *Lomax) CentralMoment(k int) float64 {
+	return CentralMoment(l, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (l *Lomax) Cumulant(k int) float64 {
+	return Cumulant(l, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (l *Lomax) CF(t float64) complex128 {
+	return CF(l, t)
+}
+
+// Summary returns the properties of the distribution
+func (l *Lomax) Summary() *DistSummary {
+	return summarise(l, "lomax", Param{"alpha", l.Alpha}, Param{"lambda", l.Lambda})
+}
+
+// paretoSkewness returns the skewness shared by the Pareto Type I and II
+// distributions of shape α
+func paretoSkewness(alpha float64) float64 {
+	if alpha <= 3 {
+		return math.NaN()
+	}
+	return 2 * (1 + alpha) / (alpha - 3) * math.Sqrt((alpha-2)/alpha)
+}
+
+// paretoKurtosis returns the excess kurtosis shared by the Pareto Type I
+// and II distributions of shape α
+func paretoKurtosis(alpha float64) float64 {
+	if alpha <= 4 {
+		return math.NaN()
+	}
+	return 6 * (alpha*alpha*alpha + alpha*alpha - 6*alpha - 2) / (alpha * (alpha - 3) * (alpha - 4))
+}
@@ -0,0 +1,64 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestPareto(t *testing.T) {
+	testCases := []struct {
+		Name string
+		Dist interface {
+			Continuous
+			Median() float64
+			Skewness() float64
+			Kurtosis() float64
+			Entropy() float64
+			Summary() *DistSummary
+		}
+	}{
+		{"pareto", &Pareto{Xm: 2, Alpha: 9}},
+		{"lomax", &Lomax{Alpha: 9, Lambda: 2}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			dist := tc.Dist
+			fmt.Println(dist.Summary())
+			moments := []struct {
+				Name     string
+				Actual   float64
+				Expected float64
+			}{
+				{"mean", expectation(dist, func(x float64) float64 { return x }, 100000), dist.Mean()},
+				{"median", dist.Quantile(.5), dist.Median()},
+				{"var", expectation(dist, func(x float64) float64 { return math.Pow(x-dist.Mean(), 2) }, 100000), dist.Var()},
+				{"skewness", expectation(dist, func(x float64) float64 { return math.Pow(x-dist.Mean(), 3) }, 100000) / math.Pow(dist.Var(), 1.5), dist.Skewness()},
+				{"entropy", expectation(dist, func(x float64) float64 { return -dist.LogPDF(x) }, 100000), dist.Entropy()},
+			}
+			for _, m := range moments {
+				if math.Abs(m.Actual-m.Expected) > 2e-2*math.Abs(m.Expected) {
+					t.Errorf("%s %f, numerically %f", m.Name, m.Expected, m.Actual)
+				}
+			}
+		})
+	}
+
+	// A Lomax variable is a Pareto variable shifted by its scale
+	p, l := &Pareto{Xm: 2, Alpha: 3}, &Lomax{Alpha: 3, Lambda: 2}
+	for _, x := range []float64{0, .5, 1, 10, 1000} {
+		if v, e := l.CDF(x), p.CDF(x+2); math.Abs(v-e) > 1e-14 {
+			t.Errorf("lomax: F(%g) = %g, expected %g", x, v, e)
+		}
+	}
+	if !math.IsInf((&Pareto{Xm: 1, Alpha: 1}).Mean(), 1) {
+		t.Errorf("expected infinite mean for α <= 1")
+	}
+	if err := p.Init(0, 1); err == nil {
+		t.Errorf("expected error for xm = 0")
+	}
+	if err := l.Init(1, -1); err == nil {
+		t.Errorf("expected error for λ < 0")
+	}
+}
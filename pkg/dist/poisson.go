@@ -1,82 +1,125 @@
 package dist
 
 import (
-	"fmt"
 	"math"
-	"math/big"
+	"math/cmplx"
 	"math/rand"
 
+	"github.com/ichbinfrog/statistics/pkg/array"
+	"github.com/ichbinfrog/statistics/pkg/specfun"
 	"github.com/ichbinfrog/statistics/pkg/util"
 )
 
 // Poisson represents the Poisson distribution
-// Continuous probability distribution function as follows:
+// Discreet probability distribution function as follows:
 // 		X ~ P(λ),  λ in ] 0, +inf [
 //		P(X = k) = (λ^k / k!)e^(-λ)
 //
 type Poisson struct {
+	source
 	Lambda float64
 }
 
-// Init intialises a Bernoulli distribution
+// Init intialises a Poisson distribution
 func (p *Poisson) Init(lambda float64) error {
-	if lambda < 0 {
+	if lambda <= 0 {
 		return util.ErrPoissonParam
 	}
 	p.Lambda = lambda
 	return nil
 }
 
-// Factorial computes the binomial coeff with the given n, k
+// Factorial returns n!, or math.MaxInt64 when it does not fit in an int64
+//
+// Deprecated: use specfun.Factorial for the exact value or specfun.LogFactorial.
 func Factorial(n int) int64 {
-	if n == 0 || n == 1 {
-		return 1
-	}
-	r1 := big.NewInt(1)
-	for i := 2; i <= n; i++ {
-		r1.Mul(r1, big.NewInt(int64(i)))
-	}
-	return r1.Int64()
+	return saturate(specfun.Factorial(n))
 }
 
 // Generate creates one sample of the Poisson distribution
 func (p *Poisson) Generate() float64 {
-	// PRESS, William H., TEUKOLSKY, Saul A., VETTERLING, William T., et al. Numerical recipes in C. 1988.
-	var em, t float64
+	return p.Rand(p.rng())
+}
+
+// GenerateN creates n samples of the Poisson distribution
+func (p *Poisson) GenerateN(n int) []float64 {
+	return GenerateN(p, p.rng(), n)
+}
+
+// Fill fills dst with samples of the Poisson distribution
+func (p *Poisson) Fill(dst []float64) {
+	Fill(p, p.rng(), dst)
+}
 
-	// Direct method
-	if p.Lambda < 12 {
-		em = -1
-		t = 1.0
+// poissonSampler holds the constants of the Poisson sampler which only
+// depend on the parameters of the distribution
+type poissonSampler struct {
+	lambda, expl float64
+	loglam, b, a float64
+	invalpha, vr float64
+}
+
+// newPoissonSampler precomputes the constants of the Poisson sampler
+func newPoissonSampler(p *Poisson) *poissonSampler {
+	s := &poissonSampler{lambda: p.Lambda, expl: math.Exp(-p.Lambda)}
+	s.loglam = math.Log(p.Lambda)
+	s.b = 0.931 + 2.53*math.Sqrt(p.Lambda)
+	s.a = -0.059 + 0.02483*s.b
+	s.invalpha = 1.1239 + 1.1328/(s.b-3.4)
+	s.vr = 0.9277 - 3.6224/(s.b-2)
+	return s
+}
+
+// sample creates one sample of the Poisson distribution
+// Algorithm:
+//		λ < 10 : multiplication of uniforms until the product falls below e^(-λ)
+//		otherwise : PTRS, transformed rejection with squeeze
+//			k = floor((2a / (0.5 - |U|) + b)U + λ + 0.43), U ~ U(-0.5, 0.5)
+//
+// HÖRMANN, Wolfgang. The transformed rejection method for generating Poisson random variables. 1993.
+// Complexity: O(λ) for λ < 10, O(1) otherwise
+//
+func (s *poissonSampler) sample(r *rand.Rand) float64 {
+	if s.lambda < 10 {
+		em := -1.0
+		t := 1.0
 		for {
-			t += rand.Float64()
-			if t >= p.Lambda {
-				break
-			}
 			em++
+			t *= r.Float64()
+			if t <= s.expl {
+				return em
+			}
 		}
-		return em
 	}
 
-	// Rejection method
-	sq := math.Sqrt(2.0 * p.Lambda)
-	alxm := math.Log(sq)
-	lg, _ := math.Lgamma(p.Lambda + 1.0)
-	g := p.Lambda*alxm - lg
-
 	for {
-		var y, em float64
-		for {
-			y = math.Tan(math.Pi * rand.Float64())
-			em = sq*y + p.Lambda
-			if em >= 0.0 {
-				break
-			}
+		u := r.Float64() - .5
+		v := r.Float64()
+		us := .5 - math.Abs(u)
+		k := math.Floor((2*s.a/us+s.b)*u + s.lambda + .43)
+		if us >= .07 && v <= s.vr {
+			return k
 		}
-		em = math.Floor(em)
-		if rand.Float64() <= 0.9*(1.0+math.Pow(y, 2))*math.Exp(em*alxm-lg-g) {
-			return em
+		if k < 0 || (us < .013 && v > us) {
+			continue
 		}
+		if math.Log(v)+math.Log(s.invalpha)-math.Log(s.a/(us*us)+s.b) <= -s.lambda+k*s.loglam-specfun.LogFactorial(k) {
+			return k
+		}
+	}
+}
+
+// Rand creates one sample of the Poisson distribution using the given generator
+func (p *Poisson) Rand(r *rand.Rand) float64 {
+	return newPoissonSampler(p).sample(r)
+}
+
+// fill fills dst with samples of the Poisson distribution, sharing the
+// constants of the sampler
+func (p *Poisson) fill(r *rand.Rand, dst []float64) {
+	s := newPoissonSampler(p)
+	for i := range dst {
+		dst[i] = s.sample(r)
 	}
 }
 
@@ -87,17 +130,54 @@ func (p *Poisson) Domain() (float64, float64) {
 
 // PMF returns the probability mass function value of a given k
 func (p *Poisson) PMF(k float64) float64 {
-	return math.Pow(p.Lambda, k) * math.Exp(-p.Lambda) / float64(Factorial(int(k)))
+	return math.Exp(p.LogPMF(k))
+}
+
+// LogPMF returns the log of the probability mass function value of a given k
+func (p *Poisson) LogPMF(k float64) float64 {
+	if k < 0 || k != math.Floor(k) {
+		return math.Inf(-1)
+	}
+	return k*math.Log(p.Lambda) - p.Lambda - specfun.LogFactorial(k)
 }
 
 // CDF returns the Cumulative distribution function value of a given k
 func (p *Poisson) CDF(k float64) float64 {
-	sum := 0.0
-	for i := 0; i < int(math.Floor(p.Lambda)); i++ {
-		sum += math.Pow(p.Lambda, float64(i)) / float64(Factorial(i))
+	if k < 0 {
+		return 0
+	}
+	return specfun.GammaIncRegComp(math.Floor(k)+1, p.Lambda)
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given k
+func (p *Poisson) LogCDF(k float64) float64 {
+	if k < 0 {
+		return math.Inf(-1)
 	}
-	sum *= math.Exp(-p.Lambda)
-	return sum
+	return specfun.LogGammaIncRegComp(math.Floor(k)+1, p.Lambda)
+}
+
+// Survival returns the survival function value of a given k
+func (p *Poisson) Survival(k float64) float64 {
+	if k < 0 {
+		return 1
+	}
+	return specfun.GammaIncReg(math.Floor(k)+1, p.Lambda)
+}
+
+// LogSurvival returns the log of the survival function value of a given k
+func (p *Poisson) LogSurvival(k float64) float64 {
+	if k < 0 {
+		return 0
+	}
+	return specfun.LogGammaIncReg(math.Floor(k)+1, p.Lambda)
+}
+
+// Quantile returns the p-th quantile of the distribution
+func (p *Poisson) Quantile(prob float64) float64 {
+	dbeg, dend := p.Domain()
+	sd := math.Sqrt(p.Lambda)
+	return discreteQuantile(p.CDF, prob, dbeg, dend, cornishFisher(p.Lambda, sd, 1/sd, prob))
 }
 
 // Mean returns the mean of the distribution
@@ -117,7 +197,7 @@ func (p *Poisson) Var() float64 {
 
 // Skewness returns the Pearson's moment coefficient of skewness of the distribution
 func (p *Poisson) Skewness() float64 {
-	return math.Pow(p.Lambda, -1/2)
+	return 1 / math.Sqrt(p.Lambda)
 }
 
 // Kurtosis returns the Kurtosis of the distribution
@@ -130,23 +210,73 @@ func (p *Poisson) Moment(t float64) float64 {
 	return math.Exp(p.Lambda * (math.Exp(t) - 1))
 }
 
+// cumulant returns the k-th cumulant κ_k = λ
+func (p *Poisson) cumulant(k int) float64 {
+	return p.Lambda
+}
+
+// cf returns the characteristic function of the distribution at t
+//		φ(t) = exp(λ(exp(it) - 1))
+//
+func (p *Poisson) cf(t float64) complex128 {
+	return cmplx.Exp(complex(p.Lambda, 0) * (expi(t) - 1))
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (p *Poisson) RawMoment(k int) float64 {
+	return RawMoment(p, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (p *Poisson) CentralMoment(k int) float64 {
+	return CentralMoment(p, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (p *Poisson) Cumulant(k int) float64 {
+	return Cumulant(p, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (p *Poisson) CF(t float64) complex128 {
+	return CF(p, t)
+}
+
 // FisherI returns the Fisher Information of the distribution
-func (p *Poisson) FisherI() float64 {
-	return 1 / p.Lambda
+func (p *Poisson) FisherI() [][]float64 {
+	return [][]float64{
+		[]float64{1 / p.Lambda},
+	}
 }
 
-// Summary returns a string summarising basic info about the distribution
-func (p *Poisson) Summary() string {
-	dbeg, dend := p.Domain()
-	return fmt.Sprintf(`
-	X ~ P(%f)
-		Domain:			[ %f , %f [
-		Mean: 			%f
-		Median(upper):	%f
-		Median(lower):	%f
-		Var: 			%f
-		Skewness: 		%f
-		Kurtosis:		%f
-		FisherInfo:		%f
-`, p.Lambda, dbeg, dend, p.Mean(), p.Median(true), p.Median(false), p.Var(), p.Skewness(), p.Kurtosis(), p.FisherI())
+// Summary returns the properties of the distribution
+func (p *Poisson) Summary() *DistSummary {
+	return summarise(p, "poisson", Param{"lambda", p.Lambda})
+}
+
+// FitPoisson returns the maximum likelihood estimation of a Poisson
+// distribution from the given observations:
+//		λ = E[X]
+//
+// Complexity: O(n)
+//
+func FitPoisson(a *array.Arrayf64) (*Poisson, *FitResult, error) {
+	if err := checkSample(a, 1, 0, math.Inf(0), true); err != nil {
+		return nil, nil, err
+	}
+	mean, _ := sampleMoments(a)
+
+	p := &Poisson{}
+	if err := p.Init(mean); err != nil {
+		return nil, nil, err
+	}
+	ll := a.Length * (xlogy(mean, p.Lambda) - p.Lambda)
+	for _, v := range a.Data {
+		ll -= specfun.LogFactorial(v)
+	}
+	return p, &FitResult{
+		LogLikelihood: ll,
+		StdErr:        fisherStdErr(p.FisherI(), a.Length),
+		Observations:  a.Length,
+	}, nil
 }
@@ -2,7 +2,11 @@ package dist
 
 import (
 	"fmt"
+	"math"
+	"math/rand"
 	"testing"
+
+	"github.com/ichbinfrog/statistics/pkg/array"
 )
 
 func TestPoisson(t *testing.T) {
@@ -37,3 +41,114 @@ func TestPoisson(t *testing.T) {
 		})
 	}
 }
+
+func TestPoissonRand(t *testing.T) {
+	for _, lambda := range []float64{.5, 4, 9.9, 10, 24, 150, 5000} {
+		t.Run(fmt.Sprint(lambda), func(t *testing.T) {
+			dist := &Poisson{}
+			dist.Init(lambda)
+			r := rand.New(rand.NewSource(5))
+			a := &array.Arrayf64{}
+			a.Init(array.Optionf64{})
+			a.InsertSlice(GenerateN(dist, r, 20000))
+			if _, p := ChiSquare(a, dist, 0); p < 1e-3 {
+				t.Errorf("samples do not follow P(%g), p-value %g", lambda, p)
+			}
+		})
+	}
+}
+
+// previousPoissonSampler is the Numerical Recipes sampler replaced by
+// PTRS, kept verbatim as a benchmark baseline. Its rejection step uses
+// log(√(2λ)) instead of log(λ) and log(λ!) instead of log(k!), so its
+// samples are biased for λ >= 12.
+type previousPoissonSampler struct {
+	lambda, expl    float64
+	sq, alxm, lg, g float64
+}
+
+func newPreviousPoissonSampler(p *Poisson) *previousPoissonSampler {
+	s := &previousPoissonSampler{lambda: p.Lambda, expl: math.Exp(-p.Lambda)}
+	s.sq = math.Sqrt(2.0 * p.Lambda)
+	s.alxm = math.Log(s.sq)
+	s.lg, _ = math.Lgamma(p.Lambda + 1.0)
+	s.g = p.Lambda*s.alxm - s.lg
+	return s
+}
+
+func (s *previousPoissonSampler) sample(r *rand.Rand) float64 {
+	// Direct method
+	if s.lambda < 12 {
+		em := -1.0
+		t := 1.0
+		for {
+			em++
+			t *= r.Float64()
+			if t <= s.expl {
+				return em
+			}
+		}
+	}
+
+	// Rejection method
+	for {
+		var y, em float64
+		for {
+			y = math.Tan(math.Pi * r.Float64())
+			em = s.sq*y + s.lambda
+			if em >= 0.0 {
+				break
+			}
+		}
+		em = math.Floor(em)
+		if r.Float64() <= 0.9*(1.0+math.Pow(y, 2))*math.Exp(em*s.alxm-s.lg-s.g) {
+			return em
+		}
+	}
+}
+
+func BenchmarkPoissonRand(b *testing.B) {
+	for _, lambda := range []float64{5, 30, 1000} {
+		dist := &Poisson{}
+		dist.Init(lambda)
+		dst := make([]float64, 1000)
+		b.Run(fmt.Sprintf("ptrs/λ=%g", lambda), func(b *testing.B) {
+			r := rand.New(rand.NewSource(1))
+			for i := 0; i < b.N; i++ {
+				dist.fill(r, dst)
+			}
+		})
+		b.Run(fmt.Sprintf("previous/λ=%g", lambda), func(b *testing.B) {
+			r := rand.New(rand.NewSource(1))
+			s := newPreviousPoissonSampler(dist)
+			for i := 0; i < b.N; i++ {
+				for j := range dst {
+					dst[j] = s.sample(r)
+				}
+			}
+		})
+	}
+}
+
+func TestPoissonSkewness(t *testing.T) {
+	for _, lambda := range []float64{.5, 4, 24} {
+		t.Run(fmt.Sprint(lambda), func(t *testing.T) {
+			dist := &Poisson{}
+			dist.Init(lambda)
+			mean, sd := dist.Mean(), math.Sqrt(dist.Var())
+			e := discreteSum(dist.PMF, func(k float64) float64 { return math.Pow((k-mean)/sd, 3) }, 0, math.Inf(1), 1)
+			if v := dist.Skewness(); math.Abs(v-e) > 1e-6 {
+				t.Errorf("skewness %f, expected %f", v, e)
+			}
+		})
+	}
+}
+
+func TestFactorial(t *testing.T) {
+	if v := Factorial(20); v != 2432902008176640000 {
+		t.Errorf("20! = %d", v)
+	}
+	if v := Factorial(21); v != math.MaxInt64 {
+		t.Errorf("21! = %d, expected saturation to %d", v, int64(math.MaxInt64))
+	}
+}
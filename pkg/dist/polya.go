@@ -1,40 +1,75 @@
 package dist
 
 import (
-	"fmt"
 	"math"
+	"math/cmplx"
 	"math/rand"
 
+	"github.com/ichbinfrog/statistics/pkg/array"
+	"github.com/ichbinfrog/statistics/pkg/specfun"
 	"github.com/ichbinfrog/statistics/pkg/util"
 )
 
 // Polya represents the Polya distribution
 // Discreet probability distribution function as follows:
-// 		X ~ NB(r, p), r > 0, 0 <= p <= 1
+// 		X ~ NB(r, p), r > 0, 0 <= p < 1
 //		f(k,p) = {
 //			C(k + r - 1, k)q^r * p^k
 //		}, k in [0, ..., n]
 //
 type Polya struct {
+	source
 	R, P, Q float64
 }
 
-// Init intialises a Bernoulli distribution
+// Init intialises a Polya distribution
 func (p *Polya) Init(r, prob float64) error {
-	if prob < 0 || prob > 1 || r <= 0 {
+	if prob < 0 || prob >= 1 || r <= 0 {
 		return util.ErrPolyaParam
 	}
 	p.R, p.P, p.Q = r, prob, 1-prob
 	return nil
 }
 
-// Generate creates one sample of a geometric distribution
+// Generate creates one sample of a Polya distribution
 func (p *Polya) Generate() float64 {
-	sum := 0.0
-	for i := 0.0; i < p.R; i++ {
-		sum += math.Floor(math.Log(rand.Float64()) / math.Log(p.Q))
+	return p.Rand(p.rng())
+}
+
+// GenerateN creates n samples of the Polya distribution
+func (p *Polya) GenerateN(n int) []float64 {
+	return GenerateN(p, p.rng(), n)
+}
+
+// Fill fills dst with samples of the Polya distribution
+func (p *Polya) Fill(dst []float64) {
+	Fill(p, p.rng(), dst)
+}
+
+// Rand creates one sample of a Polya distribution using the given generator
+// Algorithm: Gamma-Poisson mixture, valid for non integer r
+//		λ ~ Γ(r, q/p), X ~ Poisson(λ)
+//
+// Complexity: O(1) for the Gamma sample, O(min(λ, 10)) for the Poisson one
+//
+func (p *Polya) Rand(r *rand.Rand) float64 {
+	return p.mixed(r, newGammaSampler(p.R))
+}
+
+// fill fills dst with samples of the Polya distribution, sharing the
+// constants of the Gamma sampler
+func (p *Polya) fill(r *rand.Rand, dst []float64) {
+	s := newGammaSampler(p.R)
+	for i := range dst {
+		dst[i] = p.mixed(r, s)
 	}
-	return sum
+}
+
+// mixed returns a sample of the Poisson distribution of rate λ = p/q s,
+// s being a sample of the Gamma sampler of shape r
+func (p *Polya) mixed(r *rand.Rand, s *gammaSampler) float64 {
+	lambda := s.sample(r) * p.P / p.Q
+	return newPoissonSampler(&Poisson{Lambda: lambda}).sample(r)
 }
 
 // Domain returns the definition domain of the distribution
@@ -43,8 +78,59 @@ func (p *Polya) Domain() (float64, float64) {
 }
 
 // PMF returns the probability mass function value of a given k
-func (p *Polya) PMF(k int) float64 {
-	return float64(BinomialCoeff(k+int(p.R)-1, k)) * math.Pow(p.Q, p.R) * math.Pow(p.P, float64(k))
+func (p *Polya) PMF(k float64) float64 {
+	return math.Exp(p.LogPMF(k))
+}
+
+// LogPMF returns the log of the probability mass function value of a given k
+func (p *Polya) LogPMF(k float64) float64 {
+	if k < 0 || k != math.Floor(k) {
+		return math.Inf(-1)
+	}
+	return specfun.LogChoose(k+p.R-1, k) + p.R*math.Log(p.Q) + xlogy(k, p.P)
+}
+
+// CDF returns the Cumulative distribution function value of a given k
+func (p *Polya) CDF(k float64) float64 {
+	if k < 0 {
+		return 0
+	}
+	return specfun.RegIncBeta(p.R, math.Floor(k)+1, p.Q)
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given k
+func (p *Polya) LogCDF(k float64) float64 {
+	if k < 0 {
+		return math.Inf(-1)
+	}
+	return specfun.LogRegIncBeta(p.R, math.Floor(k)+1, p.Q)
+}
+
+// Survival returns the survival function value of a given k
+func (p *Polya) Survival(k float64) float64 {
+	if k < 0 {
+		return 1
+	}
+	return specfun.RegIncBeta(math.Floor(k)+1, p.R, p.P)
+}
+
+// LogSurvival returns the log of the survival function value of a given k
+func (p *Polya) LogSurvival(k float64) float64 {
+	if k < 0 {
+		return 0
+	}
+	k = math.Floor(k)
+	if v := p.Survival(k); v > 0 {
+		return math.Log(v)
+	}
+	return logDiscreteTail(p.LogPMF, k+1, 1, math.Inf(0))
+}
+
+// Quantile returns the p-th quantile of the distribution
+func (p *Polya) Quantile(prob float64) float64 {
+	dbeg, dend := p.Domain()
+	sd := math.Sqrt(p.Var())
+	return discreteQuantile(p.CDF, prob, dbeg, dend, cornishFisher(p.Mean(), sd, (1+p.P)/(p.Q*sd), prob))
 }
 
 // Mean returns the mean of the distribution
@@ -67,7 +153,7 @@ func (p *Polya) Var() float64 {
 
 // Skewness returns the Pearson's moment coefficient of skewness of the distribution
 func (p *Polya) Skewness() float64 {
-	return (1 - p.P) / math.Sqrt(p.P*p.R)
+	return (1 + p.P) / math.Sqrt(p.P*p.R)
 }
 
 // Kurtosis returns the Kurtosis of the distribution
@@ -83,23 +169,118 @@ func (p *Polya) Moment(t float64) float64 {
 	return math.NaN()
 }
 
+// cf returns the characteristic function of the distribution at t
+//		φ(t) = (q / (1 - p exp(it)))^r
+//
+func (p *Polya) cf(t float64) complex128 {
+	return cmplx.Pow(complex(p.Q, 0)/(1-complex(p.P, 0)*expi(t)), complex(p.R, 0))
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (p *Polya) RawMoment(k int) float64 {
+	return RawMoment(p, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (p *Polya) CentralMoment(k int) float64 {
+	return CentralMoment(p, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (p *Polya) Cumulant(k int) float64 {
+	return Cumulant(p, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (p *Polya) CF(t float64) complex128 {
+	return CF(p, t)
+}
+
 // FisherI returns the Fisher Information of the distribution
-func (p *Polya) FisherI() float64 {
-	return p.R / (p.P * math.Pow(p.Q, 2))
+// with regards to (r, p)
+func (p *Polya) FisherI() [][]float64 {
+	// E[ψ1(X + r)] is summed over the mass function (computed through
+	// its recurrence) until the remaining tail is negligible
+	e, pmf, cdf := 0.0, math.Pow(p.Q, p.R), 0.0
+	for k := 0.0; cdf < 1-1e-12 && k < 1e7; k++ {
+		e += pmf * specfun.Trigamma(k+p.R)
+		cdf += pmf
+		pmf *= p.P * (k + p.R) / (k + 1)
+	}
+	return [][]float64{
+		[]float64{specfun.Trigamma(p.R) - e, 1 / p.Q},
+		[]float64{1 / p.Q, p.R / (p.P * math.Pow(p.Q, 2))},
+	}
 }
 
-// Summary returns a string summarising basic info about the distribution
-func (p *Polya) Summary() string {
-	dbeg, dend := p.Domain()
-	return fmt.Sprintf(`
-	X ~ P(%f, %f)
-		Domain:			[ %f , %f [
-		Mean: 			%f
-		Median(upper):	%f
-		Median(lower):	%f
-		Var: 			%f
-		Skewness: 		%f
-		Kurtosis:		%f
-		FisherInfo:		%f
-`, p.P, p.R, dbeg, dend, p.Mean(), p.Median(true), p.Median(false), p.Var(), p.Skewness(), p.Kurtosis(), p.FisherI())
+// Summary returns the properties of the distribution
+func (p *Polya) Summary() *DistSummary {
+	return summarise(p, "polya", Param{"r", p.R}, Param{"p", p.P})
+}
+
+// FitPolya returns the maximum likelihood estimation of a Polya
+// distribution from the given overdispersed (Var[X] > E[X]) observations.
+// Algorithm:
+//		Solve the score equation of r with Brent's method
+//			Σ ψ(x_i + r) - nψ(r) + n log(r / (r + E[X])) = 0
+//		starting from the method of moments estimate E[X]^2 / (Var[X] - E[X])
+//		p = E[X] / (r + E[X])
+//
+// Since the array is sorted, each evaluation of the score only loops
+// over the distinct observations.
+// Complexity: O(n) + O(iterations * distinct values)
+//
+func FitPolya(a *array.Arrayf64) (*Polya, *FitResult, error) {
+	if err := checkSample(a, 2, 0, math.Inf(0), true); err != nil {
+		return nil, nil, err
+	}
+	mean, variance := sampleMoments(a)
+	if variance <= mean {
+		return nil, nil, util.ErrFitConvergence
+	}
+
+	values, counts := []float64{}, []float64{}
+	for _, v := range a.Data {
+		if n := len(values); n > 0 && values[n-1] == v {
+			counts[n-1]++
+			continue
+		}
+		values = append(values, v)
+		counts = append(counts, 1)
+	}
+	score := func(r float64) float64 {
+		s := a.Length * (math.Log(r/(r+mean)) - specfun.Digamma(r))
+		for i, v := range values {
+			s += counts[i] * specfun.Digamma(v+r)
+		}
+		return s
+	}
+
+	r0 := math.Pow(mean, 2) / (variance - mean)
+	lo, hi := r0, r0
+	for score(lo) < 0 {
+		if lo /= 2; lo < 1e-10 {
+			return nil, nil, util.ErrFitConvergence
+		}
+	}
+	for score(hi) > 0 {
+		if hi *= 2; hi > 1e10 {
+			return nil, nil, util.ErrFitConvergence
+		}
+	}
+	r := brent(score, lo, hi)
+
+	p := &Polya{}
+	if err := p.Init(r, mean/(r+mean)); err != nil {
+		return nil, nil, err
+	}
+	ll := a.Length * (r*math.Log(p.Q) + xlogy(mean, p.P))
+	for i, v := range values {
+		ll += counts[i] * specfun.LogChoose(v+r-1, v)
+	}
+	return p, &FitResult{
+		LogLikelihood: ll,
+		StdErr:        fisherStdErr(p.FisherI(), a.Length),
+		Observations:  a.Length,
+	}, nil
 }
@@ -2,7 +2,10 @@ package dist
 
 import (
 	"fmt"
+	"math"
 	"testing"
+
+	"github.com/ichbinfrog/statistics/pkg/util"
 )
 
 func TestPolya(t *testing.T) {
@@ -21,3 +24,64 @@ func TestPolya(t *testing.T) {
 	}
 	fmt.Printf("\n	Generated slice: %v\n\n", sl)
 }
+
+func TestPolyaSkewness(t *testing.T) {
+	for _, tc := range []struct {
+		r, p float64
+	}{
+		{3, .4},
+		{.5, .8},
+		{12, .1},
+	} {
+		t.Run(fmt.Sprint(tc.r, tc.p), func(t *testing.T) {
+			dist := &Polya{}
+			dist.Init(tc.r, tc.p)
+			mean, sd := dist.Mean(), math.Sqrt(dist.Var())
+			e := discreteSum(dist.PMF, func(k float64) float64 { return math.Pow((k-mean)/sd, 3) }, 0, math.Inf(1), 1)
+			if v := dist.Skewness(); math.Abs(v-e) > 1e-6 {
+				t.Errorf("skewness %f, expected %f", v, e)
+			}
+		})
+	}
+}
+
+func TestPolyaRand(t *testing.T) {
+	for _, tc := range []struct {
+		r, p float64
+	}{
+		{2.5, .3},
+		{.4, .8},
+		{7.3, .55},
+		{5, .3},
+	} {
+		t.Run(fmt.Sprint(tc.r, tc.p), func(t *testing.T) {
+			dist := &Polya{}
+			dist.Init(tc.r, tc.p)
+			n := 20000
+			a := powerSums(dist, n, 5, 2)
+			for k := 1; k <= 2; k++ {
+				m := RawMoment(dist, k)
+				se := math.Sqrt((RawMoment(dist, 2*k) - m*m) / float64(n))
+				if v := a.Sum[k-1] / float64(n); math.Abs(v-m) > 5*se {
+					t.Errorf("Σ x^%d / n = %f, expected %f ± %f", k, v, m, 5*se)
+				}
+			}
+			if _, p := ChiSquare(a, dist, 0); p < 1e-3 {
+				t.Errorf("samples do not follow NB(%g, %g), p-value %g", tc.r, tc.p, p)
+			}
+		})
+	}
+}
+
+func TestPolyaInit(t *testing.T) {
+	dist := &Polya{}
+	if err := dist.Init(5, 0); err != nil {
+		t.Errorf("expected a degenerate distribution at 0, got %v", err)
+	}
+	// p = 1 leaves no failure to stop at, the mean r p / q is infinite
+	for _, c := range [][]float64{{5, 1}, {5, 1.2}, {5, -.1}, {0, .5}} {
+		if err := dist.Init(c[0], c[1]); err != util.ErrPolyaParam {
+			t.Errorf("r = %g, p = %g: expected %v, got %v", c[0], c[1], util.ErrPolyaParam, err)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package dist
+
+import (
+	"math"
+
+	"github.com/ichbinfrog/statistics/pkg/specfun"
+)
+
+const (
+	// quantileFuzz loosens the p >= CDF(k) comparison of discrete
+	// quantiles so that Quantile(CDF(k)) == k despite rounding errors
+	quantileFuzz = 1 - 64*epsilon
+	epsilon      = 2.220446049250313e-16
+	maxIter      = 1000
+)
+
+// validProbability returns true when p can be inverted by a quantile function
+func validProbability(p float64) bool {
+	return p >= 0 && p <= 1
+}
+
+// cornishFisher returns the Cornish-Fisher approximation of the p-th
+// quantile of a distribution from its first three moments. It is used
+// as a starting point for the numerical inversions.
+func cornishFisher(mean, stddev, skewness, p float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	z := specfun.NormalQuantile(p)
+	return mean + stddev*(z+skewness*(z*z-1)/6)
+}
+
+// continuousQuantile inverts a continuous cdf defined on [lo, hi].
+// Algorithm:
+//	Start from the initial guess x0 and walk away from it with a step
+//	doubling at each iteration (starting at scale) until the p-th
+//	quantile is bracketed, then refine the root of cdf(x) - p with
+//	Brent's method.
+//
+func continuousQuantile(cdf func(float64) float64, p, lo, hi, x0, scale float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	if p == 0 {
+		return lo
+	}
+	if p == 1 {
+		return hi
+	}
+	if math.IsNaN(x0) || math.IsInf(x0, 0) {
+		x0 = 0
+	}
+	x0 = math.Max(lo, math.Min(hi, x0))
+	if !(scale > 0) || math.IsInf(scale, 0) {
+		scale = 1
+	}
+
+	a, b := x0, x0
+	if cdf(x0) < p {
+		for step := scale; cdf(b) < p; step *= 2 {
+			if b == hi || math.IsInf(step, 0) {
+				return hi
+			}
+			a, b = b, math.Min(hi, b+step)
+		}
+	} else {
+		for step := scale; cdf(a) >= p; step *= 2 {
+			if a == lo || math.IsInf(step, 0) {
+				return lo
+			}
+			a, b = math.Max(lo, a-step), a
+		}
+	}
+	return brent(func(x float64) float64 { return cdf(x) - p }, a, b)
+}
+
+// brent finds the root of f within [a, b] where f(a) and f(b) have
+// opposite signs.
+// BRENT, Richard P. Algorithms for minimization without derivatives. 1973.
+func brent(f func(float64) float64, a, b float64) float64 {
+	fa, fb := f(a), f(b)
+	c, fc := b, fb
+	var d, e float64
+
+	for i := 0; i < maxIter; i++ {
+		if (fb > 0 && fc > 0) || (fb < 0 && fc < 0) {
+			c, fc = a, fa
+			d = b - a
+			e = d
+		}
+		if math.Abs(fc) < math.Abs(fb) {
+			a, b, c = b, c, b
+			fa, fb, fc = fb, fc, fb
+		}
+
+		tol := 2*epsilon*math.Abs(b) + math.SmallestNonzeroFloat64
+		xm := (c - b) / 2
+		if math.Abs(xm) <= tol || fb == 0 {
+			return b
+		}
+
+		if math.Abs(e) >= tol && math.Abs(fa) > math.Abs(fb) {
+			// Inverse quadratic interpolation or secant step
+			var p, q float64
+			s := fb / fa
+			if a == c {
+				p = 2 * xm * s
+				q = 1 - s
+			} else {
+				q = fa / fc
+				r := fb / fc
+				p = s * (2*xm*q*(q-r) - (b-a)*(r-1))
+				q = (q - 1) * (r - 1) * (s - 1)
+			}
+			if p > 0 {
+				q = -q
+			}
+			p = math.Abs(p)
+			if 2*p < math.Min(3*xm*q-math.Abs(tol*q), math.Abs(e*q)) {
+				e = d
+				d = p / q
+			} else {
+				d = xm
+				e = d
+			}
+		} else {
+			// Bisection step
+			d = xm
+			e = d
+		}
+
+		a, fa = b, fb
+		if math.Abs(d) > tol {
+			b += d
+		} else {
+			b += math.Copysign(tol, xm)
+		}
+		fb = f(b)
+	}
+	return b
+}
+
+// discreteQuantile returns the smallest integer k in [lo, hi] such that
+// cdf(k) >= p, starting the search from the initial guess x0.
+// Algorithm:
+//	Walk up (or down) from x0 with a step doubling at each iteration
+//	until the step of the cdf crossing p is bracketed, then bisect
+//	the bracket over the integers.
+//
+func discreteQuantile(cdf func(float64) float64, p, lo, hi, x0 float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	if p == 0 {
+		return lo
+	}
+	if p == 1 {
+		return hi
+	}
+	p *= quantileFuzz
+	if math.IsNaN(x0) {
+		x0 = lo
+	}
+	k := math.Max(lo, math.Min(hi, math.Floor(x0)))
+
+	// Invariant once bracketed: cdf(a) < p <= cdf(b)
+	var a, b float64
+	if cdf(k) >= p {
+		b = k
+		for step := 1.0; ; step *= 2 {
+			if b == lo {
+				return lo
+			}
+			a = math.Max(lo, b-step)
+			if cdf(a) < p {
+				break
+			}
+			b = a
+		}
+	} else {
+		a = k
+		for step := 1.0; ; step *= 2 {
+			if a == hi {
+				return hi
+			}
+			b = math.Min(hi, a+step)
+			if cdf(b) >= p {
+				break
+			}
+			a = b
+		}
+	}
+
+	for b-a > 1 {
+		mid := math.Floor(a + (b-a)/2)
+		if cdf(mid) < p {
+			a = mid
+		} else {
+			b = mid
+		}
+	}
+	return b
+}
@@ -0,0 +1,91 @@
+package dist
+
+import (
+	"math"
+	"testing"
+)
+
+func TestContinuousQuantile(t *testing.T) {
+	testCases := []struct {
+		Name string
+		Dist Continuous
+	}{
+		{"normal", &Normal{Mu: 3, Sigma: 2}},
+		{"gamma", &Gamma{Alpha: .5, Beta: 10}},
+		{"chisq", &Chisq{Degree: 5}},
+		{"exponential", &Exponential{Lambda: 10}},
+		{"triangular", &Triangular{A: 1, B: 3, C: 2}},
+		{"uniform", &Uniform{A: -1, B: 10}},
+		{"studentt", &StudentT{Nu: 3.5}},
+		{"fisherf", &FisherF{D1: 3, D2: 7}},
+		{"lognormal", &LogNormal{Mu: 1, Sigma: .5}},
+		{"weibull", &Weibull{K: 1.5, Lambda: 2, Theta: 1}},
+		{"pareto", &Pareto{Xm: 1, Alpha: 3}},
+		{"lomax", &Lomax{Alpha: 3, Lambda: 2}},
+		{"cauchy", &Cauchy{Mu: 1, Sigma: 2}},
+		{"laplace", &Laplace{Mu: 1, Sigma: 2}},
+		{"logistic", &Logistic{Mu: 1, Sigma: 2}},
+		{"gumbel", &Gumbel{Mu: 1, Sigma: 2}},
+		{"gumbelmin", &GumbelMin{Mu: 1, Sigma: 2}},
+		{"noncentralt", &NoncentralT{Nu: 5, Delta: 1.5}},
+		{"beta", &Beta{Alpha: .5, Beta: 4}},
+	}
+
+	probs := []float64{1e-10, .001, .1, .25, .5, .75, .9, .999, 1 - 1e-10}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			for _, p := range probs {
+				if q := tc.Dist.CDF(tc.Dist.Quantile(p)); math.Abs(q-p) > 1e-9 {
+					t.Errorf("F(Q(%g)) = %g", p, q)
+				}
+
+				// Generic inversion of the cdf starting from a poor guess
+				dbeg, dend := tc.Dist.Domain()
+				x := continuousQuantile(tc.Dist.CDF, p, dbeg, dend, 0, 1e-3)
+				if q := tc.Dist.CDF(x); math.Abs(q-p) > 1e-9 {
+					t.Errorf("F(continuousQuantile(%g)) = %g", p, q)
+				}
+			}
+			if q := tc.Dist.Quantile(1.5); !math.IsNaN(q) {
+				t.Errorf("Q(1.5) = %g, expected NaN", q)
+			}
+		})
+	}
+}
+
+func TestDiscreteQuantile(t *testing.T) {
+	testCases := []struct {
+		Name string
+		Dist Discrete
+	}{
+		{"bernoulli", &Bernoulli{P: .3, Q: .7}},
+		{"binomial", &Binomial{N: 100, P: .4, Q: .6}},
+		{"geometric", &Geometric{P: .3, Q: .7}},
+		{"poisson", &Poisson{Lambda: 24}},
+		{"polya", &Polya{R: 5, P: .3, Q: .7}},
+		{"hypergeometric", &Hypergeometric{Population: 500, Successes: 200, Draws: 100}},
+		{"discreteuniform", &DiscreteUniform{A: -3, B: 7}},
+		{"categorical", categorical([]float64{1, 5, 3, 2, 2, .5, 1})},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			dbeg, _ := tc.Dist.Domain()
+			for k := dbeg; k < dbeg+60; k++ {
+				p := tc.Dist.CDF(k)
+				if p > 1-1e-6 {
+					break
+				}
+				if q := tc.Dist.Quantile(p); q != k {
+					t.Errorf("Q(F(%g)) = %g", k, q)
+				}
+				if q := tc.Dist.Quantile(p * (1 + 1e-9)); q != k+1 {
+					t.Errorf("Q(F(%g) + ε) = %g", k, q)
+				}
+			}
+			if q := tc.Dist.Quantile(0); q != dbeg {
+				t.Errorf("Q(0) = %g, expected %g", q, dbeg)
+			}
+		})
+	}
+}
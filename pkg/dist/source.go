@@ -0,0 +1,54 @@
+package dist
+
+import (
+	"math/rand"
+)
+
+// globalRand draws its values from the top-level math/rand functions
+// so that distributions without an explicit source keep sharing the
+// default (locked) generator.
+var globalRand = rand.New(globalSource{})
+
+type globalSource struct{}
+
+func (globalSource) Int63() int64 {
+	return rand.Int63()
+}
+
+func (globalSource) Seed(seed int64) {
+	rand.Seed(seed)
+}
+
+// source holds the random number generator used by a distribution to
+// create samples. It is embedded in every distribution of the package.
+// A *rand.Rand is not safe for concurrent use, independent goroutines
+// should each sample from a distribution with its own source, or call
+// Rand with their own generator.
+type source struct {
+	rnd *rand.Rand
+}
+
+// SetSource replaces the random source used by Generate. Two sources
+// created with the same seed yield identical samples, whereas one source
+// shared by two distributions interleaves their draws. A nil source
+// restores the global math/rand generator.
+func (s *source) SetSource(src rand.Source) {
+	if src == nil {
+		s.rnd = nil
+		return
+	}
+	s.rnd = rand.New(src)
+}
+
+// SetRand makes Generate draw its values from r
+func (s *source) SetRand(r *rand.Rand) {
+	s.rnd = r
+}
+
+// rng returns the generator used by Generate
+func (s *source) rng() *rand.Rand {
+	if s.rnd == nil {
+		return globalRand
+	}
+	return s.rnd
+}
@@ -0,0 +1,99 @@
+package dist
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+func TestSource(t *testing.T) {
+	testCases := []struct {
+		Name string
+		New  func() Distribution
+	}{
+		{"bernoulli", func() Distribution { d := &Bernoulli{}; d.Init(.3); return d }},
+		{"binomial", func() Distribution { d := &Binomial{}; d.Init(100, .4); return d }},
+		{"chisq", func() Distribution { return &Chisq{Degree: 5} }},
+		{"exponential", func() Distribution { d := &Exponential{}; d.Init(2); return d }},
+		{"gamma", func() Distribution { d := &Gamma{}; d.Init(5, 10); return d }},
+		{"geometric", func() Distribution { d := &Geometric{}; d.Init(.3); return d }},
+		{"normal", func() Distribution { d := &Normal{}; d.Init(0, 1); return d }},
+		{"poisson", func() Distribution { d := &Poisson{}; d.Init(24); return d }},
+		{"polya", func() Distribution { d := &Polya{}; d.Init(5, .3); return d }},
+		{"triangular", func() Distribution { d := &Triangular{}; d.Init(1, 3, 2); return d }},
+		{"uniform", func() Distribution { d := &Uniform{}; d.Init(0, 10); return d }},
+		{"studentt", func() Distribution { d := &StudentT{}; d.Init(5); return d }},
+		{"fisherf", func() Distribution { d := &FisherF{}; d.Init(3, 7); return d }},
+		{"beta", func() Distribution { d := &Beta{}; d.Init(2, 5); return d }},
+		{"lognormal", func() Distribution { d := &LogNormal{}; d.Init(1, .5); return d }},
+		{"weibull", func() Distribution { d := &Weibull{}; d.Init(1.5, 2, 1); return d }},
+		{"pareto", func() Distribution { d := &Pareto{}; d.Init(1, 3); return d }},
+		{"hypergeometric", func() Distribution { d := &Hypergeometric{}; d.Init(50, 20, 10); return d }},
+		{"discreteuniform", func() Distribution { d := &DiscreteUniform{}; d.Init(-3, 7); return d }},
+		{"categorical", func() Distribution { d := &Categorical{}; d.Init([]float64{1, 5, 0, 2, 2}); return d }},
+		{"lomax", func() Distribution { d := &Lomax{}; d.Init(3, 2); return d }},
+		{"betabinomial", func() Distribution { d := &BetaBinomial{}; d.Init(30, 2.5, 4); return d }},
+		{"locationscalet", func() Distribution { d := &LocationScaleT{}; d.Init(6, 2, 3); return d }},
+		{"cauchy", func() Distribution { d := &Cauchy{}; d.Init(1, 2); return d }},
+		{"laplace", func() Distribution { d := &Laplace{}; d.Init(1, 2); return d }},
+		{"logistic", func() Distribution { d := &Logistic{}; d.Init(1, 2); return d }},
+		{"gumbel", func() Distribution { d := &Gumbel{}; d.Init(1, 2); return d }},
+		{"gumbelmin", func() Distribution { d := &GumbelMin{}; d.Init(1, 2); return d }},
+		{"noncentralt", func() Distribution { d := &NoncentralT{}; d.Init(5, 1.5); return d }},
+		{"mixture", func() Distribution {
+			d := &Mixture{}
+			d.Init([]Distribution{&Normal{Mu: 0, Sigma: 1}, &Gamma{Alpha: 2.5, Beta: 1}}, []float64{1, 2})
+			return d
+		}},
+		{"truncated", func() Distribution { d := &Truncated{}; d.Init(&Normal{Mu: 0, Sigma: 1}, 1, 3); return d }},
+		{"affine", func() Distribution { d := &Affine{}; d.Init(&Poisson{Lambda: 3}, -2, 1); return d }},
+		{"maximum", func() Distribution { d := &Maximum{}; d.Init(&Normal{Mu: 0, Sigma: 1}, 5); return d }},
+		{"minimum", func() Distribution { d := &Minimum{}; d.Init(&Exponential{Lambda: 1}, 3); return d }},
+		{"empirical", func() Distribution { d := &Empirical{}; d.Init(sample(&Normal{Mu: 0, Sigma: 1}, 100, 1)); return d }},
+		{"kde", func() Distribution {
+			d := &KDE{}
+			d.Init(sample(&Normal{Mu: 0, Sigma: 1}, 100, 1), EpanechnikovKernel, SilvermanBandwidth)
+			return d
+		}},
+		{"censored", func() Distribution { d := &Censored{}; d.Init(&Gamma{Alpha: 2, Beta: 1}, .5, 3); return d }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			a, b := tc.New(), tc.New()
+			a.(interface{ SetSource(rand.Source) }).SetSource(rand.NewSource(42))
+			b.(interface{ SetSource(rand.Source) }).SetSource(rand.NewSource(42))
+			r := rand.New(rand.NewSource(42))
+			c := tc.New()
+
+			for i := 0; i < 100; i++ {
+				x, y, z := a.Generate(), b.Generate(), c.Rand(r)
+				if x != y || x != z {
+					t.Fatalf("sample %d differs for the same seed: %f, %f, %f", i, x, y, z)
+				}
+			}
+		})
+	}
+
+	// Independent generators can sample the same distribution concurrently
+	d := &Normal{}
+	d.Init(0, 1)
+	var wg sync.WaitGroup
+	res := make([]float64, 4)
+	for i := range res {
+		wg.Add(1)
+		go func(i int) {
+			r := rand.New(rand.NewSource(7))
+			for j := 0; j < 1000; j++ {
+				res[i] = d.Rand(r)
+			}
+			wg.Done()
+		}(i)
+	}
+	wg.Wait()
+	for i := range res {
+		if res[i] != res[0] {
+			t.Errorf("goroutine %d drew %f, expected %f", i, res[i], res[0])
+		}
+	}
+}
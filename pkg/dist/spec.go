@@ -0,0 +1,432 @@
+package dist
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// Constructor returns an initialised distribution from its parameters,
+// given in the order of their registration
+type Constructor func(params []float64) (Distribution, error)
+
+// family associates the parameters of a family of distribution to its
+// constructor, a NaN default marking a required parameter
+type family struct {
+	Params   []string
+	Defaults []float64
+	New      Constructor
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]*family{}
+)
+
+// Register adds a family of distribution to the registry used by ParseSpec,
+// replacing the family of the same name if any. Every parameter is
+// required unless given a default value, defaults matching the last
+// parameters. The parameter names should match the ones of the Summary
+// of the distribution for SpecOf to describe it.
+func Register(name string, params []string, constructor Constructor, defaults ...float64) {
+	f := &family{
+		Params:   params,
+		Defaults: make([]float64, len(params)),
+		New:      constructor,
+	}
+	for i := range f.Defaults {
+		f.Defaults[i] = math.NaN()
+		if j := i - len(params) + len(defaults); j >= 0 {
+			f.Defaults[i] = defaults[j]
+		}
+	}
+
+	registryMu.Lock()
+	registry[name] = f
+	registryMu.Unlock()
+}
+
+// Families returns the sorted names of the registered families
+func Families() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	res := make([]string, 0, len(registry))
+	for name := range registry {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// lookup returns the registered family of the given name
+func lookup(name string) (*family, error) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	f, ok := registry[name]
+	if !ok {
+		return nil, util.ErrSpecFamily
+	}
+	return f, nil
+}
+
+func init() {
+	Register("bernoulli", []string{"p"}, func(p []float64) (Distribution, error) {
+		d := &Bernoulli{}
+		return d, d.Init(p[0])
+	})
+	Register("beta", []string{"alpha", "beta"}, func(p []float64) (Distribution, error) {
+		d := &Beta{}
+		return d, d.Init(p[0], p[1])
+	})
+	Register("betabinomial", []string{"n", "alpha", "beta"}, func(p []float64) (Distribution, error) {
+		d := &BetaBinomial{}
+		return d, d.Init(p[0], p[1], p[2])
+	})
+	Register("binomial", []string{"n", "p"}, func(p []float64) (Distribution, error) {
+		d := &Binomial{}
+		return d, d.Init(p[0], p[1])
+	})
+	Register("cauchy", []string{"mu", "sigma"}, func(p []float64) (Distribution, error) {
+		d := &Cauchy{}
+		return d, d.Init(p[0], p[1])
+	})
+	Register("chisq", []string{"degree"}, func(p []float64) (Distribution, error) {
+		d := &Chisq{}
+		return d, d.Init(p[0])
+	})
+	Register("discreteuniform", []string{"a", "b"}, func(p []float64) (Distribution, error) {
+		d := &DiscreteUniform{}
+		return d, d.Init(p[0], p[1])
+	})
+	Register("exponential", []string{"lambda"}, func(p []float64) (Distribution, error) {
+		d := &Exponential{}
+		return d, d.Init(p[0])
+	})
+	Register("fisherf", []string{"d1", "d2"}, func(p []float64) (Distribution, error) {
+		d := &FisherF{}
+		return d, d.Init(p[0], p[1])
+	})
+	Register("gamma", []string{"alpha", "beta"}, func(p []float64) (Distribution, error) {
+		d := &Gamma{}
+		return d, d.Init(p[0], p[1])
+	})
+	Register("geometric", []string{"p"}, func(p []float64) (Distribution, error) {
+		d := &Geometric{}
+		return d, d.Init(p[0])
+	})
+	Register("gumbel", []string{"mu", "sigma"}, func(p []float64) (Distribution, error) {
+		d := &Gumbel{}
+		return d, d.Init(p[0], p[1])
+	})
+	Register("gumbelmin", []string{"mu", "sigma"}, func(p []float64) (Distribution, error) {
+		d := &GumbelMin{}
+		return d, d.Init(p[0], p[1])
+	})
+	Register("hypergeometric", []string{"population", "successes", "draws"}, func(p []float64) (Distribution, error) {
+		d := &Hypergeometric{}
+		return d, d.Init(p[0], p[1], p[2])
+	})
+	Register("laplace", []string{"mu", "sigma"}, func(p []float64) (Distribution, error) {
+		d := &Laplace{}
+		return d, d.Init(p[0], p[1])
+	})
+	Register("logistic", []string{"mu", "sigma"}, func(p []float64) (Distribution, error) {
+		d := &Logistic{}
+		return d, d.Init(p[0], p[1])
+	})
+	Register("lognormal", []string{"mu", "sigma"}, func(p []float64) (Distribution, error) {
+		d := &LogNormal{}
+		return d, d.Init(p[0], p[1])
+	})
+	Register("locationscalet", []string{"nu", "mu", "sigma"}, func(p []float64) (Distribution, error) {
+		d := &LocationScaleT{}
+		return d, d.Init(p[0], p[1], p[2])
+	})
+	Register("lomax", []string{"alpha", "lambda"}, func(p []float64) (Distribution, error) {
+		d := &Lomax{}
+		return d, d.Init(p[0], p[1])
+	})
+	Register("noncentralt", []string{"nu", "delta"}, func(p []float64) (Distribution, error) {
+		d := &NoncentralT{}
+		return d, d.Init(p[0], p[1])
+	})
+	Register("normal", []string{"mu", "sigma"}, func(p []float64) (Distribution, error) {
+		d := &Normal{}
+		return d, d.Init(p[0], p[1])
+	})
+	Register("pareto", []string{"xm", "alpha"}, func(p []float64) (Distribution, error) {
+		d := &Pareto{}
+		return d, d.Init(p[0], p[1])
+	})
+	Register("poisson", []string{"lambda"}, func(p []float64) (Distribution, error) {
+		d := &Poisson{}
+		return d, d.Init(p[0])
+	})
+	Register("polya", []string{"r", "p"}, func(p []float64) (Distribution, error) {
+		d := &Polya{}
+		return d, d.Init(p[0], p[1])
+	})
+	Register("studentt", []string{"nu"}, func(p []float64) (Distribution, error) {
+		d := &StudentT{}
+		return d, d.Init(p[0])
+	})
+	Register("triangular", []string{"a", "b", "c"}, func(p []float64) (Distribution, error) {
+		d := &Triangular{}
+		return d, d.Init(p[0], p[1], p[2])
+	})
+	Register("uniform", []string{"a", "b"}, func(p []float64) (Distribution, error) {
+		d := &Uniform{}
+		return d, d.Init(p[0], p[1])
+	})
+	Register("weibull", []string{"k", "lambda", "theta"}, func(p []float64) (Distribution, error) {
+		d := &Weibull{}
+		return d, d.Init(p[0], p[1], p[2])
+	}, 0)
+}
+
+// Spec is the declarative specification of a distribution, a registered
+// family and its parameters. Its canonical forms are
+//		text:	normal(mu=0, sigma=1)
+//		JSON:	{"family":"normal","mu":0,"sigma":1}
+// the parameters being listed in the order of their registration.
+//
+type Spec struct {
+	Family string
+	Params []Param
+}
+
+// ParseSpec parses the text or JSON specification of a distribution.
+// Text parameters are either named or positional, positional ones coming
+// first, so that gamma(2, 0.5), gamma(2, beta=0.5) and
+// gamma(beta=0.5, alpha=2) are the same specification. Parameters left
+// out take their default value. Values other than NaN and infinities are
+// not validated until the distribution is built by New.
+//
+func ParseSpec(s string) (*Spec, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "{") {
+		res := &Spec{}
+		if err := res.UnmarshalJSON([]byte(s)); err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	open := strings.IndexByte(s, '(')
+	if open <= 0 || !strings.HasSuffix(s, ")") {
+		return nil, util.ErrSpecSyntax
+	}
+	name := strings.TrimSpace(s[:open])
+	for _, c := range name {
+		if !unicode.IsLetter(c) && !unicode.IsDigit(c) && c != '_' {
+			return nil, util.ErrSpecSyntax
+		}
+	}
+
+	args := strings.TrimSpace(s[open+1 : len(s)-1])
+	named := map[string]float64{}
+	positional := []float64{}
+	if args != "" {
+		for _, arg := range strings.Split(args, ",") {
+			key, value := "", arg
+			if eq := strings.IndexByte(arg, '='); eq >= 0 {
+				key, value = strings.TrimSpace(arg[:eq]), arg[eq+1:]
+			}
+			v, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				return nil, util.ErrSpecSyntax
+			}
+			if key == "" {
+				if len(named) > 0 {
+					return nil, util.ErrSpecSyntax
+				}
+				positional = append(positional, v)
+				continue
+			}
+			if _, ok := named[key]; ok {
+				return nil, util.ErrSpecParam
+			}
+			named[key] = v
+		}
+	}
+	return newSpec(name, positional, named)
+}
+
+// newSpec returns the canonical specification of the family from its
+// positional and named parameters, which must be finite
+func newSpec(name string, positional []float64, named map[string]float64) (*Spec, error) {
+	f, err := lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	if len(positional) > len(f.Params) {
+		return nil, util.ErrSpecParam
+	}
+
+	res := &Spec{Family: name, Params: make([]Param, len(f.Params))}
+	for i, p := range f.Params {
+		v, ok := named[p]
+		switch {
+		case i < len(positional):
+			if ok {
+				return nil, util.ErrSpecParam
+			}
+			v = positional[i]
+		case !ok:
+			if math.IsNaN(f.Defaults[i]) {
+				return nil, util.ErrSpecParam
+			}
+			v = f.Defaults[i]
+		}
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, util.ErrSpecParam
+		}
+		delete(named, p)
+		res.Params[i] = Param{p, v}
+	}
+	if len(named) > 0 {
+		return nil, util.ErrSpecParam
+	}
+	return res, nil
+}
+
+// SpecOf returns the specification of a distribution of a registered family
+func SpecOf(d Distribution) (*Spec, error) {
+	s := summaryOf(d)
+	named := make(map[string]float64, len(s.Params))
+	for _, p := range s.Params {
+		named[p.Name] = p.Value
+	}
+	return newSpec(s.Family, nil, named)
+}
+
+// New returns the distribution described by the specification, validated
+// by the Init method of the family
+func (s *Spec) New() (Distribution, error) {
+	named := make(map[string]float64, len(s.Params))
+	for _, p := range s.Params {
+		if _, ok := named[p.Name]; ok {
+			return nil, util.ErrSpecParam
+		}
+		named[p.Name] = p.Value
+	}
+	canonical, err := newSpec(s.Family, nil, named)
+	if err != nil {
+		return nil, err
+	}
+	f, err := lookup(s.Family)
+	if err != nil {
+		return nil, err
+	}
+
+	params := make([]float64, len(canonical.Params))
+	for i, p := range canonical.Params {
+		params[i] = p.Value
+	}
+	d, err := f.New(params)
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Parse returns the distribution described by the text or JSON specification
+func Parse(s string) (Distribution, error) {
+	spec, err := ParseSpec(s)
+	if err != nil {
+		return nil, err
+	}
+	return spec.New()
+}
+
+// String returns the canonical text form of the specification
+func (s *Spec) String() string {
+	params := make([]string, len(s.Params))
+	for i, p := range s.Params {
+		params[i] = p.Name + "=" + strconv.FormatFloat(p.Value, 'g', -1, 64)
+	}
+	return s.Family + "(" + strings.Join(params, ", ") + ")"
+}
+
+// MarshalText implements encoding.TextMarshaler with the canonical text form
+func (s *Spec) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the text and
+// JSON forms
+func (s *Spec) UnmarshalText(data []byte) error {
+	res, err := ParseSpec(string(data))
+	if err != nil {
+		return err
+	}
+	*s = *res
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler with the canonical JSON form
+func (s *Spec) MarshalJSON() ([]byte, error) {
+	b := &bytes.Buffer{}
+	family, err := json.Marshal(s.Family)
+	if err != nil {
+		return nil, err
+	}
+	b.WriteString(`{"family":`)
+	b.Write(family)
+	for _, p := range s.Params {
+		name, err := json.Marshal(p.Name)
+		if err != nil {
+			return nil, err
+		}
+		value, _ := jsonFloat(p.Value).MarshalJSON()
+		b.WriteByte(',')
+		b.Write(name)
+		b.WriteByte(':')
+		b.Write(value)
+	}
+	b.WriteByte('}')
+	return b.Bytes(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting a JSON object holding
+// the family and the named parameters, or a string holding the text form
+func (s *Spec) UnmarshalJSON(data []byte) error {
+	text := ""
+	if err := json.Unmarshal(data, &text); err == nil {
+		return s.UnmarshalText([]byte(text))
+	}
+
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return util.ErrSpecSyntax
+	}
+	name := ""
+	if err := json.Unmarshal(fields["family"], &name); err != nil {
+		return util.ErrSpecSyntax
+	}
+	delete(fields, "family")
+
+	named := make(map[string]float64, len(fields))
+	for key, raw := range fields {
+		v := jsonFloat(0)
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return util.ErrSpecSyntax
+		}
+		named[key] = float64(v)
+	}
+	res, err := newSpec(name, nil, named)
+	if err != nil {
+		return err
+	}
+	*s = *res
+	return nil
+}
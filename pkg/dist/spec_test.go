@@ -0,0 +1,167 @@
+package dist
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+func TestParseSpec(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Spec     string
+		Expected Distribution
+		Err      error
+	}{
+		{"named", "normal(mu=0, sigma=1)", &Normal{Mu: 0, Sigma: 1}, nil},
+		{"positional", "gamma(2, 0.5)", &Gamma{Alpha: 2, Beta: .5}, nil},
+		{"mixed", " gamma( 2 , beta = 0.5 ) ", &Gamma{Alpha: 2, Beta: .5}, nil},
+		{"unordered", "gamma(beta=0.5, alpha=2)", &Gamma{Alpha: 2, Beta: .5}, nil},
+		{"default", "weibull(k=1.5, lambda=2)", &Weibull{K: 1.5, Lambda: 2}, nil},
+		{"json", `{"family":"poisson","lambda":3}`, &Poisson{Lambda: 3}, nil},
+		{"json_unordered", `{"sigma": 2, "family": "lognormal", "mu": -1}`, &LogNormal{Mu: -1, Sigma: 2}, nil},
+		{"chisq", "chisq(4)", &Chisq{Degree: 4}, nil},
+		{"invalid_param", "normal(mu=0, sigma=-1)", nil, util.ErrNormalParam},
+		{"invalid_json_param", `{"family":"poisson","lambda":-3}`, nil, util.ErrPoissonParam},
+		{"invalid_chisq", "chisq(0)", nil, util.ErrChisqParam},
+		{"unknown_family", "gaussian(0, 1)", nil, util.ErrSpecFamily},
+		{"unknown_param", "normal(mu=0, sd=1)", nil, util.ErrSpecParam},
+		{"missing_param", "normal(mu=0)", nil, util.ErrSpecParam},
+		{"duplicated_param", "normal(0, mu=1)", nil, util.ErrSpecParam},
+		{"too_many_params", "poisson(1, 2)", nil, util.ErrSpecParam},
+		{"nan_param", "normal(mu=NaN, sigma=1)", nil, util.ErrSpecParam},
+		{"infinite_param", "poisson(lambda=Inf)", nil, util.ErrSpecParam},
+		{"negative_infinite_param", "uniform(-inf, 0)", nil, util.ErrSpecParam},
+		{"json_null_param", `{"family":"normal","mu":null,"sigma":1}`, nil, util.ErrSpecParam},
+		{"json_infinite_param", `{"family":"poisson","lambda":"+Inf"}`, nil, util.ErrSpecParam},
+		{"positional_after_named", "gamma(alpha=2, 0.5)", nil, util.ErrSpecSyntax},
+		{"not_a_number", "poisson(lambda=three)", nil, util.ErrSpecSyntax},
+		{"unbalanced", "poisson(3", nil, util.ErrSpecSyntax},
+		{"json_without_family", `{"lambda":3}`, nil, util.ErrSpecSyntax},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			d, err := Parse(tc.Spec)
+			if err != tc.Err {
+				t.Fatalf("expected error %v, got %v", tc.Err, err)
+			}
+			if err == nil && !reflect.DeepEqual(d, tc.Expected) {
+				t.Errorf("expected %v, got %v", tc.Expected, d)
+			}
+		})
+	}
+}
+
+func TestSpecMarshal(t *testing.T) {
+	if families := Families(); len(families) != 28 || !sort.StringsAreSorted(families) {
+		t.Errorf("unexpected families %v", families)
+	}
+
+	d := &Gamma{}
+	d.Init(2, .5)
+	spec, err := SpecOf(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := spec.String(); s != "gamma(alpha=2, beta=0.5)" {
+		t.Errorf("unexpected text form %s", s)
+	}
+	data, err := json.Marshal(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"family":"gamma","alpha":2,"beta":0.5}` {
+		t.Errorf("unexpected JSON form %s", data)
+	}
+
+	// Both forms parse back to the same specification
+	for _, form := range []string{spec.String(), string(data)} {
+		res, err := ParseSpec(form)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(res, spec) {
+			t.Errorf("expected %v, got %v", spec, res)
+		}
+	}
+
+	// Specifications embedded in configurations accept both forms
+	config := struct {
+		Arrivals *Spec   `json:"arrivals"`
+		Services []*Spec `json:"services"`
+	}{}
+	err = json.Unmarshal([]byte(`{
+		"arrivals": "poisson(3)",
+		"services": [{"family": "exponential", "lambda": 2}, "weibull(1.5, 2)"]
+	}`), &config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Arrivals.String() != "poisson(lambda=3)" || len(config.Services) != 2 ||
+		config.Services[0].String() != "exponential(lambda=2)" || config.Services[1].String() != "weibull(k=1.5, lambda=2, theta=0)" {
+		t.Errorf("unexpected configuration %v %v", config.Arrivals, config.Services)
+	}
+
+	// Every registered family describes its distributions
+	for _, name := range []string{"bernoulli(0.3)", "hypergeometric(50, 20, 10)", "triangular(1, 3, 2)", "lomax(3, 2)", "polya(5, 0.3)"} {
+		d, err := Parse(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		spec, err := SpecOf(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := spec.New()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(res, d) {
+			t.Errorf("%s: expected %v, got %v", name, d, res)
+		}
+	}
+
+	// Specifications built by hand are checked as well
+	invalid := &Spec{Family: "normal", Params: []Param{{"mu", 0}, {"sigma", math.Inf(1)}}}
+	if _, err := invalid.New(); err != util.ErrSpecParam {
+		t.Errorf("expected %v, got %v", util.ErrSpecParam, err)
+	}
+
+	// Distributions outside of the registry have no specification
+	m := &Mixture{}
+	m.Init([]Distribution{&Normal{Mu: 0, Sigma: 1}}, []float64{1})
+	if _, err := SpecOf(m); err != util.ErrSpecFamily {
+		t.Errorf("expected %v, got %v", util.ErrSpecFamily, err)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	Register("shifted_exponential", []string{"lambda", "shift"}, func(p []float64) (Distribution, error) {
+		d := &Exponential{}
+		if err := d.Init(p[0]); err != nil {
+			return nil, err
+		}
+		return &Truncated{Dist: d, Lower: p[1], Upper: p[1] + 10}, nil
+	}, 0)
+	defer func() {
+		registryMu.Lock()
+		delete(registry, "shifted_exponential")
+		registryMu.Unlock()
+	}()
+
+	spec, err := ParseSpec("shifted_exponential(2)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spec.String() != "shifted_exponential(lambda=2, shift=0)" {
+		t.Errorf("unexpected specification %s", spec)
+	}
+	if _, err := Parse("shifted_exponential(-2)"); err != util.ErrExponentialParam {
+		t.Errorf("expected %v, got %v", util.ErrExponentialParam, err)
+	}
+}
@@ -0,0 +1,253 @@
+package dist
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/ichbinfrog/statistics/pkg/specfun"
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// StudentT represents the Student's t distribution
+// Continuous probability distribution function as follows:
+//		X ~ t(ν), ν > 0
+//
+//		f(x,ν) = Γ((ν+1)/2) / (√(νπ) Γ(ν/2)) * (1 + x^2/ν)^(-(ν+1)/2)
+//
+type StudentT struct {
+	source
+	Nu float64
+}
+
+// Init intialises a Student's t distribution
+func (s *StudentT) Init(nu float64) error {
+	if nu <= 0 {
+		return util.ErrStudentTParam
+	}
+	s.Nu = nu
+	return nil
+}
+
+// Generate creates one sample of the Student's t distribution
+func (s *StudentT) Generate() float64 {
+	return s.Rand(s.rng())
+}
+
+// GenerateN creates n samples of the Student's t distribution
+func (s *StudentT) GenerateN(n int) []float64 {
+	return GenerateN(s, s.rng(), n)
+}
+
+// Fill fills dst with samples of the Student's t distribution
+func (s *StudentT) Fill(dst []float64) {
+	Fill(s, s.rng(), dst)
+}
+
+// Rand creates one sample of the Student's t distribution using the given generator
+// Algorithm: polar method
+//		Draw (u, v) uniformly in the unit disk, w = u^2 + v^2
+//		x = u * √(ν(w^(-2/ν) - 1) / w)
+//
+// BAILEY, Ralph W. Polar generation of random variates with the t-distribution. 1994.
+func (s *StudentT) Rand(r *rand.Rand) float64 {
+	for {
+		u, v := 2*r.Float64()-1, 2*r.Float64()-1
+		w := u*u + v*v
+		if w > 0 && w < 1 {
+			return u * math.Sqrt(s.Nu*(math.Pow(w, -2/s.Nu)-1)/w)
+		}
+	}
+}
+
+// Domain returns the definition domain of the distribution
+func (s *StudentT) Domain() (float64, float64) {
+	return math.Inf(-1), math.Inf(0)
+}
+
+// PDF returns the probability density function value of a given x
+func (s *StudentT) PDF(x float64) float64 {
+	return math.Exp(s.LogPDF(x))
+}
+
+// LogPDF returns the log of the probability density function value of a given x
+func (s *StudentT) LogPDF(x float64) float64 {
+	return -specfun.LogBeta(s.Nu/2, .5) - math.Log(s.Nu)/2 - (s.Nu+1)/2*math.Log1p(x*x/s.Nu)
+}
+
+// CDF returns the Cumulative distribution function value of a given x
+// Algorithm:
+//		F(x) = 1 - I(ν/(ν+x^2); ν/2, 1/2) / 2 for x >= 0, symmetric otherwise
+//
+func (s *StudentT) CDF(x float64) float64 {
+	if x > 0 {
+		return 1 - s.tail(x)
+	}
+	return s.tail(x)
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given x
+func (s *StudentT) LogCDF(x float64) float64 {
+	if x > 0 {
+		return math.Log1p(-s.tail(x))
+	}
+	return specfun.LogRegIncBeta(s.Nu/2, .5, s.Nu/(s.Nu+x*x)) - math.Ln2
+}
+
+// Survival returns the survival function value of a given x
+func (s *StudentT) Survival(x float64) float64 {
+	return s.CDF(-x)
+}
+
+// LogSurvival returns the log of the survival function value of a given x
+func (s *StudentT) LogSurvival(x float64) float64 {
+	return s.LogCDF(-x)
+}
+
+// tail returns P(X > |x|)
+func (s *StudentT) tail(x float64) float64 {
+	if math.IsInf(x, 0) {
+		return 0
+	}
+	return specfun.RegIncBeta(s.Nu/2, .5, s.Nu/(s.Nu+x*x)) / 2
+}
+
+// Quantile returns the p-th quantile of the distribution
+// Algorithm:
+//		p < 1/4 : x = -√(ν(1-y)/y), y = I^-1(2p; ν/2, 1/2)
+//		p < 1/2 : x = -√(νy/(1-y)), y = I^-1(1 - 2p; 1/2, ν/2)
+//		else    : x = -Q(1 - p)
+//
+func (s *StudentT) Quantile(p float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	if p > .5 {
+		return -s.Quantile(1 - p)
+	}
+	if p == 0 {
+		return math.Inf(-1)
+	}
+	if p < .25 {
+		y := specfun.InvRegIncBeta(s.Nu/2, .5, 2*p)
+		return -math.Sqrt(s.Nu * (1 - y) / y)
+	}
+	y := specfun.InvRegIncBeta(.5, s.Nu/2, 1-2*p)
+	return -math.Sqrt(s.Nu * y / (1 - y))
+}
+
+// Mean returns the mean of the distribution, undefined for ν <= 1
+func (s *StudentT) Mean() float64 {
+	if s.Nu <= 1 {
+		return math.NaN()
+	}
+	return 0
+}
+
+// Median returns the median of the distribution
+func (s *StudentT) Median() float64 {
+	return 0
+}
+
+// Var returns the variance of the distribution, infinite for 1 < ν <= 2
+// and undefined for ν <= 1
+func (s *StudentT) Var() float64 {
+	switch {
+	case s.Nu > 2:
+		return s.Nu / (s.Nu - 2)
+	case s.Nu > 1:
+		return math.Inf(0)
+	default:
+		return math.NaN()
+	}
+}
+
+// Skewness returns the Pearson's moment coefficient of skewness of the distribution
+func (s *StudentT) Skewness() float64 {
+	if s.Nu <= 3 {
+		return math.NaN()
+	}
+	return 0
+}
+
+// Kurtosis returns the Kurtosis of the distribution
+func (s *StudentT) Kurtosis() float64 {
+	switch {
+	case s.Nu > 4:
+		return 6 / (s.Nu - 4)
+	case s.Nu > 2:
+		return math.Inf(0)
+	default:
+		return math.NaN()
+	}
+}
+
+// Entropy returns the Entropy of the distribution
+func (s *StudentT) Entropy() float64 {
+	return (s.Nu+1)/2*(specfun.Digamma((s.Nu+1)/2)-specfun.Digamma(s.Nu/2)) +
+		math.Log(s.Nu)/2 + specfun.LogBeta(s.Nu/2, .5)
+}
+
+// Moment returns the t-th moment of the distribution, the moment
+// generating function of the Student's t distribution only exists at 0
+func (s *StudentT) Moment(t float64) float64 {
+	if t == 0 {
+		return 1
+	}
+	return math.NaN()
+}
+
+// centralMoment returns E[X^k], +Inf for even k >= ν and NaN for odd k >= ν
+//		E[X^k] = ν^(k/2) Γ((k+1)/2) Γ((ν-k)/2) / (√π Γ(ν/2)) for even k, 0 otherwise
+//
+func (s *StudentT) centralMoment(k int) float64 {
+	kf := float64(k)
+	switch {
+	case kf >= s.Nu && k%2 == 0:
+		return math.Inf(1)
+	case kf >= s.Nu:
+		return math.NaN()
+	case k%2 == 1:
+		return 0
+	}
+	lg := specfun.LogGamma((kf+1)/2) + specfun.LogGamma((s.Nu-kf)/2) - specfun.LogGamma(s.Nu/2)
+	return math.Exp(kf/2*math.Log(s.Nu)+lg) / math.Sqrt(math.Pi)
+}
+
+// rawMoment returns E[X^k], the distribution being centered
+func (s *StudentT) rawMoment(k int) float64 {
+	return s.centralMoment(k)
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (s *StudentT) RawMoment(k int) float64 {
+	return RawMoment(s, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (s *StudentT) CentralMoment(k int) float64 {
+	return CentralMoment(s, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (s *StudentT) Cumulant(k int) float64 {
+	return Cumulant(s, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (s *StudentT) CF(t float64) complex128 {
+	return CF(s, t)
+}
+
+// FisherI returns the Fisher Information of the distribution
+// with respect to ν
+func (s *StudentT) FisherI() [][]float64 {
+	return [][]float64{
+		[]float64{(specfun.Trigamma(s.Nu/2) - specfun.Trigamma((s.Nu+1)/2)) / 4 -
+			(s.Nu + 5) / (2 * s.Nu * (s.Nu + 1) * (s.Nu + 3))},
+	}
+}
+
+// Summary returns the properties of the distribution
+func (s *StudentT) Summary() *DistSummary {
+	return summarise(s, "studentt", Param{"nu", s.Nu})
+}
@@ -0,0 +1,66 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// expectation returns E[g(X)] computed with the midpoint rule on the
+// quantile function of the distribution
+func expectation(d Distribution, g func(float64) float64, n int) float64 {
+	sum := 0.0
+	for i := 0; i < n; i++ {
+		sum += g(d.Quantile((float64(i) + .5) / float64(n)))
+	}
+	return sum / float64(n)
+}
+
+func TestStudentT(t *testing.T) {
+	dist := &StudentT{}
+	if err := dist.Init(5); err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(dist.Summary())
+
+	// ν = 1 is the standard Cauchy distribution and ν = 2 has a closed form cdf
+	cauchy, two := &StudentT{Nu: 1}, &StudentT{Nu: 2}
+	for _, x := range []float64{-30, -2, -.5, 0, .5, 2, 30} {
+		if v, e := cauchy.CDF(x), .5+math.Atan(x)/math.Pi; math.Abs(v-e) > 1e-12 {
+			t.Errorf("t(1): F(%g) = %g, expected %g", x, v, e)
+		}
+		if v, e := cauchy.PDF(x), 1/(math.Pi*(1+x*x)); math.Abs(v-e) > 1e-12 {
+			t.Errorf("t(1): f(%g) = %g, expected %g", x, v, e)
+		}
+		if v, e := two.CDF(x), .5+x/(2*math.Sqrt(2+x*x)); math.Abs(v-e) > 1e-12 {
+			t.Errorf("t(2): F(%g) = %g, expected %g", x, v, e)
+		}
+	}
+	if q := dist.Quantile(.975); math.Abs(q-2.570581835636314) > 1e-9 {
+		t.Errorf("Q(.975) = %.15f", q)
+	}
+
+	if h := expectation(dist, func(x float64) float64 { return -dist.LogPDF(x) }, 100000); math.Abs(h-dist.Entropy()) > 1e-3 {
+		t.Errorf("entropy %f, numerically %f", dist.Entropy(), h)
+	}
+	score := func(x float64) float64 {
+		up, down := &StudentT{Nu: dist.Nu + 1e-5}, &StudentT{Nu: dist.Nu - 1e-5}
+		return math.Pow((up.LogPDF(x)-down.LogPDF(x))/2e-5, 2)
+	}
+	if i := expectation(dist, score, 100000); math.Abs(i-dist.FisherI()[0][0]) > 1e-4 {
+		t.Errorf("fisher information %g, numerically %g", dist.FisherI()[0][0], i)
+	}
+
+	for _, nu := range []float64{.5, 1, 2, 4} {
+		d := &StudentT{Nu: nu}
+		if nu <= 1 && !math.IsNaN(d.Mean()) {
+			t.Errorf("t(%g): mean %f, expected NaN", nu, d.Mean())
+		}
+		if nu <= 2 && !math.IsNaN(d.Kurtosis()) {
+			t.Errorf("t(%g): kurtosis %f, expected NaN", nu, d.Kurtosis())
+		}
+	}
+	if err := dist.Init(0); err == nil {
+		t.Errorf("expected error for ν = 0")
+	}
+}
@@ -0,0 +1,177 @@
+package dist
+
+import (
+	"math"
+
+	"github.com/ichbinfrog/statistics/pkg/util"
+	"gonum.org/v1/gonum/dsp/fourier"
+)
+
+const (
+	// convolutionTail is the probability mass of the infinite tails left
+	// out of the probability tables convolved by Convolve
+	convolutionTail = 1e-13
+	// maxConvolution is the maximal length of the probability tables
+	// convolved by Convolve
+	maxConvolution = 1 << 24
+)
+
+// SumOf returns the distribution of X + Y, where X and Y are independent
+// random variables following x and y. Sums with a closed form are
+// returned as a distribution of the same family:
+//		Normal(μ1, σ1) + Normal(μ2, σ2) = Normal(μ1 + μ2, √(σ1^2 + σ2^2))
+//		Cauchy(μ1, σ1) + Cauchy(μ2, σ2) = Cauchy(μ1 + μ2, σ1 + σ2)
+//		Γ(α1, β) + Γ(α2, β) = Γ(α1 + α2, β), Exp(λ) being Γ(1, λ)
+//		χ(k1) + χ(k2) = χ(k1 + k2)
+//		Poisson(λ1) + Poisson(λ2) = Poisson(λ1 + λ2)
+//		B(n1, p) + B(n2, p) = B(n1 + n2, p), Bernoulli(p) being B(1, p)
+//		NB(r1, p) + NB(r2, p) = NB(r1 + r2, p)
+// Other integer valued discrete distributions are convolved by Convolve.
+//
+func SumOf(x, y Distribution) (Distribution, error) {
+	if x == nil || y == nil {
+		return nil, util.ErrSumParam
+	}
+	switch u := x.(type) {
+	case *Normal:
+		if v, ok := y.(*Normal); ok {
+			return &Normal{Mu: u.Mu + v.Mu, Sigma: math.Hypot(u.Sigma, v.Sigma)}, nil
+		}
+	case *Cauchy:
+		if v, ok := y.(*Cauchy); ok {
+			return &Cauchy{Mu: u.Mu + v.Mu, Sigma: u.Sigma + v.Sigma}, nil
+		}
+	case *Chisq:
+		if v, ok := y.(*Chisq); ok {
+			return &Chisq{Degree: u.Degree + v.Degree}, nil
+		}
+	case *Poisson:
+		if v, ok := y.(*Poisson); ok {
+			return &Poisson{Lambda: u.Lambda + v.Lambda}, nil
+		}
+	case *Polya:
+		if v, ok := y.(*Polya); ok && u.P == v.P {
+			return &Polya{R: u.R + v.R, P: u.P, Q: u.Q}, nil
+		}
+	}
+	if u, ok := asGamma(x); ok {
+		if v, ok := asGamma(y); ok && u.Beta == v.Beta {
+			return &Gamma{Alpha: u.Alpha + v.Alpha, Beta: u.Beta}, nil
+		}
+	}
+	if u, ok := asBinomial(x); ok {
+		if v, ok := asBinomial(y); ok && u.P == v.P {
+			return &Binomial{N: u.N + v.N, P: u.P, Q: u.Q}, nil
+		}
+	}
+
+	dx, okx := x.(Discrete)
+	dy, oky := y.(Discrete)
+	if !okx || !oky || !IsDiscrete(x) || !IsDiscrete(y) {
+		return nil, util.ErrSumParam
+	}
+	return Convolve(dx, dy)
+}
+
+// asGamma returns the Gamma distribution equal to d, if any
+func asGamma(d Distribution) (*Gamma, bool) {
+	switch v := d.(type) {
+	case *Gamma:
+		return v, true
+	case *Exponential:
+		return &Gamma{Alpha: 1, Beta: v.Lambda}, true
+	}
+	return nil, false
+}
+
+// asBinomial returns the Binomial distribution equal to d, if any
+func asBinomial(d Distribution) (*Binomial, bool) {
+	switch v := d.(type) {
+	case *Binomial:
+		return v, true
+	case *Bernoulli:
+		return &Binomial{N: 1, P: v.P, Q: v.Q}, true
+	}
+	return nil, false
+}
+
+// pmfTable returns the probabilities of the integers of the support of d
+// starting at the returned offset, the infinite tails holding less than
+// convolutionTail being left out
+func pmfTable(d Discrete) ([]float64, float64, error) {
+	lo, hi := d.Domain()
+	if math.IsInf(lo, -1) {
+		lo = d.Quantile(convolutionTail / 2)
+	}
+	if math.IsInf(hi, 1) {
+		hi = d.Quantile(1 - convolutionTail/2)
+	}
+	lo, hi = math.Ceil(lo), math.Floor(hi)
+	if math.IsNaN(lo) || math.IsNaN(hi) || math.IsInf(lo, 0) || math.IsInf(hi, 0) || hi-lo+1 > maxConvolution {
+		return nil, 0, util.ErrSumParam
+	}
+
+	res, mass := make([]float64, int(hi-lo)+1), 0.0
+	for i := range res {
+		res[i] = d.PMF(lo + float64(i))
+		mass += res[i]
+	}
+	if math.Abs(mass-1) > 1e-6 {
+		// d is not integer valued
+		return nil, 0, util.ErrSumParam
+	}
+	return res, lo, nil
+}
+
+// Convolve returns the distribution of X + Y, where X and Y are
+// independent integer valued random variables following x and y, as the
+// Categorical distribution of its probability table shifted to the
+// smallest value of the support
+// Algorithm:
+//		P(X + Y = k) = Σ_i P(X = i) P(Y = k - i)
+//		The tables of both pmfs are zero padded to the next power of 2 of
+//		the length of their convolution, which is the inverse Fourier
+//		transform of the product of their Fourier transforms
+//
+// Infinite supports are cut where their tails hold less than 1e-13.
+// Complexity: O(n log(n)), n being the length of the support of X + Y
+//
+func Convolve(x, y Discrete) (*Affine, error) {
+	px, ox, err := pmfTable(x)
+	if err != nil {
+		return nil, err
+	}
+	py, oy, err := pmfTable(y)
+	if err != nil {
+		return nil, err
+	}
+
+	m := len(px) + len(py) - 1
+	n := 1
+	for n < m {
+		n <<= 1
+	}
+	fft := fourier.NewFFT(n)
+	padded := make([]float64, n)
+	copy(padded, px)
+	cx := fft.Coefficients(nil, padded)
+	for i := range padded {
+		padded[i] = 0
+	}
+	copy(padded, py)
+	cy := fft.Coefficients(nil, padded)
+	for i := range cx {
+		cx[i] *= cy[i]
+	}
+	res := fft.Sequence(nil, cx)[:m]
+	for i, v := range res {
+		// Rounding errors of the transform can be slightly negative
+		res[i] = math.Max(0, v/float64(n))
+	}
+
+	c := &Categorical{}
+	if err := c.Init(res); err != nil {
+		return nil, err
+	}
+	return &Affine{Dist: c, A: 1, B: ox + oy}, nil
+}
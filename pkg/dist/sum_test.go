@@ -0,0 +1,101 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+func TestSumOf(t *testing.T) {
+	for _, tc := range []struct {
+		x, y     Distribution
+		expected Distribution
+	}{
+		{&Normal{Mu: 1, Sigma: 3}, &Normal{Mu: -2, Sigma: 4}, &Normal{Mu: -1, Sigma: 5}},
+		{&Cauchy{Mu: 1, Sigma: 1}, &Cauchy{Mu: 1, Sigma: 2}, &Cauchy{Mu: 2, Sigma: 3}},
+		{&Chisq{Degree: 2}, &Chisq{Degree: 3}, &Chisq{Degree: 5}},
+		{&Poisson{Lambda: 2}, &Poisson{Lambda: 3.5}, &Poisson{Lambda: 5.5}},
+		{&Gamma{Alpha: 2, Beta: 3}, &Gamma{Alpha: 1.5, Beta: 3}, &Gamma{Alpha: 3.5, Beta: 3}},
+		{&Exponential{Lambda: 2}, &Exponential{Lambda: 2}, &Gamma{Alpha: 2, Beta: 2}},
+		{&Binomial{N: 3, P: .4, Q: .6}, &Bernoulli{P: .4, Q: .6}, &Binomial{N: 4, P: .4, Q: .6}},
+		{&Polya{R: 2, P: .3, Q: .7}, &Polya{R: 1.5, P: .3, Q: .7}, &Polya{R: 3.5, P: .3, Q: .7}},
+	} {
+		t.Run(fmt.Sprintf("%T+%T", tc.x, tc.y), func(t *testing.T) {
+			res, err := SumOf(tc.x, tc.y)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if fmt.Sprint(res) != fmt.Sprint(tc.expected) {
+				t.Errorf("got %+v, expected %+v", res, tc.expected)
+			}
+		})
+	}
+
+	if _, err := SumOf(&Normal{Mu: 0, Sigma: 1}, &Gamma{Alpha: 1, Beta: 1}); err != util.ErrSumParam {
+		t.Errorf("expected %v, got %v", util.ErrSumParam, err)
+	}
+	if _, err := SumOf(&Poisson{Lambda: 1}, &Normal{Mu: 0, Sigma: 1}); err != util.ErrSumParam {
+		t.Errorf("expected %v, got %v", util.ErrSumParam, err)
+	}
+}
+
+func TestConvolve(t *testing.T) {
+	for _, tc := range []struct {
+		x, y Discrete
+	}{
+		{&Binomial{N: 10, P: .3, Q: .7}, &Geometric{P: .4, Q: .6}},
+		{&Poisson{Lambda: 4}, &Binomial{N: 20, P: .6, Q: .4}},
+		{&DiscreteUniform{A: -3, B: 5}, &Poisson{Lambda: 1.5}},
+	} {
+		t.Run(fmt.Sprintf("%T+%T", tc.x, tc.y), func(t *testing.T) {
+			res, err := SumOf(tc.x, tc.y)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sum, ok := res.(*Affine)
+			if !ok {
+				t.Fatalf("expected a convolution, got %T", res)
+			}
+			if !IsDiscrete(sum) {
+				t.Errorf("expected a discrete distribution")
+			}
+			xlo, _ := tc.x.Domain()
+			ylo, _ := tc.y.Domain()
+			cdf := 0.0
+			for k := xlo + ylo; k < xlo+ylo+40; k++ {
+				// P(X + Y = k) = Σ_i P(X = i) P(Y = k - i)
+				e := 0.0
+				for i := xlo; i <= k-ylo; i++ {
+					e += tc.x.PMF(i) * tc.y.PMF(k-i)
+				}
+				cdf += e
+				if v := sum.PMF(k); math.Abs(v-e) > 1e-12 {
+					t.Errorf("P(X + Y = %g) = %g, expected %g", k, v, e)
+				}
+				if v := sum.CDF(k); math.Abs(v-cdf) > 1e-12 {
+					t.Errorf("F(%g) = %g, expected %g", k, v, cdf)
+				}
+			}
+			if v, e := sum.Mean(), tc.x.Mean()+tc.y.Mean(); math.Abs(v-e) > 1e-9 {
+				t.Errorf("E[X + Y] = %f, expected %f", v, e)
+			}
+			if v, e := sum.Var(), tc.x.Var()+tc.y.Var(); math.Abs(v-e) > 1e-8 {
+				t.Errorf("Var(X + Y) = %f, expected %f", v, e)
+			}
+			for _, p := range []float64{.05, .5, .95} {
+				q := sum.Quantile(p)
+				if sum.CDF(q) < p || sum.CDF(q-1) >= p {
+					t.Errorf("Q(%f) = %f is not the generalized inverse of the cdf", p, q)
+				}
+			}
+		})
+	}
+
+	// Half integers are not convolved
+	half := &Affine{Dist: &Poisson{Lambda: 2}, A: .5, B: 0}
+	if _, err := SumOf(&Poisson{Lambda: 1}, half); err != util.ErrSumParam {
+		t.Errorf("expected %v, got %v", util.ErrSumParam, err)
+	}
+}
@@ -0,0 +1,361 @@
+package dist
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/ichbinfrog/statistics/pkg/matrix"
+)
+
+// Param is a named parameter of a distribution
+type Param struct {
+	Name  string  `json:"name"`
+	Value float64 `json:"value"`
+}
+
+// DistSummary is the structured summary of the properties of a distribution,
+// the equivalent of array.Summaryf64 for distributions. Properties that are
+// undefined, or not implemented by the family, are NaN.
+type DistSummary struct {
+	Family string `json:"family"`
+	// Params holds the parameters in the order of the Init method
+	Params   []Param    `json:"params"`
+	Support  [2]float64 `json:"support"`
+	Mean     float64    `json:"mean"`
+	Median   float64    `json:"median"`
+	Variance float64    `json:"variance"`
+	Skewness float64    `json:"skewness"`
+	Kurtosis float64    `json:"kurtosis"`
+	Entropy  float64    `json:"entropy"`
+	// FisherInformation is the Fisher information matrix of the parameters
+	// of families with a maximum likelihood estimator
+	FisherInformation [][]float64 `json:"fisherInformation,omitempty"`
+	// Components holds the summaries of the distributions a mixture, a
+	// truncation or a censoring is built on
+	Components []*DistSummary `json:"components,omitempty"`
+	// MeanVector and Covariance hold the moments of multivariate distributions
+	MeanVector []float64   `json:"meanVector,omitempty"`
+	Covariance [][]float64 `json:"covariance,omitempty"`
+}
+
+// summarise returns the summary of a univariate distribution, the optional
+// properties being read from the methods implemented by the distribution.
+// The median is the quantile of 1/2, the smallest median of discrete
+// distributions.
+func summarise(d Distribution, family string, params ...Param) *DistSummary {
+	s := &DistSummary{
+		Family:   family,
+		Params:   params,
+		Mean:     d.Mean(),
+		Median:   d.Quantile(.5),
+		Variance: d.Var(),
+		Skewness: math.NaN(),
+		Kurtosis: math.NaN(),
+		Entropy:  math.NaN(),
+	}
+	s.Support[0], s.Support[1] = d.Domain()
+	if m, ok := d.(interface{ Skewness() float64 }); ok {
+		s.Skewness = m.Skewness()
+	}
+	if m, ok := d.(interface{ Kurtosis() float64 }); ok {
+		s.Kurtosis = m.Kurtosis()
+	}
+	if m, ok := d.(interface{ Entropy() float64 }); ok {
+		s.Entropy = m.Entropy()
+	}
+	if m, ok := d.(interface{ FisherI() [][]float64 }); ok {
+		s.FisherInformation = m.FisherI()
+	}
+	return s
+}
+
+// summariseMultivariate returns the summary of a multivariate distribution,
+// whose scalar moments are NaN
+func summariseMultivariate(family string, lo, hi float64, mean []float64, cov *matrix.Matrixf64, entropy float64, params ...Param) *DistSummary {
+	s := &DistSummary{
+		Family:     family,
+		Params:     params,
+		Support:    [2]float64{lo, hi},
+		Mean:       math.NaN(),
+		Median:     math.NaN(),
+		Variance:   math.NaN(),
+		Skewness:   math.NaN(),
+		Kurtosis:   math.NaN(),
+		Entropy:    entropy,
+		MeanVector: mean,
+		Covariance: make([][]float64, cov.Height()),
+	}
+	for i := range s.Covariance {
+		s.Covariance[i] = make([]float64, cov.Width())
+		for j := range s.Covariance[i] {
+			s.Covariance[i][j] = *cov.At(i, j)
+		}
+	}
+	return s
+}
+
+// vectorParams names the i-th value of a vector parameter name[i]
+func vectorParams(name string, values []float64) []Param {
+	res := make([]Param, len(values))
+	for i, v := range values {
+		res[i] = Param{fmt.Sprintf("%s[%d]", name, i), v}
+	}
+	return res
+}
+
+// properties returns the scalar properties of the summary in display order
+func (s *DistSummary) properties() []Param {
+	return []Param{
+		{"Mean", s.Mean},
+		{"Median", s.Median},
+		{"Var", s.Variance},
+		{"Skewness", s.Skewness},
+		{"Kurtosis", s.Kurtosis},
+		{"Entropy", s.Entropy},
+	}
+}
+
+// signature returns family(name: value, ...)
+func (s *DistSummary) signature() string {
+	params := make([]string, len(s.Params))
+	for i, p := range s.Params {
+		params[i] = fmt.Sprintf("%s: %f", p.Name, p.Value)
+	}
+	return fmt.Sprintf("%s(%s)", s.Family, strings.Join(params, ", "))
+}
+
+// Text renders the summary as the tab indented block printed by the
+// package, omitting the undefined properties
+func (s *DistSummary) Text() string {
+	return s.text(1)
+}
+
+func (s *DistSummary) text(depth int) string {
+	indent := strings.Repeat("\t", depth)
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "\n%sX ~ %s\n", indent, s.signature())
+	fmt.Fprintf(b, "%s\tDomain:\t\t\t{ %f , %f }\n", indent, s.Support[0], s.Support[1])
+	for _, p := range s.properties() {
+		if !math.IsNaN(p.Value) {
+			fmt.Fprintf(b, "%s\t%s:\t\t\t%f\n", indent, p.Name, p.Value)
+		}
+	}
+	if s.MeanVector != nil {
+		fmt.Fprintf(b, "%s\tMean:\t\t\t%v\n", indent, s.MeanVector)
+		fmt.Fprintf(b, "%s\tCov:\t\t\t%v\n", indent, s.Covariance)
+	}
+	if s.FisherInformation != nil {
+		fmt.Fprintf(b, "%s\tFisherI:\t\t%v\n", indent, s.FisherInformation)
+	}
+	for _, c := range s.Components {
+		b.WriteString(c.text(depth + 1))
+	}
+	return b.String()
+}
+
+// String implements fmt.Stringer with the Text rendering
+func (s *DistSummary) String() string {
+	return s.Text()
+}
+
+// Markdown renders the summary as a table of properties, omitting the
+// undefined ones, followed by the tables of the components
+func (s *DistSummary) Markdown() string {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "| %s | |\n|:---|---:|\n", s.signature())
+	for _, p := range s.Params {
+		fmt.Fprintf(b, "| %s | %g |\n", p.Name, p.Value)
+	}
+	fmt.Fprintf(b, "| Domain | [%g, %g] |\n", s.Support[0], s.Support[1])
+	for _, p := range s.properties() {
+		if !math.IsNaN(p.Value) {
+			fmt.Fprintf(b, "| %s | %g |\n", p.Name, p.Value)
+		}
+	}
+	if s.MeanVector != nil {
+		fmt.Fprintf(b, "| Mean | %v |\n| Cov | %v |\n", s.MeanVector, s.Covariance)
+	}
+	if s.FisherInformation != nil {
+		fmt.Fprintf(b, "| FisherI | %v |\n", s.FisherInformation)
+	}
+	for _, c := range s.Components {
+		b.WriteString("\n")
+		b.WriteString(c.Markdown())
+	}
+	return b.String()
+}
+
+// JSON renders the summary as indented JSON
+func (s *DistSummary) JSON() ([]byte, error) {
+	return json.MarshalIndent(s, "", "  ")
+}
+
+// jsonFloat is a float64 encoding the values JSON numbers cannot hold as
+// null for NaN and as the strings "+Inf" and "-Inf"
+type jsonFloat float64
+
+// MarshalJSON implements json.Marshaler
+func (f jsonFloat) MarshalJSON() ([]byte, error) {
+	v := float64(f)
+	switch {
+	case math.IsNaN(v):
+		return []byte("null"), nil
+	case math.IsInf(v, 1):
+		return []byte(`"+Inf"`), nil
+	case math.IsInf(v, -1):
+		return []byte(`"-Inf"`), nil
+	}
+	return []byte(strconv.FormatFloat(v, 'g', -1, 64)), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (f *jsonFloat) UnmarshalJSON(data []byte) error {
+	switch string(data) {
+	case "null":
+		*f = jsonFloat(math.NaN())
+		return nil
+	case `"+Inf"`:
+		*f = jsonFloat(math.Inf(1))
+		return nil
+	case `"-Inf"`:
+		*f = jsonFloat(math.Inf(-1))
+		return nil
+	}
+	v, err := strconv.ParseFloat(string(data), 64)
+	*f = jsonFloat(v)
+	return err
+}
+
+// jsonFloats converts a vector for the JSON encoding
+func jsonFloats(values []float64) []jsonFloat {
+	if values == nil {
+		return nil
+	}
+	res := make([]jsonFloat, len(values))
+	for i, v := range values {
+		res[i] = jsonFloat(v)
+	}
+	return res
+}
+
+// jsonMatrix converts a matrix for the JSON encoding
+func jsonMatrix(values [][]float64) [][]jsonFloat {
+	if values == nil {
+		return nil
+	}
+	res := make([][]jsonFloat, len(values))
+	for i, v := range values {
+		res[i] = jsonFloats(v)
+	}
+	return res
+}
+
+// float64s converts back a decoded vector
+func float64s(values []jsonFloat) []float64 {
+	if values == nil {
+		return nil
+	}
+	res := make([]float64, len(values))
+	for i, v := range values {
+		res[i] = float64(v)
+	}
+	return res
+}
+
+// float64Matrix converts back a decoded matrix
+func float64Matrix(values [][]jsonFloat) [][]float64 {
+	if values == nil {
+		return nil
+	}
+	res := make([][]float64, len(values))
+	for i, v := range values {
+		res[i] = float64s(v)
+	}
+	return res
+}
+
+type jsonParam struct {
+	Name  string    `json:"name"`
+	Value jsonFloat `json:"value"`
+}
+
+// jsonSummary mirrors DistSummary with values encodable in JSON
+type jsonSummary struct {
+	Family            string         `json:"family"`
+	Params            []jsonParam    `json:"params"`
+	Support           [2]jsonFloat   `json:"support"`
+	Mean              jsonFloat      `json:"mean"`
+	Median            jsonFloat      `json:"median"`
+	Variance          jsonFloat      `json:"variance"`
+	Skewness          jsonFloat      `json:"skewness"`
+	Kurtosis          jsonFloat      `json:"kurtosis"`
+	Entropy           jsonFloat      `json:"entropy"`
+	FisherInformation [][]jsonFloat  `json:"fisherInformation,omitempty"`
+	Components        []*DistSummary `json:"components,omitempty"`
+	MeanVector        []jsonFloat    `json:"meanVector,omitempty"`
+	Covariance        [][]jsonFloat  `json:"covariance,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler, encoding NaN as null and the
+// infinities as "+Inf" and "-Inf"
+func (s *DistSummary) MarshalJSON() ([]byte, error) {
+	j := jsonSummary{
+		Family:            s.Family,
+		Params:            make([]jsonParam, len(s.Params)),
+		Support:           [2]jsonFloat{jsonFloat(s.Support[0]), jsonFloat(s.Support[1])},
+		Mean:              jsonFloat(s.Mean),
+		Median:            jsonFloat(s.Median),
+		Variance:          jsonFloat(s.Variance),
+		Skewness:          jsonFloat(s.Skewness),
+		Kurtosis:          jsonFloat(s.Kurtosis),
+		Entropy:           jsonFloat(s.Entropy),
+		FisherInformation: jsonMatrix(s.FisherInformation),
+		Components:        s.Components,
+		MeanVector:        jsonFloats(s.MeanVector),
+		Covariance:        jsonMatrix(s.Covariance),
+	}
+	for i, p := range s.Params {
+		j.Params[i] = jsonParam{p.Name, jsonFloat(p.Value)}
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (s *DistSummary) UnmarshalJSON(data []byte) error {
+	j := jsonSummary{}
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+	*s = DistSummary{
+		Family:            j.Family,
+		Params:            make([]Param, len(j.Params)),
+		Support:           [2]float64{float64(j.Support[0]), float64(j.Support[1])},
+		Mean:              float64(j.Mean),
+		Median:            float64(j.Median),
+		Variance:          float64(j.Variance),
+		Skewness:          float64(j.Skewness),
+		Kurtosis:          float64(j.Kurtosis),
+		Entropy:           float64(j.Entropy),
+		FisherInformation: float64Matrix(j.FisherInformation),
+		Components:        j.Components,
+		MeanVector:        float64s(j.MeanVector),
+		Covariance:        float64Matrix(j.Covariance),
+	}
+	for i, p := range j.Params {
+		s.Params[i] = Param{p.Name, float64(p.Value)}
+	}
+	return nil
+}
+
+// summaryOf returns the summary of any distribution, the distributions
+// defined outside of the package being summarised without family nor
+// parameters
+func summaryOf(d Distribution) *DistSummary {
+	if s, ok := d.(interface{ Summary() *DistSummary }); ok {
+		return s.Summary()
+	}
+	return summarise(d, "")
+}
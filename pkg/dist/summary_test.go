@@ -0,0 +1,128 @@
+package dist
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ichbinfrog/statistics/pkg/matrix"
+)
+
+// sameFloat compares floats, NaN being equal to itself
+func sameFloat(a, b float64) bool {
+	return a == b || math.IsNaN(a) && math.IsNaN(b)
+}
+
+func TestDistSummary(t *testing.T) {
+	mixture := &Mixture{}
+	mixture.Init([]Distribution{&Normal{Mu: 0, Sigma: 1}, &Gamma{Alpha: 2.5, Beta: 1}}, []float64{1, 3})
+	sigma := &matrix.Matrixf64{}
+	sigma.Init(2, 2)
+	sigma.Data = [][]float64{{2, .5}, {.5, 1}}
+	mvn := &MultivariateNormal{}
+	mvn.Init([]float64{1, -1}, sigma)
+	categorical := &Categorical{}
+	categorical.Init([]float64{.2, .8})
+
+	testCases := []struct {
+		Name    string
+		Summary *DistSummary
+		Params  []Param
+	}{
+		{"normal", (&Normal{Mu: 1, Sigma: 2}).Summary(), []Param{{"mu", 1}, {"sigma", 2}}},
+		{"cauchy", (&Cauchy{Mu: 1, Sigma: 2}).Summary(), []Param{{"mu", 1}, {"sigma", 2}}},
+		{"categorical", categorical.Summary(), []Param{{"p[0]", .2}, {"p[1]", .8}}},
+		{"mixture", mixture.Summary(), []Param{{"weight[0]", .25}, {"weight[1]", .75}}},
+		{"multivariatenormal", mvn.Summary(), []Param{{"mu[0]", 1}, {"mu[1]", -1}, {"sigma[0,0]", 2}, {"sigma[0,1]", .5}, {"sigma[1,0]", .5}, {"sigma[1,1]", 1}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			s := tc.Summary
+			if s.Family != tc.Name {
+				t.Errorf("expected family %s, got %s", tc.Name, s.Family)
+			}
+			if !reflect.DeepEqual(s.Params, tc.Params) {
+				t.Errorf("expected params %v, got %v", tc.Params, s.Params)
+			}
+
+			// NaN and infinite properties survive a JSON round trip
+			data, err := s.JSON()
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := &DistSummary{}
+			if err := json.Unmarshal(data, res); err != nil {
+				t.Fatal(err)
+			}
+			expected, got := append(s.properties(), Param{"lower", s.Support[0]}, Param{"upper", s.Support[1]}),
+				append(res.properties(), Param{"lower", res.Support[0]}, Param{"upper", res.Support[1]})
+			for i := range expected {
+				if !sameFloat(expected[i].Value, got[i].Value) {
+					t.Errorf("%s: expected %f after round trip, got %f", expected[i].Name, expected[i].Value, got[i].Value)
+				}
+			}
+			if len(res.Components) != len(s.Components) || !reflect.DeepEqual(res.Params, s.Params) ||
+				!reflect.DeepEqual(res.FisherInformation, s.FisherInformation) || !reflect.DeepEqual(res.Covariance, s.Covariance) {
+				t.Errorf("unexpected summary after round trip %s", data)
+			}
+
+			// Undefined properties are left out of the renderings
+			for _, text := range []string{s.Text(), s.Markdown()} {
+				if !strings.Contains(text, tc.Name+"(") {
+					t.Errorf("missing family in %s", text)
+				}
+				if strings.Contains(text, "NaN") {
+					t.Errorf("unexpected undefined property in %s", text)
+				}
+			}
+		})
+	}
+
+	// Cauchy has no moments
+	data, _ := (&Cauchy{Mu: 0, Sigma: 1}).Summary().JSON()
+	for _, expected := range []string{`"mean": null`, `"support": [`, `"-Inf"`, `"+Inf"`} {
+		if !strings.Contains(string(data), expected) {
+			t.Errorf("expected %s in %s", expected, data)
+		}
+	}
+
+	// Components are summarised along with the mixture
+	s := mixture.Summary()
+	if len(s.Components) != 2 || s.Components[1].Family != "gamma" || s.Components[1].Params[0].Value != 2.5 {
+		t.Errorf("unexpected components %v", s.Components)
+	}
+	if !strings.Contains(s.Markdown(), "| alpha | 2.5 |") {
+		t.Errorf("missing component in %s", s.Markdown())
+	}
+}
+
+func TestSummaryMedian(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Dist     Distribution
+		Expected float64
+	}{
+		{"binomial", &Binomial{N: 10, P: .3, Q: .7}, 3},
+		{"poisson", &Poisson{Lambda: 4.5}, 4},
+		{"polya", &Polya{R: 5, P: .3, Q: .7}, 2},
+		{"bernoulli", &Bernoulli{P: .7, Q: .3}, 1},
+		// b - √((b-a)(b-c)/2) for c < (a+b)/2
+		{"triangular", &Triangular{A: 0, B: 4, C: 1}, 4 - math.Sqrt(6)},
+		{"normal", &Normal{Mu: 1, Sigma: 2}, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			s := summaryOf(tc.Dist)
+			if math.Abs(s.Median-tc.Expected) > 1e-9 {
+				t.Errorf("median %f, expected %f", s.Median, tc.Expected)
+			}
+			if !strings.Contains(s.Text(), "Median") {
+				t.Errorf("missing median in %s", s.Text())
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package dist
+
+import (
+	"math"
+)
+
+// logDiscreteTail returns the log of Σ exp(logpmf(k)) for k going from
+// start by step (+1 or -1) until the bound, stopping once the terms
+// become negligible in front of the sum. It is used when the closed
+// form of a discrete cdf underflows.
+func logDiscreteTail(logpmf func(float64) float64, start, step, bound float64) float64 {
+	max := logpmf(start)
+	if math.IsInf(max, -1) {
+		return max
+	}
+	sum := 0.0
+	for k := start; (step > 0 && k <= bound) || (step < 0 && k >= bound); k += step {
+		l := logpmf(k)
+		if l > max {
+			sum *= math.Exp(max - l)
+			max = l
+		}
+		term := math.Exp(l - max)
+		sum += term
+		if term < sum*epsilon {
+			break
+		}
+	}
+	return max + math.Log(sum)
+}
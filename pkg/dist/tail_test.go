@@ -0,0 +1,100 @@
+package dist
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLogConsistency(t *testing.T) {
+	testCases := []struct {
+		Name string
+		Dist Distribution
+	}{
+		{"normal", &Normal{Mu: 3, Sigma: 2}},
+		{"gamma", &Gamma{Alpha: 2.5, Beta: 3}},
+		{"chisq", &Chisq{Degree: 5}},
+		{"exponential", &Exponential{Lambda: 2}},
+		{"triangular", &Triangular{A: 1, B: 3, C: 2}},
+		{"uniform", &Uniform{A: -1, B: 10}},
+		{"studentt", &StudentT{Nu: 3.5}},
+		{"fisherf", &FisherF{D1: 3, D2: 7}},
+		{"lognormal", &LogNormal{Mu: 1, Sigma: .5}},
+		{"weibull", &Weibull{K: 1.5, Lambda: 2, Theta: 1}},
+		{"pareto", &Pareto{Xm: 1, Alpha: 3}},
+		{"lomax", &Lomax{Alpha: 3, Lambda: 2}},
+		{"cauchy", &Cauchy{Mu: 1, Sigma: 2}},
+		{"laplace", &Laplace{Mu: 1, Sigma: 2}},
+		{"logistic", &Logistic{Mu: 1, Sigma: 2}},
+		{"gumbel", &Gumbel{Mu: 1, Sigma: 2}},
+		{"gumbelmin", &GumbelMin{Mu: 1, Sigma: 2}},
+		{"noncentralt", &NoncentralT{Nu: 5, Delta: 1.5}},
+		{"beta", &Beta{Alpha: 2, Beta: 5}},
+		{"bernoulli", &Bernoulli{P: .3, Q: .7}},
+		{"binomial", &Binomial{N: 40, P: .4, Q: .6}},
+		{"geometric", &Geometric{P: .3, Q: .7}},
+		{"poisson", &Poisson{Lambda: 24}},
+		{"polya", &Polya{R: 5, P: .3, Q: .7}},
+		{"hypergeometric", &Hypergeometric{Population: 500, Successes: 200, Draws: 100}},
+		{"discreteuniform", &DiscreteUniform{A: -3, B: 7}},
+	}
+
+	probs := []float64{.001, .1, .25, .5, .75, .9, .999}
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			for _, p := range probs {
+				x := tc.Dist.Quantile(p)
+				cdf, sf := tc.Dist.CDF(x), tc.Dist.Survival(x)
+				if math.Abs(cdf+sf-1) > 1e-12 {
+					t.Errorf("F(%g) + S(%g) = %g", x, x, cdf+sf)
+				}
+				if v := math.Exp(tc.Dist.LogCDF(x)); math.Abs(v-cdf) > 1e-12*math.Max(1, cdf) {
+					t.Errorf("exp(logF(%g)) = %g, expected %g", x, v, cdf)
+				}
+				if v := math.Exp(tc.Dist.LogSurvival(x)); math.Abs(v-sf) > 1e-10*sf {
+					t.Errorf("exp(logS(%g)) = %g, expected %g", x, v, sf)
+				}
+
+				switch d := tc.Dist.(type) {
+				case Continuous:
+					if v, f := math.Exp(d.LogPDF(x)), d.PDF(x); math.Abs(v-f) > 1e-12*f {
+						t.Errorf("exp(logf(%g)) = %g, expected %g", x, v, f)
+					}
+				case Discrete:
+					if v, f := math.Exp(d.LogPMF(x)), d.PMF(x); math.Abs(v-f) > 1e-12*f {
+						t.Errorf("exp(logp(%g)) = %g, expected %g", x, v, f)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestLogTails(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Fn       func(float64) float64
+		X        float64
+		Expected float64
+		Tol      float64
+	}{
+		// Values where the linear scale functions underflow or overflowed
+		{"normal logS", (&Normal{Mu: 0, Sigma: 1}).LogSurvival, 40, -804.6084420137538, 1e-9},
+		{"normal logF", (&Normal{Mu: 0, Sigma: 1}).LogCDF, -40, -804.6084420137538, 1e-9},
+		{"normal S", (&Normal{Mu: 0, Sigma: 1}).Survival, 10, 7.619853024160527e-24, 1e-12},
+		{"exponential logS", (&Exponential{Lambda: 2}).LogSurvival, 1000, -2000, 1e-12},
+		{"gamma logS", (&Gamma{Alpha: 2, Beta: 1}).LogSurvival, 1000, -1000 + math.Log(1001), 1e-12},
+		{"poisson p", (&Poisson{Lambda: 1000}).PMF, 1000, 0.012614611348721489, 1e-10},
+		{"poisson logF", (&Poisson{Lambda: 1000}).LogCDF, 10, -1000 + 10*math.Log(1000) - 15.104412573075516 + math.Log(1.0101112234568922), 1e-6},
+		{"binomial p", (&Binomial{N: 1000, P: .5, Q: .5}).PMF, 500, 0.025225018178360804, 1e-10},
+		{"binomial logS", (&Binomial{N: 2000, P: .1, Q: .9}).LogSurvival, 1999, 2000 * math.Log(.1), 1e-12},
+		{"geometric logS", (&Geometric{P: .5, Q: .5}).LogSurvival, 2000, 2000 * math.Log(.5), 1e-12},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if v := tc.Fn(tc.X); math.Abs(v-tc.Expected) > tc.Tol*math.Abs(tc.Expected) {
+				t.Errorf("f(%g) = %.16g, expected %.16g", tc.X, v, tc.Expected)
+			}
+		})
+	}
+}
@@ -1,14 +1,13 @@
 package dist
 
 import (
-	"fmt"
 	"math"
 	"math/rand"
 
 	"github.com/ichbinfrog/statistics/pkg/util"
 )
 
-// Triangular represents the discreet triangular distribution
+// Triangular represents the continuous triangular distribution
 // probability distribution function as follows:
 // 		X ~ T(a, b), a,b,c in [-inf, +inf], a <= c <= b
 //		f(k,p) = {
@@ -18,10 +17,11 @@ import (
 //		}, k in [-inf, +inf]
 //
 type Triangular struct {
+	source
 	A, B, C float64
 }
 
-// Init intialises a Bernoulli distribution
+// Init intialises a Triangular distribution
 func (t *Triangular) Init(a, b, c float64) error {
 	if b >= c && c >= a && b > a {
 		t.A, t.B, t.C = a, b, c
@@ -32,11 +32,22 @@ func (t *Triangular) Init(a, b, c float64) error {
 
 // Generate creates one sample of the Triangular distribution
 func (t *Triangular) Generate() float64 {
-	u := rand.Float64()
-	if 0 < u && u < t.CDF(u) {
-		return t.A + math.Sqrt(u*(t.B-t.A)*(t.C-t.A))
-	}
-	return t.B - math.Sqrt((1-u)*(t.B-t.A)*(t.B-t.C))
+	return t.Rand(t.rng())
+}
+
+// GenerateN creates n samples of the Triangular distribution
+func (t *Triangular) GenerateN(n int) []float64 {
+	return GenerateN(t, t.rng(), n)
+}
+
+// Fill fills dst with samples of the Triangular distribution
+func (t *Triangular) Fill(dst []float64) {
+	Fill(t, t.rng(), dst)
+}
+
+// Rand creates one sample of the Triangular distribution using the given generator
+func (t *Triangular) Rand(r *rand.Rand) float64 {
+	return t.Quantile(r.Float64())
 }
 
 // Domain returns the definition domain of the distribution
@@ -44,37 +55,74 @@ func (t *Triangular) Domain() (float64, float64) {
 	return t.A, t.B
 }
 
-// PMF returns the probability mass function value of a given k
-func (t *Triangular) PMF(k float64) float64 {
-	if k < t.A {
+// PDF returns the probability density function value of a given x
+func (t *Triangular) PDF(x float64) float64 {
+	if x < t.A || x > t.B {
 		return 0
 	}
-	if t.A <= k && k <= t.C {
-		return 2 * (k - t.A) / ((t.B - t.A) * (t.C - t.A))
+	if x == t.C {
+		return 2 / (t.B - t.A)
 	}
-	if k == t.C {
-		return 2 * (t.B - t.A)
+	if x < t.C {
+		return 2 * (x - t.A) / ((t.B - t.A) * (t.C - t.A))
 	}
-	if t.C < k && k <= t.B {
-		return 2 * (t.B - k) / ((t.B - t.A) * (t.B - t.C))
-	}
-	return 1
+	return 2 * (t.B - x) / ((t.B - t.A) * (t.B - t.C))
 }
 
-// CDF returns the Cumulative distribution function value of a given k
-func (t *Triangular) CDF(k float64) float64 {
-	if k <= t.A {
+// CDF returns the Cumulative distribution function value of a given x
+func (t *Triangular) CDF(x float64) float64 {
+	if x <= t.A {
 		return 0
 	}
-	if t.A < k && k <= t.C {
-		return math.Pow(k-t.A, 2) / ((t.B - t.A) * (t.B - t.C))
+	if x <= t.C {
+		return math.Pow(x-t.A, 2) / ((t.B - t.A) * (t.C - t.A))
 	}
-	if t.C < k && k <= t.B {
-		return 1 - math.Pow(t.B-k, 2)/((t.B-t.A)*(t.B-t.C))
+	if x < t.B {
+		return 1 - math.Pow(t.B-x, 2)/((t.B-t.A)*(t.B-t.C))
 	}
 	return 1
 }
 
+// LogPDF returns the log of the probability density function value of a given x
+func (t *Triangular) LogPDF(x float64) float64 {
+	return math.Log(t.PDF(x))
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given x
+func (t *Triangular) LogCDF(x float64) float64 {
+	return math.Log(t.CDF(x))
+}
+
+// Survival returns the survival function value of a given x
+func (t *Triangular) Survival(x float64) float64 {
+	if x <= t.A {
+		return 1
+	}
+	if x <= t.C {
+		return 1 - math.Pow(x-t.A, 2)/((t.B-t.A)*(t.C-t.A))
+	}
+	if x < t.B {
+		return math.Pow(t.B-x, 2) / ((t.B - t.A) * (t.B - t.C))
+	}
+	return 0
+}
+
+// LogSurvival returns the log of the survival function value of a given x
+func (t *Triangular) LogSurvival(x float64) float64 {
+	return math.Log(t.Survival(x))
+}
+
+// Quantile returns the p-th quantile of the distribution
+func (t *Triangular) Quantile(p float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	if p < (t.C-t.A)/(t.B-t.A) {
+		return t.A + math.Sqrt(p*(t.B-t.A)*(t.C-t.A))
+	}
+	return t.B - math.Sqrt((1-p)*(t.B-t.A)*(t.B-t.C))
+}
+
 // Mean returns the mean of the distribution
 func (t *Triangular) Mean() float64 {
 	return (t.A + t.B + t.C) / 3
@@ -95,17 +143,17 @@ func (t *Triangular) Var() float64 {
 
 // Skewness returns the Pearson's moment coefficient of skewness of the distribution
 func (t *Triangular) Skewness() float64 {
-	return (math.Sqrt(2) * (t.A + t.B - 2*t.C) * (2*t.A - t.B - t.C) * (t.A - 2*t.B + t.C)) / (5 * math.Pow((math.Pow(t.A, 2)+math.Pow(t.B, 2)+math.Pow(t.C, 2)-t.A*t.B-t.A*t.C-t.B*t.C), 3/2))
+	return (math.Sqrt(2) * (t.A + t.B - 2*t.C) * (2*t.A - t.B - t.C) * (t.A - 2*t.B + t.C)) / (5 * math.Pow((math.Pow(t.A, 2)+math.Pow(t.B, 2)+math.Pow(t.C, 2)-t.A*t.B-t.A*t.C-t.B*t.C), 1.5))
 }
 
 // Kurtosis returns the Kurtosis of the distribution
 func (t *Triangular) Kurtosis() float64 {
-	return -3 / 5
+	return -3.0 / 5
 }
 
 // Entropy returns the Entropy of the distribution
 func (t *Triangular) Entropy() float64 {
-	return 1/2 + math.Log((t.B-t.A)/2)
+	return .5 + math.Log((t.B-t.A)/2)
 }
 
 // Moment returns the t-th moment of the distribution
@@ -113,17 +161,43 @@ func (t *Triangular) Moment(k float64) float64 {
 	return 2 * ((t.B-t.C)*math.Exp(t.A*k) - (t.B-t.A)*math.Exp(t.C*k) + (t.C-t.A)*math.Exp(t.B*k)) / ((t.B - t.A) * (t.C - t.A) * (t.B - t.C) * math.Pow(k, 2))
 }
 
-// Summary returns a string summarising basic info about the distribution
-func (t *Triangular) Summary() string {
-	dbeg, dend := t.Domain()
-	return fmt.Sprintf(`
-	X ~ B(%f, %f, %f)
-		Domain:		{ %f , %f }
-		Mean: 		%f
-		Median: 	%f
-		Var: 		%f
-		Skewness: 	%f
-		Kurtosis:	%f
-		Entropy:	%f
-`, t.A, t.B, t.C, dbeg, dend, t.Mean(), t.Median(), t.Var(), t.Skewness(), t.Kurtosis(), t.Entropy())
+// rawMoment returns E[X^k], the second divided difference of x^(k+2) over the
+// bounds and the mode
+//		E[X^k] = 2 / ((k+1)(k+2)) Σ_(x∈{a,b,c}) x^(k+2) / Π_(y≠x) (x - y)
+//
+// Distributions with their mode on a bound are integrated numerically.
+func (t *Triangular) rawMoment(k int) float64 {
+	kf := float64(k)
+	if t.C == t.A || t.C == t.B {
+		return expect(t, func(x float64) float64 { return math.Pow(x, kf) })
+	}
+	sum := math.Pow(t.A, kf+2)/((t.A-t.B)*(t.A-t.C)) +
+		math.Pow(t.B, kf+2)/((t.B-t.A)*(t.B-t.C)) +
+		math.Pow(t.C, kf+2)/((t.C-t.A)*(t.C-t.B))
+	return 2 * sum / ((kf + 1) * (kf + 2))
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (t *Triangular) RawMoment(k int) float64 {
+	return RawMoment(t, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (t *Triangular) CentralMoment(k int) float64 {
+	return CentralMoment(t, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (t *Triangular) Cumulant(k int) float64 {
+	return Cumulant(t, k)
+}
+
+// CF returns the characteristic function E[exp(isX)] of the distribution at s
+func (t *Triangular) CF(s float64) complex128 {
+	return CF(t, s)
+}
+
+// Summary returns the properties of the distribution
+func (t *Triangular) Summary() *DistSummary {
+	return summarise(t, "triangular", Param{"a", t.A}, Param{"b", t.B}, Param{"c", t.C})
 }
@@ -10,8 +10,8 @@ func TestTriangular(t *testing.T) {
 	dist.Init(1, 3, 2)
 	fmt.Println(dist.Summary())
 
-	fmt.Printf("		f(0) = %f", dist.PMF(0))
-	fmt.Printf("\n		f(1) = %f\n", dist.PMF(1))
+	fmt.Printf("		f(0) = %f", dist.PDF(0))
+	fmt.Printf("\n		f(1) = %f\n", dist.PDF(1))
 	fmt.Printf("\n		F(0) = %f", dist.CDF(0))
 	fmt.Printf("\n		F(1) = %f", dist.CDF(1))
 	fmt.Printf("\n		F(2) = %f", dist.CDF(2))
@@ -0,0 +1,321 @@
+package dist
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// Truncated represents any distribution of the package restricted to
+// [Lower, Upper], observations outside of the bounds being discarded
+// Probability distribution function as follows:
+//		X ~ D | Lower <= X <= Upper
+//
+//		f(x) = f_D(x) / (F_D(Upper) - F_D(Lower-)), x in [Lower, Upper]
+//
+// The density is the PDF of a continuous base distribution and the PMF
+// of a discrete one, so that Truncated implements both interfaces.
+type Truncated struct {
+	source
+	Dist         Distribution
+	Lower, Upper float64
+}
+
+// Init intialises a Truncated distribution
+func (t *Truncated) Init(d Distribution, lower, upper float64) error {
+	if d == nil || !(lower <= upper) {
+		return util.ErrTruncatedParam
+	}
+	t.Dist, t.Lower, t.Upper = d, lower, upper
+	if !(t.mass() > 0) {
+		return util.ErrTruncatedParam
+	}
+	return nil
+}
+
+// below returns P(X < x) for the base distribution
+func (t *Truncated) below(x float64) float64 {
+	if IsDiscrete(t.Dist) {
+		return t.Dist.CDF(math.Ceil(x) - 1)
+	}
+	return t.Dist.CDF(x)
+}
+
+// atLeast returns P(X >= x) for the base distribution
+func (t *Truncated) atLeast(x float64) float64 {
+	if IsDiscrete(t.Dist) {
+		return t.Dist.Survival(math.Ceil(x) - 1)
+	}
+	return t.Dist.Survival(x)
+}
+
+// upperTail returns true when the bounds lie in the upper tail of the base
+// distribution, where differences of survival functions are more accurate
+// than differences of cdfs
+func (t *Truncated) upperTail() bool {
+	return t.below(t.Lower) > .5
+}
+
+// mass returns the probability P(Lower <= X <= Upper) of the base distribution
+func (t *Truncated) mass() float64 {
+	if t.upperTail() {
+		return t.atLeast(t.Lower) - t.Dist.Survival(t.Upper)
+	}
+	return t.Dist.CDF(t.Upper) - t.below(t.Lower)
+}
+
+// Generate creates one sample of the Truncated distribution
+func (t *Truncated) Generate() float64 {
+	return t.Rand(t.rng())
+}
+
+// GenerateN creates n samples of the Truncated distribution
+func (t *Truncated) GenerateN(n int) []float64 {
+	return GenerateN(t, t.rng(), n)
+}
+
+// Fill fills dst with samples of the Truncated distribution
+func (t *Truncated) Fill(dst []float64) {
+	Fill(t, t.rng(), dst)
+}
+
+// Rand creates one sample of the Truncated distribution using the given generator
+// Algorithm:
+//		Rejection of the samples of the base distribution falling outside
+//		of the bounds when they hold at least a quarter of its mass,
+//		inverse transform sampling otherwise
+//
+// Complexity: at most 4 samples of the base distribution on average, or
+// one evaluation of the quantile function
+//
+func (t *Truncated) Rand(r *rand.Rand) float64 {
+	if t.mass() >= .25 {
+		for {
+			if x := t.Dist.Rand(r); x >= t.Lower && x <= t.Upper {
+				return x
+			}
+		}
+	}
+	return t.Quantile(r.Float64())
+}
+
+// fill fills dst with samples of the Truncated distribution, computing the
+// mass between the bounds once instead of at every inverse transform. The
+// numerical inversions of the cdf, for discrete distributions or bounds
+// holding almost no mass, still evaluate it at each step.
+func (t *Truncated) fill(r *rand.Rand, dst []float64) {
+	lower, mass := t.below(t.Lower), t.mass()
+	if mass >= .25 {
+		for i := range dst {
+			for {
+				if x := t.Dist.Rand(r); x >= t.Lower && x <= t.Upper {
+					dst[i] = x
+					break
+				}
+			}
+		}
+		return
+	}
+	for i := range dst {
+		dst[i] = t.quantile(r.Float64(), lower, mass)
+	}
+}
+
+// Domain returns the definition domain of the distribution
+func (t *Truncated) Domain() (float64, float64) {
+	dbeg, dend := t.Dist.Domain()
+	lo, hi := math.Max(dbeg, t.Lower), math.Min(dend, t.Upper)
+	if IsDiscrete(t.Dist) {
+		return math.Ceil(lo), math.Floor(hi)
+	}
+	return lo, hi
+}
+
+// PDF returns the probability density function value of a given x
+func (t *Truncated) PDF(x float64) float64 {
+	return math.Exp(t.LogPDF(x))
+}
+
+// LogPDF returns the log of the probability density function value of a given x
+func (t *Truncated) LogPDF(x float64) float64 {
+	if x < t.Lower || x > t.Upper {
+		return math.Inf(-1)
+	}
+	return logDensity(t.Dist, x) - math.Log(t.mass())
+}
+
+// PMF returns the probability mass function value of a given k
+func (t *Truncated) PMF(k float64) float64 {
+	return t.PDF(k)
+}
+
+// LogPMF returns the log of the probability mass function value of a given k
+func (t *Truncated) LogPMF(k float64) float64 {
+	return t.LogPDF(k)
+}
+
+// CDF returns the Cumulative distribution function value of a given x
+//		F(x) = (F_D(x) - F_D(Lower-)) / (F_D(Upper) - F_D(Lower-))
+//
+func (t *Truncated) CDF(x float64) float64 {
+	if x < t.Lower {
+		return 0
+	}
+	if x >= t.Upper {
+		return 1
+	}
+	var res float64
+	if t.upperTail() {
+		res = (t.atLeast(t.Lower) - t.Dist.Survival(x)) / t.mass()
+	} else {
+		res = (t.Dist.CDF(x) - t.below(t.Lower)) / t.mass()
+	}
+	return math.Max(0, math.Min(1, res))
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given x
+func (t *Truncated) LogCDF(x float64) float64 {
+	return math.Log(t.CDF(x))
+}
+
+// Survival returns the survival function value of a given x
+func (t *Truncated) Survival(x float64) float64 {
+	if x < t.Lower {
+		return 1
+	}
+	if x >= t.Upper {
+		return 0
+	}
+	var res float64
+	if t.upperTail() {
+		res = (t.Dist.Survival(x) - t.Dist.Survival(t.Upper)) / t.mass()
+	} else {
+		res = (t.Dist.CDF(t.Upper) - t.Dist.CDF(x)) / t.mass()
+	}
+	return math.Max(0, math.Min(1, res))
+}
+
+// LogSurvival returns the log of the survival function value of a given x
+func (t *Truncated) LogSurvival(x float64) float64 {
+	return math.Log(t.Survival(x))
+}
+
+// Quantile returns the p-th quantile of the distribution
+// Algorithm:
+//		Q(p) = Q_D(F_D(Lower-) + p (F_D(Upper) - F_D(Lower-)))
+//		The cdf is inverted numerically when the bounds hold too little
+//		mass for the base quantile to be accurate
+//
+func (t *Truncated) Quantile(p float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	return t.quantile(p, t.below(t.Lower), t.mass())
+}
+
+// quantile returns the p-th quantile of the distribution from F_D(Lower-)
+// and the mass between the bounds
+func (t *Truncated) quantile(p, lower, mass float64) float64 {
+	dbeg, dend := t.Domain()
+	x := math.Max(dbeg, math.Min(dend, t.Dist.Quantile(lower+p*mass)))
+	if IsDiscrete(t.Dist) {
+		return discreteQuantile(t.CDF, p, dbeg, dend, x)
+	}
+	if mass >= 1e-8 && !math.IsNaN(x) {
+		return x
+	}
+
+	x0, scale := dbeg, math.Sqrt(t.Dist.Var())
+	if math.IsInf(dbeg, -1) {
+		x0 = dend
+	}
+	if !math.IsInf(dbeg, 0) && !math.IsInf(dend, 0) {
+		x0, scale = (dbeg+dend)/2, (dend-dbeg)/4
+	}
+	return continuousQuantile(t.CDF, p, dbeg, dend, x0, scale)
+}
+
+// expectation returns E[g(X)], summing over the support of a discrete
+// distribution and integrating g(Q(u)) over [0, 1] otherwise
+func (t *Truncated) expectation(g func(float64) float64) float64 {
+	dbeg, dend := t.Domain()
+	if IsDiscrete(t.Dist) {
+		return discreteSum(t.PMF, g, dbeg, dend, 1)
+	}
+	return quantileIntegral(t.Quantile, g, 0, 1)
+}
+
+// unbounded returns true when the bounds do not cut an infinite tail of the
+// base distribution, whose moments may then be undefined
+func (t *Truncated) unbounded() bool {
+	dbeg, dend := t.Domain()
+	return math.IsInf(dbeg, 0) || math.IsInf(dend, 0)
+}
+
+// Mean returns the mean of the distribution
+func (t *Truncated) Mean() float64 {
+	if mean := t.Dist.Mean(); t.unbounded() && (math.IsNaN(mean) || math.IsInf(mean, 0)) {
+		return mean
+	}
+	return t.expectation(func(x float64) float64 { return x })
+}
+
+// Median returns the median of the distribution
+func (t *Truncated) Median() float64 {
+	return t.Quantile(.5)
+}
+
+// Var returns the variance of the distribution
+func (t *Truncated) Var() float64 {
+	if v := t.Dist.Var(); t.unbounded() && (math.IsNaN(v) || math.IsInf(v, 0)) {
+		return v
+	}
+	mean := t.Mean()
+	return t.expectation(func(x float64) float64 { return (x - mean) * (x - mean) })
+}
+
+// rawMoment returns E[X^k], that of the base distribution when it is not
+// finite and the bounds keep its infinite tails
+func (t *Truncated) rawMoment(k int) float64 {
+	if m := RawMoment(t.Dist, k); t.unbounded() && (math.IsNaN(m) || math.IsInf(m, 0)) {
+		return m
+	}
+	return t.expectation(func(x float64) float64 { return math.Pow(x, float64(k)) })
+}
+
+// centralMoment returns E[(X - E[X])^k], undefined when the raw moment is not finite
+func (t *Truncated) centralMoment(k int) float64 {
+	if m := t.rawMoment(k); math.IsNaN(m) || math.IsInf(m, 0) {
+		return m
+	}
+	mean := t.Mean()
+	return t.expectation(func(x float64) float64 { return math.Pow(x-mean, float64(k)) })
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (t *Truncated) RawMoment(k int) float64 {
+	return RawMoment(t, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (t *Truncated) CentralMoment(k int) float64 {
+	return CentralMoment(t, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (t *Truncated) Cumulant(k int) float64 {
+	return Cumulant(t, k)
+}
+
+// CF returns the characteristic function E[exp(isX)] of the distribution at s
+func (t *Truncated) CF(s float64) complex128 {
+	return CF(t, s)
+}
+
+// Summary returns the properties of the distribution
+func (t *Truncated) Summary() *DistSummary {
+	s := summarise(t, "truncated", Param{"lower", t.Lower}, Param{"upper", t.Upper})
+	s.Components = []*DistSummary{summaryOf(t.Dist)}
+	return s
+}
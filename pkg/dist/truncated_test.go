@@ -0,0 +1,98 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+func TestTruncated(t *testing.T) {
+	// Closed form moments of the truncated standard Normal distribution
+	std := &Normal{Mu: 0, Sigma: 1}
+	for _, bounds := range [][2]float64{{-1, 2}, {0, math.Inf(1)}, {math.Inf(-1), -3}, {10, math.Inf(1)}} {
+		a, b := bounds[0], bounds[1]
+		dist := &Truncated{}
+		if err := dist.Init(std, a, b); err != nil {
+			t.Fatal(err)
+		}
+		phi := func(x float64) float64 {
+			if math.IsInf(x, 0) {
+				return 0
+			}
+			return x * std.PDF(x)
+		}
+		z := std.Survival(a) - std.Survival(b)
+		mean := (std.PDF(a) - std.PDF(b)) / z
+		variance := 1 + (phi(a)-phi(b))/z - mean*mean
+		if v := dist.Mean(); math.Abs(v-mean) > 1e-8*math.Max(1, math.Abs(mean)) {
+			t.Errorf("[%f, %f]: E[X] = %.10f, expected %.10f", a, b, v, mean)
+		}
+		if v := dist.Var(); math.Abs(v-variance) > 1e-7*math.Max(1, variance) {
+			t.Errorf("[%f, %f]: Var(X) = %.10f, expected %.10f", a, b, v, variance)
+		}
+		for _, p := range []float64{1e-9, .1, .5, .9, 1 - 1e-9} {
+			if v := dist.CDF(dist.Quantile(p)); math.Abs(v-p) > 1e-9 {
+				t.Errorf("[%f, %f]: F(Q(%f)) = %f", a, b, p, v)
+			}
+		}
+	}
+
+	// The Exponential distribution is memoryless
+	exp := &Truncated{Dist: &Exponential{Lambda: 2}, Lower: 3, Upper: math.Inf(1)}
+	fmt.Println(exp.Summary())
+	for _, x := range []float64{3, 3.5, 7} {
+		if v, e := exp.PDF(x), (&Exponential{Lambda: 2}).PDF(x-3); math.Abs(v-e) > 1e-12 {
+			t.Errorf("f(%f) = %f, expected %f", x, v, e)
+		}
+	}
+	if v := exp.PDF(2.9); v != 0 {
+		t.Errorf("f(2.9) = %f, expected 0", v)
+	}
+
+	// Discrete distributions keep both bounds
+	poisson := &Truncated{}
+	poisson.Init(&Poisson{Lambda: 4}, 2, 6)
+	sum, mean := 0.0, 0.0
+	for k := 2.0; k <= 6; k++ {
+		sum += poisson.PMF(k)
+		mean += k * (&Poisson{Lambda: 4}).PMF(k)
+	}
+	mean /= (&Poisson{Lambda: 4}).CDF(6) - (&Poisson{Lambda: 4}).CDF(1)
+	if math.Abs(sum-1) > 1e-12 || poisson.PMF(1) != 0 || poisson.PMF(7) != 0 {
+		t.Errorf("Σ f(k) = %f, expected 1", sum)
+	}
+	if v := poisson.Mean(); math.Abs(v-mean) > 1e-12 {
+		t.Errorf("E[X] = %f, expected %f", v, mean)
+	}
+	if v := poisson.Quantile(0); v != 2 {
+		t.Errorf("Q(0) = %f, expected 2", v)
+	}
+
+	// Both the rejection and the inverse transform samplers are unbiased
+	r := rand.New(rand.NewSource(1))
+	for _, dist := range []*Truncated{
+		{Dist: &Gamma{Alpha: 3, Beta: 2}, Lower: .5, Upper: 2},
+		{Dist: &Gamma{Alpha: 3, Beta: 2}, Lower: 3, Upper: math.Inf(1)},
+	} {
+		n, sum := 20000, 0.0
+		for i := 0; i < n; i++ {
+			x := dist.Rand(r)
+			if x < dist.Lower || x > dist.Upper {
+				t.Fatalf("sample %f outside of [%f, %f]", x, dist.Lower, dist.Upper)
+			}
+			sum += x
+		}
+		if mean := sum / float64(n); math.Abs(mean-dist.Mean()) > 4*math.Sqrt(dist.Var()/float64(n)) {
+			t.Errorf("sample mean %f, expected %f", mean, dist.Mean())
+		}
+	}
+
+	for _, bounds := range [][2]float64{{2, 1}, {-2, -1}, {math.NaN(), 1}} {
+		if err := (&Truncated{}).Init(&Exponential{Lambda: 1}, bounds[0], bounds[1]); err != util.ErrTruncatedParam {
+			t.Errorf("[%f, %f]: expected %v, got %v", bounds[0], bounds[1], util.ErrTruncatedParam, err)
+		}
+	}
+}
@@ -1,7 +1,6 @@
 package dist
 
 import (
-	"fmt"
 	"math"
 	"math/rand"
 
@@ -17,17 +16,33 @@ import (
 //		}, k in [-inf, +inf]
 //
 type Uniform struct {
+	source
 	A, B float64
 }
 
-// Generate creates one sample of the Bernoulli distribution
+// Generate creates one sample of the Uniform distribution
 func (u *Uniform) Generate() float64 {
-	return rand.Float64()*(u.B-u.A) + u.A
+	return u.Rand(u.rng())
+}
+
+// GenerateN creates n samples of the Uniform distribution
+func (u *Uniform) GenerateN(n int) []float64 {
+	return GenerateN(u, u.rng(), n)
+}
+
+// Fill fills dst with samples of the Uniform distribution
+func (u *Uniform) Fill(dst []float64) {
+	Fill(u, u.rng(), dst)
+}
+
+// Rand creates one sample of the Uniform distribution using the given generator
+func (u *Uniform) Rand(r *rand.Rand) float64 {
+	return r.Float64()*(u.B-u.A) + u.A
 }
 
 // Init initialises the uniform distribution
 func (u *Uniform) Init(a, b float64) error {
-	if a > b {
+	if a >= b {
 		return util.ErrUniformParam
 	}
 	u.A, u.B = a, b
@@ -39,23 +54,57 @@ func (u *Uniform) Domain() (float64, float64) {
 	return u.A, u.B
 }
 
-// PMF returns the probability mass function value of a given k
-func (u *Uniform) PMF(k float64) float64 {
-	if k >= u.A && k <= u.B {
+// PDF returns the probability density function value of a given x
+func (u *Uniform) PDF(x float64) float64 {
+	if x >= u.A && x <= u.B {
 		return 1 / (u.B - u.A)
 	}
 	return 0
 }
 
-// CDF returns the Cumulative distribution function value of a given k
-func (u *Uniform) CDF(k float64) float64 {
-	if k < u.A {
+// CDF returns the Cumulative distribution function value of a given x
+func (u *Uniform) CDF(x float64) float64 {
+	if x < u.A {
 		return 0
 	}
-	if k > u.B {
+	if x > u.B {
 		return 1
 	}
-	return (k - u.A) / (u.B - u.A)
+	return (x - u.A) / (u.B - u.A)
+}
+
+// LogPDF returns the log of the probability density function value of a given x
+func (u *Uniform) LogPDF(x float64) float64 {
+	return math.Log(u.PDF(x))
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given x
+func (u *Uniform) LogCDF(x float64) float64 {
+	return math.Log(u.CDF(x))
+}
+
+// Survival returns the survival function value of a given x
+func (u *Uniform) Survival(x float64) float64 {
+	if x < u.A {
+		return 1
+	}
+	if x > u.B {
+		return 0
+	}
+	return (u.B - x) / (u.B - u.A)
+}
+
+// LogSurvival returns the log of the survival function value of a given x
+func (u *Uniform) LogSurvival(x float64) float64 {
+	return math.Log(u.Survival(x))
+}
+
+// Quantile returns the p-th quantile of the distribution
+func (u *Uniform) Quantile(p float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	return u.A + p*(u.B-u.A)
 }
 
 // Mean returns the mean of the distribution
@@ -70,7 +119,7 @@ func (u *Uniform) Median() float64 {
 
 // Var returns the variance of the distribution
 func (u *Uniform) Var() float64 {
-	return math.Pow(u.B-u.B, 2) / 12
+	return math.Pow(u.B-u.A, 2) / 12
 }
 
 // Skewness returns the Pearson's moment coefficient of skewness of the distribution
@@ -80,7 +129,7 @@ func (u *Uniform) Skewness() float64 {
 
 // Kurtosis returns the Kurtosis of the distribution
 func (u *Uniform) Kurtosis() float64 {
-	return -6 / 5
+	return -6. / 5
 }
 
 // Entropy returns the Entropy of the distribution
@@ -96,17 +145,48 @@ func (u *Uniform) Moment(t float64) float64 {
 	return (math.Exp(t*u.B) - math.Exp(t*u.A)) / (t * (u.B - u.A))
 }
 
-// Summary returns a string summarising basic info about the distribution
-func (u *Uniform) Summary() string {
-	dbeg, dend := u.Domain()
-	return fmt.Sprintf(`
-	X ~ U(%f, %f)
-		Domain:		{ %f , %f }
-		Mean: 		%f
-		Median: 	%f
-		Var: 		%f
-		Skewness: 	%f
-		Kurtosis:	%f
-		Entropy:	%f
-`, u.A, u.B, dbeg, dend, u.Mean(), u.Median(), u.Var(), u.Skewness(), u.Kurtosis(), u.Entropy())
+// rawMoment returns E[X^k] = (b^(k+1) - a^(k+1)) / ((k+1)(b-a))
+func (u *Uniform) rawMoment(k int) float64 {
+	kf := float64(k + 1)
+	return (math.Pow(u.B, kf) - math.Pow(u.A, kf)) / (kf * (u.B - u.A))
+}
+
+// centralMoment returns E[(X - E[X])^k] = ((b-a)/2)^k / (k+1) for even k, 0 otherwise
+func (u *Uniform) centralMoment(k int) float64 {
+	if k%2 == 1 {
+		return 0
+	}
+	return math.Pow((u.B-u.A)/2, float64(k)) / float64(k+1)
+}
+
+// cf returns the characteristic function of the distribution at t
+//		φ(t) = (exp(itb) - exp(ita)) / (it(b-a))
+//
+func (u *Uniform) cf(t float64) complex128 {
+	return (expi(t*u.B) - expi(t*u.A)) / complex(0, t*(u.B-u.A))
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (u *Uniform) RawMoment(k int) float64 {
+	return RawMoment(u, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (u *Uniform) CentralMoment(k int) float64 {
+	return CentralMoment(u, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (u *Uniform) Cumulant(k int) float64 {
+	return Cumulant(u, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (u *Uniform) CF(t float64) complex128 {
+	return CF(u, t)
+}
+
+// Summary returns the properties of the distribution
+func (u *Uniform) Summary() *DistSummary {
+	return summarise(u, "uniform", Param{"a", u.A}, Param{"b", u.B})
 }
@@ -0,0 +1,295 @@
+package dist
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/ichbinfrog/statistics/pkg/array"
+	"github.com/ichbinfrog/statistics/pkg/specfun"
+	"github.com/ichbinfrog/statistics/pkg/util"
+)
+
+// eulerGamma is the Euler-Mascheroni constant
+const eulerGamma = 0.57721566490153286060651209008240243104215933593992
+
+// Weibull represents the Weibull distribution with shape k, scale λ and
+// location θ. The usual 2-parameter distribution is obtained with θ = 0.
+// Continuous probability distribution function as follows:
+//		X ~ W(k, λ, θ), k > 0, λ > 0
+//
+//		f(x,k,λ,θ) = (k/λ) ((x-θ)/λ)^(k-1) exp(-((x-θ)/λ)^k), x >= θ
+//
+type Weibull struct {
+	source
+	K, Lambda, Theta float64
+}
+
+// Init intialises a Weibull distribution
+func (w *Weibull) Init(k, lambda, theta float64) error {
+	if k <= 0 || lambda <= 0 {
+		return util.ErrWeibullParam
+	}
+	w.K, w.Lambda, w.Theta = k, lambda, theta
+	return nil
+}
+
+// Generate creates one sample of the Weibull distribution
+func (w *Weibull) Generate() float64 {
+	return w.Rand(w.rng())
+}
+
+// GenerateN creates n samples of the Weibull distribution
+func (w *Weibull) GenerateN(n int) []float64 {
+	return GenerateN(w, w.rng(), n)
+}
+
+// Fill fills dst with samples of the Weibull distribution
+func (w *Weibull) Fill(dst []float64) {
+	Fill(w, w.rng(), dst)
+}
+
+// Rand creates one sample of the Weibull distribution using the given generator
+// Algorithm:
+//		X = θ + λE^(1/k), E ~ Exp(1)
+//
+func (w *Weibull) Rand(r *rand.Rand) float64 {
+	return w.Theta + w.Lambda*math.Pow(r.ExpFloat64(), 1/w.K)
+}
+
+// Domain returns the definition domain of the distribution
+func (w *Weibull) Domain() (float64, float64) {
+	return w.Theta, math.Inf(0)
+}
+
+// PDF returns the probability density function value of a given x
+func (w *Weibull) PDF(x float64) float64 {
+	return math.Exp(w.LogPDF(x))
+}
+
+// LogPDF returns the log of the probability density function value of a given x
+func (w *Weibull) LogPDF(x float64) float64 {
+	if x < w.Theta {
+		return math.Inf(-1)
+	}
+	z := (x - w.Theta) / w.Lambda
+	return math.Log(w.K/w.Lambda) + xlogy(w.K-1, z) - math.Pow(z, w.K)
+}
+
+// CDF returns the Cumulative distribution function value of a given x
+func (w *Weibull) CDF(x float64) float64 {
+	return -math.Expm1(w.LogSurvival(x))
+}
+
+// LogCDF returns the log of the Cumulative distribution function value of a given x
+func (w *Weibull) LogCDF(x float64) float64 {
+	return math.Log(w.CDF(x))
+}
+
+// Survival returns the survival function value of a given x
+func (w *Weibull) Survival(x float64) float64 {
+	return math.Exp(w.LogSurvival(x))
+}
+
+// LogSurvival returns the log of the survival function value of a given x
+func (w *Weibull) LogSurvival(x float64) float64 {
+	if x <= w.Theta {
+		return 0
+	}
+	return -math.Pow((x-w.Theta)/w.Lambda, w.K)
+}
+
+// Quantile returns the p-th quantile of the distribution
+func (w *Weibull) Quantile(p float64) float64 {
+	if !validProbability(p) {
+		return math.NaN()
+	}
+	return w.Theta + w.Lambda*math.Pow(-math.Log1p(-p), 1/w.K)
+}
+
+// gammaRatio returns Γ(1 + i/k)
+func (w *Weibull) gammaRatio(i float64) float64 {
+	return math.Gamma(1 + i/w.K)
+}
+
+// Mean returns the mean of the distribution
+func (w *Weibull) Mean() float64 {
+	return w.Theta + w.Lambda*w.gammaRatio(1)
+}
+
+// Median returns the median of the distribution
+func (w *Weibull) Median() float64 {
+	return w.Theta + w.Lambda*math.Pow(math.Ln2, 1/w.K)
+}
+
+// Var returns the variance of the distribution
+func (w *Weibull) Var() float64 {
+	return w.Lambda * w.Lambda * (w.gammaRatio(2) - math.Pow(w.gammaRatio(1), 2))
+}
+
+// Skewness returns the Pearson's moment coefficient of skewness of the distribution
+func (w *Weibull) Skewness() float64 {
+	g1, g2, g3 := w.gammaRatio(1), w.gammaRatio(2), w.gammaRatio(3)
+	return (g3 - 3*g1*g2 + 2*g1*g1*g1) / math.Pow(g2-g1*g1, 1.5)
+}
+
+// Kurtosis returns the Kurtosis of the distribution
+func (w *Weibull) Kurtosis() float64 {
+	g1, g2, g3, g4 := w.gammaRatio(1), w.gammaRatio(2), w.gammaRatio(3), w.gammaRatio(4)
+	return (g4-4*g1*g3+6*g1*g1*g2-3*math.Pow(g1, 4))/math.Pow(g2-g1*g1, 2) - 3
+}
+
+// Entropy returns the Entropy of the distribution
+func (w *Weibull) Entropy() float64 {
+	return eulerGamma*(1-1/w.K) + math.Log(w.Lambda/w.K) + 1
+}
+
+// Moment returns the t-th moment of the distribution
+// Algorithm:
+//		M(t) = exp(tθ) Σ(n = 0; n < ∞; n++) (tλ)^n Γ(1 + n/k) / n!
+//
+// The series only converges for k > 1, or k = 1 and |tλ| < 1, NaN is
+// returned otherwise.
+func (w *Weibull) Moment(t float64) float64 {
+	if t == 0 {
+		return 1
+	}
+	if w.K < 1 || (w.K == 1 && math.Abs(t*w.Lambda) >= 1) {
+		return math.NaN()
+	}
+	sum := 0.0
+	for n := 0.0; n < maxIter; n++ {
+		term := math.Exp(n*math.Log(math.Abs(t*w.Lambda)) + specfun.LogGamma(1+n/w.K) - specfun.LogFactorial(n))
+		if t < 0 && math.Mod(n, 2) == 1 {
+			term = -term
+		}
+		sum += term
+		if n > 0 && math.Abs(term) < math.Abs(sum)*epsilon {
+			break
+		}
+	}
+	return math.Exp(t*w.Theta) * sum
+}
+
+// scaleMoment returns E[(X - θ)^k] = λ^k Γ(1 + k/κ), κ being the shape
+func (w *Weibull) scaleMoment(k int) float64 {
+	kf := float64(k)
+	return math.Exp(kf*math.Log(w.Lambda) + specfun.LogGamma(1+kf/w.K))
+}
+
+// rawMoment returns E[X^k] = E[((X - θ) + θ)^k]
+func (w *Weibull) rawMoment(k int) float64 {
+	return shiftMoment(w.scaleMoment, w.Theta, k)
+}
+
+// centralMoment returns E[(X - E[X])^k] = E[((X - θ) + θ - E[X])^k]
+func (w *Weibull) centralMoment(k int) float64 {
+	return shiftMoment(w.scaleMoment, w.Theta-w.Mean(), k)
+}
+
+// RawMoment returns the k-th raw moment E[X^k] of the distribution
+func (w *Weibull) RawMoment(k int) float64 {
+	return RawMoment(w, k)
+}
+
+// CentralMoment returns the k-th central moment E[(X - E[X])^k] of the distribution
+func (w *Weibull) CentralMoment(k int) float64 {
+	return CentralMoment(w, k)
+}
+
+// Cumulant returns the k-th cumulant of the distribution
+func (w *Weibull) Cumulant(k int) float64 {
+	return Cumulant(w, k)
+}
+
+// CF returns the characteristic function E[exp(itX)] of the distribution at t
+func (w *Weibull) CF(t float64) complex128 {
+	return CF(w, t)
+}
+
+// FisherI returns the Fisher Information of the distribution with
+// respect to (k, λ), the location θ being known
+func (w *Weibull) FisherI() [][]float64 {
+	return [][]float64{
+		[]float64{(math.Pow(1-eulerGamma, 2) + math.Pi*math.Pi/6) / (w.K * w.K), -(1 - eulerGamma) / w.Lambda},
+		[]float64{-(1 - eulerGamma) / w.Lambda, w.K * w.K / (w.Lambda * w.Lambda)},
+	}
+}
+
+// Summary returns the properties of the distribution
+func (w *Weibull) Summary() *DistSummary {
+	return summarise(w, "weibull", Param{"k", w.K}, Param{"lambda", w.Lambda}, Param{"theta", w.Theta})
+}
+
+// FitWeibull returns the maximum likelihood estimation of a 2-parameter
+// Weibull distribution (θ = 0) from the given observations.
+// Algorithm: Newton's method on the profile likelihood equation of k
+//		Σ x^k log(x) / Σ x^k - 1/k - E[log(X)] = 0
+//		λ = (E[X^k])^(1/k)
+//
+// The observations are scaled by their maximum to avoid overflows.
+// Complexity: O(n) per iteration
+//
+func FitWeibull(a *array.Arrayf64) (*Weibull, *FitResult, error) {
+	if err := checkSample(a, 2, 0, math.Inf(0), false); err != nil {
+		return nil, nil, err
+	}
+	if a.Data[0] <= 0 {
+		return nil, nil, util.ErrFitSupport
+	}
+	scale := a.Data[len(a.Data)-1]
+	logs := make([]float64, len(a.Data))
+	meanLog, varLog := 0.0, 0.0
+	for i, v := range a.Data {
+		logs[i] = math.Log(v / scale)
+		meanLog += logs[i]
+	}
+	meanLog /= a.Length
+	for _, l := range logs {
+		varLog += math.Pow(l-meanLog, 2)
+	}
+	varLog /= a.Length
+	if varLog == 0 {
+		return nil, nil, util.ErrFitConvergence
+	}
+
+	// Initial guess from the variance of log(X) = π^2 / 6k^2
+	k := math.Pi / math.Sqrt(6*varLog)
+	converged := false
+	for i := 0; i < maxIter; i++ {
+		s0, s1, s2 := 0.0, 0.0, 0.0
+		for _, l := range logs {
+			xk := math.Exp(k * l)
+			s0 += xk
+			s1 += xk * l
+			s2 += xk * l * l
+		}
+		h := s1/s0 - 1/k - meanLog
+		dh := (s2*s0-s1*s1)/(s0*s0) + 1/(k*k)
+		step := h / dh
+		if k-step <= 0 {
+			step = k / 2
+		}
+		k -= step
+		if math.Abs(step) <= 1e-12*k {
+			converged = true
+			break
+		}
+	}
+	if !converged {
+		return nil, nil, util.ErrFitConvergence
+	}
+
+	s0 := 0.0
+	for _, l := range logs {
+		s0 += math.Exp(k * l)
+	}
+	w := &Weibull{}
+	if err := w.Init(k, scale*math.Pow(s0/a.Length, 1/k), 0); err != nil {
+		return nil, nil, err
+	}
+	return w, &FitResult{
+		LogLikelihood: a.Length * (math.Log(w.K/w.Lambda) + (w.K-1)*(meanLog+math.Log(scale)-math.Log(w.Lambda)) - 1),
+		StdErr:        fisherStdErr(w.FisherI(), a.Length),
+		Observations:  a.Length,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package dist
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestWeibull(t *testing.T) {
+	dist := &Weibull{}
+	if err := dist.Init(1.5, 2, 1); err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(dist.Summary())
+
+	// k = 1 is the exponential distribution of rate 1/λ
+	w, e := &Weibull{K: 1, Lambda: .5}, &Exponential{Lambda: 2}
+	for _, x := range []float64{0, .1, 1, 5} {
+		if v, ev := w.CDF(x), e.CDF(x); math.Abs(v-ev) > 1e-15 {
+			t.Errorf("W(1, .5): F(%g) = %g, expected %g", x, v, ev)
+		}
+		if v, ev := w.PDF(x), e.PDF(x); math.Abs(v-ev) > 1e-14 {
+			t.Errorf("W(1, .5): f(%g) = %g, expected %g", x, v, ev)
+		}
+	}
+	if v := w.Moment(1); math.Abs(v-2) > 1e-12 {
+		t.Errorf("W(1, .5): Mx(1) = %f, expected 2", v)
+	}
+
+	moments := []struct {
+		Name     string
+		Actual   float64
+		Expected float64
+	}{
+		{"mean", expectation(dist, func(x float64) float64 { return x }, 100000), dist.Mean()},
+		{"var", expectation(dist, func(x float64) float64 { return math.Pow(x-dist.Mean(), 2) }, 100000), dist.Var()},
+		{"skewness", expectation(dist, func(x float64) float64 { return math.Pow(x-dist.Mean(), 3) }, 100000) / math.Pow(dist.Var(), 1.5), dist.Skewness()},
+		{"kurtosis", expectation(dist, func(x float64) float64 { return math.Pow(x-dist.Mean(), 4) }, 100000)/math.Pow(dist.Var(), 2) - 3, dist.Kurtosis()},
+		{"entropy", expectation(dist, func(x float64) float64 { return -dist.LogPDF(x) }, 100000), dist.Entropy()},
+		{"mgf(.5)", expectation(dist, func(x float64) float64 { return math.Exp(.5 * x) }, 100000), dist.Moment(.5)},
+		{"mgf(-1)", expectation(dist, func(x float64) float64 { return math.Exp(-x) }, 100000), dist.Moment(-1)},
+	}
+	for _, m := range moments {
+		if math.Abs(m.Actual-m.Expected) > 5e-3*math.Max(1, math.Abs(m.Expected)) {
+			t.Errorf("%s %f, numerically %f", m.Name, m.Expected, m.Actual)
+		}
+	}
+
+	// Fisher information as the variance of the score of (k, λ)
+	std := &Weibull{K: 1.5, Lambda: 2}
+	h := 1e-6
+	score := func(x float64) []float64 {
+		dk := ((&Weibull{K: std.K + h, Lambda: std.Lambda}).LogPDF(x) - (&Weibull{K: std.K - h, Lambda: std.Lambda}).LogPDF(x)) / (2 * h)
+		dl := ((&Weibull{K: std.K, Lambda: std.Lambda + h}).LogPDF(x) - (&Weibull{K: std.K, Lambda: std.Lambda - h}).LogPDF(x)) / (2 * h)
+		return []float64{dk, dl}
+	}
+	fisher := std.FisherI()
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			v := expectation(std, func(x float64) float64 { s := score(x); return s[i] * s[j] }, 100000)
+			if math.Abs(v-fisher[i][j]) > 1e-3 {
+				t.Errorf("I[%d][%d] = %f, numerically %f", i, j, fisher[i][j], v)
+			}
+		}
+	}
+	if err := dist.Init(0, 1, 0); err == nil {
+		t.Errorf("expected error for k = 0")
+	}
+}
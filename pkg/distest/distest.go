@@ -0,0 +1,337 @@
+// Package distest provides conformance checks for the distributions of the
+// dist package, which also apply to user-defined implementations of its
+// interfaces. Each check returns an error describing the first violation
+// found, and Run runs all of them as subtests.
+package distest
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/ichbinfrog/statistics/pkg/array"
+	"github.com/ichbinfrog/statistics/pkg/dist"
+	"gonum.org/v1/gonum/integrate/quad"
+	"gonum.org/v1/gonum/mathext"
+)
+
+// Options configures the checks
+type Options struct {
+	// Samples is the number of samples drawn by the statistical checks
+	Samples int
+	// Seed seeds the generator of the samples, making the checks reproducible
+	Seed int64
+	// Tolerance is the absolute tolerance of the numerical checks
+	Tolerance float64
+	// Alpha is the significance level of the statistical checks
+	Alpha float64
+}
+
+// DefaultOptions are the options used by Run
+var DefaultOptions = Options{
+	Samples:   10000,
+	Seed:      1,
+	Tolerance: 1e-6,
+	Alpha:     1e-4,
+}
+
+// probabilities are the quantiles splitting the support in the numerical
+// checks, refined in the tails so that the integrals of the density and
+// the inversions of the cdf are checked where they are the hardest
+var probabilities = []float64{
+	1e-9, 1e-6, 1e-4, 1e-3, .01, .025, .05, .1, .2, .3, .4, .5,
+	.6, .7, .8, .9, .95, .975, .99, .999, 1 - 1e-4, 1 - 1e-6, 1 - 1e-9,
+}
+
+// Run runs every check of the package against the distribution as subtests
+func Run(t *testing.T, d dist.Distribution, opt Options) {
+	checks := []struct {
+		Name  string
+		Check func(dist.Distribution, Options) error
+	}{
+		{"normalised", Normalised},
+		{"cdf", CDF},
+		{"quantile", Quantile},
+		{"moments", Moments},
+		{"goodness of fit", GoodnessOfFit},
+	}
+	for _, c := range checks {
+		check := c.Check
+		t.Run(c.Name, func(t *testing.T) {
+			if err := check(d, opt); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+// Normalised checks that the density integrates to 1 over the domain of a
+// continuous distribution, or that the mass function sums to 1 over the
+// support of a discrete one. Distributions with neither are skipped.
+// Algorithm:
+//		The support is split at the quantiles of the probabilities above, the
+//		mass left of the first point and right of the last one being given by
+//		the cdf when the domain is unbounded
+//
+func Normalised(d dist.Distribution, opt Options) error {
+	var total float64
+	switch {
+	case dist.IsDiscrete(d):
+		p, ok := d.(dist.Discrete)
+		if !ok {
+			return nil
+		}
+		lo, hi := discreteRange(d)
+		total = d.CDF(lo-1) + d.Survival(hi)
+		for k := lo; k <= hi; k++ {
+			total += p.PMF(k)
+		}
+	default:
+		f, ok := d.(dist.Continuous)
+		if !ok {
+			return nil
+		}
+		points := partition(d)
+		total = d.CDF(points[0]) + d.Survival(points[len(points)-1])
+		for i := 1; i < len(points); i++ {
+			total += integrate(f.PDF, points[i-1], points[i], opt.Tolerance/float64(len(points)))
+		}
+	}
+	if math.Abs(total-1) > opt.Tolerance {
+		return fmt.Errorf("total mass %.12g, expected 1", total)
+	}
+	return nil
+}
+
+// CDF checks that the cdf is a nondecreasing function of [0, 1], that it is
+// the complement of the survival function, that the log functions agree
+// with their counterparts and that it matches the integrated density or the
+// cumulated mass function.
+func CDF(d dist.Distribution, opt Options) error {
+	points := partition(d)
+	lo, hi := points[0], points[len(points)-1]
+	prev := math.Inf(-1)
+	for i := 0; i <= 1000; i++ {
+		x := lo + (hi-lo)*float64(i)/1000
+		if err := cdfAt(d, x, opt.Tolerance); err != nil {
+			return err
+		}
+		c := d.CDF(x)
+		if c < prev {
+			return fmt.Errorf("F(%g) = %g decreases from %g", x, c, prev)
+		}
+		prev = c
+	}
+
+	switch p := d.(type) {
+	case dist.Discrete:
+		if !dist.IsDiscrete(d) {
+			break
+		}
+		lo, hi := discreteRange(d)
+		sum := d.CDF(lo - 1)
+		for k := lo; k <= hi; k++ {
+			sum += p.PMF(k)
+			if c := d.CDF(k); math.Abs(c-sum) > opt.Tolerance {
+				return fmt.Errorf("F(%g) = %.12g, cumulated mass function %.12g", k, c, sum)
+			}
+			if c, m := d.CDF(k+.5), d.CDF(k); c != m {
+				return fmt.Errorf("F(%g) = %.12g differs from F(%g) = %.12g", k+.5, c, k, m)
+			}
+		}
+		return nil
+	}
+
+	f, ok := d.(dist.Continuous)
+	if !ok || dist.IsDiscrete(d) {
+		return nil
+	}
+	sum := d.CDF(points[0])
+	for i := 1; i < len(points); i++ {
+		sum += integrate(f.PDF, points[i-1], points[i], opt.Tolerance/float64(len(points)))
+		if c := d.CDF(points[i]); math.Abs(c-sum) > opt.Tolerance {
+			return fmt.Errorf("F(%g) = %.12g, integrated density %.12g", points[i], c, sum)
+		}
+	}
+	return nil
+}
+
+// cdfAt checks the cdf, the survival function and their logs at x
+func cdfAt(d dist.Distribution, x, tol float64) error {
+	c, s := d.CDF(x), d.Survival(x)
+	if !(c >= 0 && c <= 1) {
+		return fmt.Errorf("F(%g) = %g is not a probability", x, c)
+	}
+	if math.Abs(c+s-1) > tol {
+		return fmt.Errorf("F(%g) + S(%g) = %.12g, expected 1", x, x, c+s)
+	}
+	if lc := math.Exp(d.LogCDF(x)); math.Abs(lc-c) > tol*math.Max(c, tol) {
+		return fmt.Errorf("exp(log F(%g)) = %.12g, F(%g) = %.12g", x, lc, x, c)
+	}
+	if ls := math.Exp(d.LogSurvival(x)); math.Abs(ls-s) > tol*math.Max(s, tol) {
+		return fmt.Errorf("exp(log S(%g)) = %.12g, S(%g) = %.12g", x, ls, x, s)
+	}
+	return nil
+}
+
+// Quantile checks that the quantile function is the generalised inverse of
+// the cdf, Q(p) = inf{x : F(x) >= p}, and that it is only defined on [0, 1]
+//		F(Q(p)) >= p and F(x) <= p for x < Q(p)
+//
+// The left limit is taken one unit below Q(p) for discrete distributions
+// and a relative 1e-6 below otherwise.
+func Quantile(d dist.Distribution, opt Options) error {
+	for _, p := range []float64{-.1, 1.1} {
+		if q := d.Quantile(p); !math.IsNaN(q) {
+			return fmt.Errorf("Q(%g) = %g, expected NaN", p, q)
+		}
+	}
+	discrete := dist.IsDiscrete(d)
+	for _, p := range probabilities {
+		x := d.Quantile(p)
+		if math.IsNaN(x) {
+			return fmt.Errorf("Q(%g) is NaN", p)
+		}
+		if c := d.CDF(x); c < p-opt.Tolerance {
+			return fmt.Errorf("F(Q(%g)) = F(%g) = %.12g is below %g", p, x, c, p)
+		}
+		below := x - 1e-6*math.Max(1, math.Abs(x))
+		if discrete {
+			below = x - 1
+		}
+		if c := d.CDF(below); c > p+opt.Tolerance {
+			return fmt.Errorf("F(%g) = %.12g is above %g although %g < Q(%g) = %g", below, c, p, below, p, x)
+		}
+	}
+	return nil
+}
+
+// Moments checks that the mean and the variance of samples drawn from the
+// distribution lie within the confidence interval of level 1 - Alpha of
+// Mean and Var, distributions of infinite variance being skipped.
+// Algorithm: by the central limit theorem
+//		|mean - μ| <= z sqrt(σ^2 / n)
+//		|var - σ^2| <= z sqrt((m4 - var^2) / n)
+//
+// with z the 1 - Alpha/2 quantile of N(0, 1) and m4 the sample fourth
+// central moment.
+func Moments(d dist.Distribution, opt Options) error {
+	mu, sigma2 := d.Mean(), d.Var()
+	// The central limit theorem requires a finite variance
+	if math.IsNaN(sigma2) || math.IsInf(sigma2, 0) {
+		return nil
+	}
+	samples := dist.GenerateN(d, rand.New(rand.NewSource(opt.Seed)), opt.Samples)
+	n := float64(len(samples))
+	z := mathext.NormalQuantile(1 - opt.Alpha/2)
+
+	mean := 0.0
+	for _, v := range samples {
+		mean += v
+	}
+	mean /= n
+	m2, m4 := 0.0, 0.0
+	for _, v := range samples {
+		dv := (v - mean) * (v - mean)
+		m2 += dv
+		m4 += dv * dv
+	}
+	m2, m4 = m2/n, m4/n
+
+	if bound := z*math.Sqrt(sigma2/n) + opt.Tolerance*math.Max(1, math.Abs(mu)); math.Abs(mean-mu) > bound {
+		return fmt.Errorf("sample mean %g, expected %g ± %g", mean, mu, bound)
+	}
+	if bound := z*math.Sqrt((m4-m2*m2)/n) + opt.Tolerance*math.Max(1, sigma2); math.Abs(m2-sigma2) > bound {
+		return fmt.Errorf("sample variance %g, expected %g ± %g", m2, sigma2, bound)
+	}
+	return nil
+}
+
+// GoodnessOfFit checks that samples drawn from the distribution pass a
+// χ^2 test against a discrete distribution, or a Kolmogorov-Smirnov test
+// otherwise, at the significance level Alpha
+func GoodnessOfFit(d dist.Distribution, opt Options) error {
+	samples := dist.GenerateN(d, rand.New(rand.NewSource(opt.Seed)), opt.Samples)
+	sort.Float64s(samples)
+	a := &array.Arrayf64{}
+	a.Init(array.Optionf64{})
+	a.InsertSlice(samples)
+
+	if p, ok := d.(dist.Discrete); ok && dist.IsDiscrete(d) {
+		if stat, pvalue := dist.ChiSquare(a, p, 0); pvalue < opt.Alpha {
+			return fmt.Errorf("χ^2 = %g, p-value %g below %g", stat, pvalue, opt.Alpha)
+		}
+		return nil
+	}
+	if stat, pvalue := dist.KolmogorovSmirnov(a, d); pvalue < opt.Alpha {
+		return fmt.Errorf("D = %g, p-value %g below %g", stat, pvalue, opt.Alpha)
+	}
+	return nil
+}
+
+// partition returns the increasing quantiles of the probabilities above,
+// extended to the bounds of the domain when they are finite
+func partition(d dist.Distribution) []float64 {
+	dbeg, dend := d.Domain()
+	points := []float64{}
+	if !math.IsInf(dbeg, 0) {
+		points = append(points, dbeg)
+	}
+	for _, p := range probabilities {
+		if x := d.Quantile(p); !math.IsNaN(x) && !math.IsInf(x, 0) {
+			points = append(points, x)
+		}
+	}
+	if !math.IsInf(dend, 0) {
+		points = append(points, dend)
+	}
+	sort.Float64s(points)
+
+	res := points[:1]
+	for _, x := range points[1:] {
+		if x > res[len(res)-1] {
+			res = append(res, x)
+		}
+	}
+	return res
+}
+
+// discreteRange returns the integers between which the mass function is
+// summed, the bounds of the domain or the extreme quantiles when unbounded
+func discreteRange(d dist.Distribution) (float64, float64) {
+	dbeg, dend := d.Domain()
+	lo, hi := math.Ceil(dbeg), math.Floor(dend)
+	if math.IsInf(lo, 0) {
+		lo = d.Quantile(probabilities[0])
+	}
+	if math.IsInf(hi, 0) {
+		hi = d.Quantile(probabilities[len(probabilities)-1])
+	}
+	return lo, hi
+}
+
+// integrate returns the integral of f over [a, b] with 32 points
+// Gauss-Legendre rules, bisecting the interval until both halves agree with
+// the whole within tol. f is never evaluated at the bounds, where densities
+// can be singular.
+func integrate(f func(float64) float64, a, b, tol float64) float64 {
+	g := func(x float64) float64 {
+		// Abscissas of tiny intervals can round to a bound
+		if x <= a || x >= b {
+			return 0
+		}
+		return f(x)
+	}
+	return bisect(g, a, b, tol, quad.Fixed(g, a, b, 32, nil, 0), 20)
+}
+
+func bisect(f func(float64) float64, a, b, tol, whole float64, depth int) float64 {
+	m := a + (b-a)/2
+	left, right := quad.Fixed(f, a, m, 32, nil, 0), quad.Fixed(f, m, b, 32, nil, 0)
+	if depth == 0 || math.Abs(left+right-whole) <= tol {
+		return left + right
+	}
+	return bisect(f, a, m, tol/2, left, depth-1) + bisect(f, m, b, tol/2, right, depth-1)
+}
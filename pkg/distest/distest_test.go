@@ -0,0 +1,159 @@
+package distest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/ichbinfrog/statistics/pkg/array"
+	"github.com/ichbinfrog/statistics/pkg/dist"
+)
+
+// observations returns an array of n samples of the distribution
+func observations(d dist.Distribution, n int, seed int64) *array.Arrayf64 {
+	a := &array.Arrayf64{}
+	a.Init(array.Optionf64{Degree: 2})
+	a.InsertSlice(dist.GenerateN(d, rand.New(rand.NewSource(seed)), n))
+	return a
+}
+
+func TestRun(t *testing.T) {
+	testCases := []struct {
+		Name string
+		New  func() dist.Distribution
+	}{
+		{"bernoulli", func() dist.Distribution { d := &dist.Bernoulli{}; d.Init(.3); return d }},
+		{"binomial", func() dist.Distribution { d := &dist.Binomial{}; d.Init(100, .4); return d }},
+		{"binomial small", func() dist.Distribution { d := &dist.Binomial{}; d.Init(12, .8); return d }},
+		{"chisq", func() dist.Distribution { return &dist.Chisq{Degree: 5} }},
+		{"exponential", func() dist.Distribution { d := &dist.Exponential{}; d.Init(2); return d }},
+		{"gamma", func() dist.Distribution { d := &dist.Gamma{}; d.Init(5, 10); return d }},
+		{"gamma small shape", func() dist.Distribution { d := &dist.Gamma{}; d.Init(.5, 2); return d }},
+		{"geometric", func() dist.Distribution { d := &dist.Geometric{}; d.Init(.3); return d }},
+		{"normal", func() dist.Distribution { d := &dist.Normal{}; d.Init(1, 2); return d }},
+		{"poisson", func() dist.Distribution { d := &dist.Poisson{}; d.Init(24); return d }},
+		{"polya", func() dist.Distribution { d := &dist.Polya{}; d.Init(5, .3); return d }},
+		{"triangular", func() dist.Distribution { d := &dist.Triangular{}; d.Init(1, 3, 2); return d }},
+		{"uniform", func() dist.Distribution { d := &dist.Uniform{}; d.Init(0, 10); return d }},
+		{"studentt", func() dist.Distribution { d := &dist.StudentT{}; d.Init(5); return d }},
+		{"fisherf", func() dist.Distribution { d := &dist.FisherF{}; d.Init(3, 12); return d }},
+		{"beta", func() dist.Distribution { d := &dist.Beta{}; d.Init(2, 5); return d }},
+		{"beta arcsine", func() dist.Distribution { d := &dist.Beta{}; d.Init(.5, .5); return d }},
+		{"lognormal", func() dist.Distribution { d := &dist.LogNormal{}; d.Init(1, .5); return d }},
+		{"weibull", func() dist.Distribution { d := &dist.Weibull{}; d.Init(1.5, 2, 1); return d }},
+		{"pareto", func() dist.Distribution { d := &dist.Pareto{}; d.Init(1, 5); return d }},
+		{"hypergeometric", func() dist.Distribution { d := &dist.Hypergeometric{}; d.Init(50, 20, 10); return d }},
+		{"discreteuniform", func() dist.Distribution { d := &dist.DiscreteUniform{}; d.Init(-3, 7); return d }},
+		{"categorical", func() dist.Distribution { d := &dist.Categorical{}; d.Init([]float64{1, 5, 0, 2, 2}); return d }},
+		{"lomax", func() dist.Distribution { d := &dist.Lomax{}; d.Init(5, 2); return d }},
+		{"betabinomial", func() dist.Distribution { d := &dist.BetaBinomial{}; d.Init(30, 2.5, 4); return d }},
+		{"locationscalet", func() dist.Distribution { d := &dist.LocationScaleT{}; d.Init(6, 2, 3); return d }},
+		{"cauchy", func() dist.Distribution { d := &dist.Cauchy{}; d.Init(1, 2); return d }},
+		{"laplace", func() dist.Distribution { d := &dist.Laplace{}; d.Init(1, 2); return d }},
+		{"logistic", func() dist.Distribution { d := &dist.Logistic{}; d.Init(1, 2); return d }},
+		{"gumbel", func() dist.Distribution { d := &dist.Gumbel{}; d.Init(1, 2); return d }},
+		{"gumbelmin", func() dist.Distribution { d := &dist.GumbelMin{}; d.Init(1, 2); return d }},
+		{"noncentralt", func() dist.Distribution { d := &dist.NoncentralT{}; d.Init(5, 1.5); return d }},
+		{"mixture", func() dist.Distribution {
+			d := &dist.Mixture{}
+			d.Init([]dist.Distribution{&dist.Normal{Mu: 0, Sigma: 1}, &dist.Gamma{Alpha: 2.5, Beta: 1}}, []float64{1, 2})
+			return d
+		}},
+		{"discrete mixture", func() dist.Distribution {
+			d := &dist.Mixture{}
+			d.Init([]dist.Distribution{&dist.Poisson{Lambda: 2}, &dist.Poisson{Lambda: 30}}, []float64{1, 1})
+			return d
+		}},
+		{"truncated", func() dist.Distribution {
+			d := &dist.Truncated{}
+			d.Init(&dist.Normal{Mu: 0, Sigma: 1}, 1, 3)
+			return d
+		}},
+		{"truncated discrete", func() dist.Distribution { d := &dist.Truncated{}; d.Init(&dist.Poisson{Lambda: 10}, 5, 15); return d }},
+		{"affine", func() dist.Distribution { d := &dist.Affine{}; d.Init(&dist.Gamma{Alpha: 3, Beta: 2}, -2, 1); return d }},
+		{"affine discrete", func() dist.Distribution { d := &dist.Affine{}; d.Init(&dist.Poisson{Lambda: 6}, -1, 2); return d }},
+		{"affine discrete scaled", func() dist.Distribution { d := &dist.Affine{}; d.Init(&dist.Poisson{Lambda: 6}, 3, -2); return d }},
+		{"truncated affine discrete", func() dist.Distribution {
+			a := &dist.Affine{}
+			a.Init(&dist.Poisson{Lambda: 6}, 3, -2)
+			d := &dist.Truncated{}
+			d.Init(a, 1, 20)
+			return d
+		}},
+		{"convolution", func() dist.Distribution {
+			d, _ := dist.Convolve(&dist.Binomial{N: 10, P: .3, Q: .7}, &dist.Poisson{Lambda: 4})
+			return d
+		}},
+		{"maximum", func() dist.Distribution { d := &dist.Maximum{}; d.Init(&dist.Normal{Mu: 0, Sigma: 1}, 5); return d }},
+		{"minimum discrete", func() dist.Distribution { d := &dist.Minimum{}; d.Init(&dist.Poisson{Lambda: 8}, 3); return d }},
+		{"censored", func() dist.Distribution {
+			d := &dist.Censored{}
+			d.Init(&dist.Gamma{Alpha: 2, Beta: 1}, .5, 3)
+			return d
+		}},
+		{"empirical", func() dist.Distribution {
+			d := &dist.Empirical{}
+			d.Init(observations(&dist.Poisson{Lambda: 4}, 200, 1))
+			return d
+		}},
+		{"kde", func() dist.Distribution {
+			d := &dist.KDE{}
+			d.Init(observations(&dist.Normal{Mu: 0, Sigma: 1}, 100, 1), dist.EpanechnikovKernel, dist.SilvermanBandwidth)
+			return d
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			Run(t, tc.New(), DefaultOptions)
+		})
+	}
+}
+
+// brokenQuantile reports the quantiles of a distribution shifted by one
+type brokenQuantile struct {
+	*dist.Normal
+}
+
+func (b brokenQuantile) Quantile(p float64) float64 {
+	return b.Normal.Quantile(p) + 1
+}
+
+// brokenSampler draws samples with a larger variance than its Var
+type brokenSampler struct {
+	*dist.Normal
+}
+
+func (b brokenSampler) Rand(r *rand.Rand) float64 {
+	return 1.2 * b.Normal.Rand(r)
+}
+
+// unnormalised has a density twice too large
+type unnormalised struct {
+	*dist.Exponential
+}
+
+func (u unnormalised) PDF(x float64) float64 {
+	return 2 * u.Exponential.PDF(x)
+}
+
+func TestDetection(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		Dist  dist.Distribution
+		Check func(dist.Distribution, Options) error
+	}{
+		{"quantile", brokenQuantile{&dist.Normal{Mu: 0, Sigma: 1}}, Quantile},
+		{"moments", brokenSampler{&dist.Normal{Mu: 0, Sigma: 1}}, Moments},
+		{"goodness of fit", brokenSampler{&dist.Normal{Mu: 0, Sigma: 1}}, GoodnessOfFit},
+		{"normalised", unnormalised{&dist.Exponential{Lambda: 1}}, Normalised},
+		{"cdf", unnormalised{&dist.Exponential{Lambda: 1}}, CDF},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if err := tc.Check(tc.Dist, DefaultOptions); err == nil {
+				t.Errorf("the check passed on a broken distribution")
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package matrix
 
 import (
+	"math"
 	"sync"
 )
 
@@ -272,3 +273,35 @@ func ParallelRowMult(a, b *Matrixf64) *Matrixf64 {
 	wg.Wait()
 	return c
 }
+
+// Cholesky returns the lower triangular matrix L such that a = L L^T,
+// or nil if a is not a square positive definite matrix. Only the lower
+// triangle of a is read.
+// Algorithm: Cholesky–Banachiewicz, computing L row by row
+// Complexity: O(n^3)
+//
+func Cholesky(a *Matrixf64) *Matrixf64 {
+	n := a.Height()
+	if n != a.Width() {
+		return nil
+	}
+	l := &Matrixf64{}
+	l.Init(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i; j++ {
+			sum := *a.At(i, j)
+			for k := 0; k < j; k++ {
+				sum -= l.Data[i][k] * l.Data[j][k]
+			}
+			if i == j {
+				if !(sum > 0) {
+					return nil
+				}
+				l.Data[i][i] = math.Sqrt(sum)
+			} else {
+				l.Data[i][j] = sum / l.Data[j][j]
+			}
+		}
+	}
+	return l
+}
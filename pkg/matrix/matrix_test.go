@@ -3,10 +3,9 @@ package matrix
 import (
 	"fmt"
 	"math"
+	"math/rand"
 	"syscall"
 	"testing"
-
-	"github.com/ichbinfrog/statistics/pkg/dist"
 )
 
 func init() {
@@ -20,15 +19,12 @@ func init() {
 func BenchmarkMatrix(t *testing.B) {
 	var data [][]float64
 	for i := 1; i < 5; i++ {
-		d := dist.Normal{}
-		d.Init(0, 1)
-
 		n := int(math.Pow10(i))
 		data = make([][]float64, n)
 		for j := 0; j < n; j++ {
 			data[j] = make([]float64, n)
 			for k := 0; k < n; k++ {
-				data[j][k] = d.Generate()
+				data[j][k] = rand.NormFloat64()
 			}
 		}
 		A := &Matrixf64{
@@ -53,3 +49,45 @@ func BenchmarkMatrix(t *testing.B) {
 	}
 
 }
+
+func TestCholesky(t *testing.T) {
+	a := &Matrixf64{}
+	a.Init(3, 3)
+	a.Data = [][]float64{{4, 12, -16}, {12, 37, -43}, {-16, -43, 98}}
+	expected := [][]float64{{2, 0, 0}, {6, 1, 0}, {-8, 5, 3}}
+
+	l := Cholesky(a)
+	if l == nil {
+		t.Fatal("expected a factorisation of a positive definite matrix")
+	}
+	for i := range expected {
+		for j := range expected[i] {
+			if math.Abs(l.Data[i][j]-expected[i][j]) > 1e-12 {
+				t.Errorf("L[%d][%d] = %f, expected %f", i, j, l.Data[i][j], expected[i][j])
+			}
+		}
+	}
+
+	lt := Cholesky(a)
+	lt.T()
+	prod := NaiveMult(l, lt)
+	for i := range a.Data {
+		for j := range a.Data[i] {
+			if math.Abs(prod.Data[i][j]-a.Data[i][j]) > 1e-12 {
+				t.Errorf("LL^T[%d][%d] = %f, expected %f", i, j, prod.Data[i][j], a.Data[i][j])
+			}
+		}
+	}
+
+	singular := &Matrixf64{}
+	singular.Init(2, 2)
+	singular.Data = [][]float64{{1, 2}, {2, 4}}
+	if Cholesky(singular) != nil {
+		t.Error("expected no factorisation of a singular matrix")
+	}
+	rect := &Matrixf64{}
+	rect.Init(2, 3)
+	if Cholesky(rect) != nil {
+		t.Error("expected no factorisation of a non square matrix")
+	}
+}
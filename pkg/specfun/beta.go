@@ -0,0 +1,105 @@
+package specfun
+
+import (
+	"math"
+	"math/big"
+
+	"gonum.org/v1/gonum/mathext"
+)
+
+// LogBeta returns the log of the beta function
+//		B(a, b) = Γ(a)Γ(b) / Γ(a + b)
+//
+// Algorithm: with p = min(a, b), q = max(a, b) and c the remainder of
+// Stirling's series, the terms of the log gamma functions that cancel
+// are simplified analytically
+//		p >= 10 : log(2π)/2 - log(q)/2 + c(p) + c(q) - c(p + q)
+//			+ (p - 1/2)log(p / (p + q)) + q log1p(-p / (p + q))
+//		q >= 10 : log Γ(p) + c(q) - c(p + q) + p - p log(p + q)
+//			+ (q - 1/2)log1p(-p / (p + q))
+//		otherwise : log Γ(p) + log Γ(q) - log Γ(p + q)
+//
+// DIDONATO, Armido R., MORRIS, Alfred H. Algorithm 708: significant digit computation of the incomplete beta function ratios. 1992.
+func LogBeta(a, b float64) float64 {
+	p, q := math.Min(a, b), math.Max(a, b)
+	if !(p > 0) {
+		if p == 0 {
+			return math.Inf(1)
+		}
+		return math.NaN()
+	}
+	if math.IsInf(q, 1) {
+		return math.Inf(-1)
+	}
+	r := p / (p + q)
+	switch {
+	case p >= 10:
+		corr := stirlingCorrection(p) + stirlingCorrection(q) - stirlingCorrection(p+q)
+		return math.Log(2*math.Pi)/2 - math.Log(q)/2 + corr + (p-.5)*math.Log(r) + q*math.Log1p(-r)
+	case q >= 10:
+		corr := stirlingCorrection(q) - stirlingCorrection(p+q)
+		return LogGamma(p) + corr + p - p*math.Log(p+q) + (q-.5)*math.Log1p(-r)
+	}
+	return LogGamma(p) + LogGamma(q) - LogGamma(p+q)
+}
+
+// LogChoose returns the log of the binomial coefficient C(n, k), extended
+// to real n and k through the gamma function, or -Inf when k < 0 or
+// n - k <= -1, where the coefficient of integers vanishes
+// Algorithm:
+//		log C(n, k) = -log(n + 1) - log B(n - k + 1, k + 1)
+//
+// which, unlike the sum of log factorials, does not cancel when n is large
+// in front of k.
+func LogChoose(n, k float64) float64 {
+	if k < 0 || n-k <= -1 {
+		return math.Inf(-1)
+	}
+	if k == 0 || k == n {
+		return 0
+	}
+	if k == 1 || k == n-1 {
+		return math.Log(n)
+	}
+	return -math.Log1p(n) - LogBeta(n-k+1, k+1)
+}
+
+// Choose returns the exact value of the binomial coefficient C(n, k), which
+// is 0 when k is not in [0, n], or nil when n < 0
+// Complexity: O(min(k, n - k)) multiplications
+//
+func Choose(n, k int) *big.Int {
+	if n < 0 {
+		return nil
+	}
+	if k < 0 || k > n {
+		return new(big.Int)
+	}
+	return new(big.Int).Binomial(int64(n), int64(k))
+}
+
+// RegIncBeta returns the regularized incomplete beta function
+//		I_x(a, b) = 1/B(a, b) ∫(0, x) t^(a-1) (1 - t)^(b-1) dt
+//
+func RegIncBeta(a, b, x float64) float64 {
+	return mathext.RegIncBeta(a, b, x)
+}
+
+// InvRegIncBeta returns x such that I_x(a, b) = p
+func InvRegIncBeta(a, b, p float64) float64 {
+	return mathext.InvRegIncBeta(a, b, p)
+}
+
+// LogRegIncBeta returns the log of the regularized incomplete beta
+// function I_x(a, b). When the value underflows, the log of its
+// leading term x^a (1 - x)^b / (a B(a, b)) is returned instead, which
+// is accurate as x goes to 0.
+func LogRegIncBeta(a, b, x float64) float64 {
+	if v := RegIncBeta(a, b, x); v > 0 {
+		return math.Log(v)
+	}
+	if x <= 0 {
+		return math.Inf(-1)
+	}
+	return a*math.Log(x) + b*math.Log1p(-x) - math.Log(a) - LogBeta(a, b)
+}
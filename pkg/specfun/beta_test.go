@@ -0,0 +1,101 @@
+package specfun
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLogChoose(t *testing.T) {
+	testCases := []struct {
+		N, K     float64
+		Expected float64
+	}{
+		{10, 0, 0},
+		{10, 1, math.Log(10)},
+		{10, 3, math.Log(120)},
+		{10, 10, 0},
+		{52, 5, math.Log(2598960)},
+		// C(1e15, 2) where the sum of log factorials cancels
+		{1e15, 2, math.Log(1e15) + math.Log(1e15-1) - math.Ln2},
+		// Generalised coefficient C(k + r - 1, k) of the negative binomial
+		{2.5, 2, math.Log(2.5 * 1.5 / 2)},
+		{10, 11, math.Inf(-1)},
+		{10, -1, math.Inf(-1)},
+	}
+	for _, tc := range testCases {
+		v := LogChoose(tc.N, tc.K)
+		if math.IsInf(tc.Expected, -1) && !math.IsInf(v, -1) || math.Abs(v-tc.Expected) > 1e-12*math.Max(1, tc.Expected) {
+			t.Errorf("log C(%g, %g) = %.15g, expected %.15g", tc.N, tc.K, v, tc.Expected)
+		}
+	}
+}
+
+func TestChoose(t *testing.T) {
+	testCases := []struct {
+		N, K     int
+		Expected string
+	}{
+		{10, 1, "10"},
+		{10, 0, "1"},
+		{10, 10, "1"},
+		{52, 5, "2598960"},
+		{100, 50, "100891344545564193334812497256"},
+		{5, 7, "0"},
+	}
+	for _, tc := range testCases {
+		if v := Choose(tc.N, tc.K); v.String() != tc.Expected {
+			t.Errorf("C(%d, %d) = %s, expected %s", tc.N, tc.K, v, tc.Expected)
+		}
+	}
+	if v := Choose(-1, 0); v != nil {
+		t.Errorf("C(-1, 0) = %s, expected nil", v)
+	}
+}
+
+func TestLogBeta(t *testing.T) {
+	testCases := []struct {
+		A, B     float64
+		Expected float64
+	}{
+		{2, 3, math.Log(1.0 / 12)},
+		{.5, .5, math.Log(math.Pi)},
+		// B(n, 3) = 2 / (n(n + 1)(n + 2))
+		{1e15, 3, math.Ln2 - math.Log(1e15) - math.Log(1e15+1) - math.Log(1e15+2)},
+		{12, 3, math.Log(2.0 / (12 * 13 * 14))},
+		// B(a, a) for large a from the central binomial coefficient
+		{30, 30, LogGamma(30)*2 - LogGamma(60)},
+	}
+	for _, tc := range testCases {
+		if v := LogBeta(tc.A, tc.B); math.Abs(v-tc.Expected) > 1e-13*math.Max(1, math.Abs(tc.Expected)) {
+			t.Errorf("log B(%g, %g) = %.15g, expected %.15g", tc.A, tc.B, v, tc.Expected)
+		}
+	}
+}
+
+func TestRegIncBeta(t *testing.T) {
+
+	testCases := []struct {
+		A, B, X float64
+	}{
+		{.5, .5, .2}, {2, 3, .4}, {10, 2, .9}, {50, 70, .4},
+	}
+	for _, tc := range testCases {
+		p := RegIncBeta(tc.A, tc.B, tc.X)
+		if q := RegIncBeta(tc.B, tc.A, 1-tc.X); math.Abs(p+q-1) > 1e-14 {
+			t.Errorf("I_x(%g, %g) + I_1-x(%g, %g) = %.15f", tc.A, tc.B, tc.B, tc.A, p+q)
+		}
+		if v := math.Exp(LogRegIncBeta(tc.A, tc.B, tc.X)); math.Abs(v-p) > 1e-14 {
+			t.Errorf("exp(log I_x(%g, %g)) = %g, expected %g", tc.A, tc.B, v, p)
+		}
+		if x := InvRegIncBeta(tc.A, tc.B, p); math.Abs(x-tc.X) > 1e-10 {
+			t.Errorf("I^-1(%g, %g, %g) = %g, expected %g", tc.A, tc.B, p, x, tc.X)
+		}
+	}
+
+	// I_x(a, b) ~ x^a / (a B(a, b)) once it underflows
+	a, b, x := 30.0, 2.0, 1e-20
+	expected := a*math.Log(x) - math.Log(a) - LogBeta(a, b)
+	if v := LogRegIncBeta(a, b, x); math.Abs(v-expected) > 1e-9*math.Abs(expected) {
+		t.Errorf("log I_x(%g, %g) = %.12g, expected %.12g", a, b, v, expected)
+	}
+}
@@ -0,0 +1,134 @@
+package specfun
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLogFactorial(t *testing.T) {
+	testCases := []struct {
+		N        float64
+		Expected float64
+	}{
+		{0, 0},
+		{1, 0},
+		{5, math.Log(120)},
+		{20, math.Log(2432902008176640000)},
+		// Stirling's series beyond the range of float64 factorials
+		{1e6, 1e6*math.Log(1e6) - 1e6 + math.Log(2*math.Pi*1e6)/2 + 1/12e6},
+		{.5, math.Log(math.Sqrt(math.Pi) / 2)},
+	}
+	for _, tc := range testCases {
+		if v := LogFactorial(tc.N); math.Abs(v-tc.Expected) > 1e-12*math.Max(1, tc.Expected) {
+			t.Errorf("log(%g!) = %.15g, expected %.15g", tc.N, v, tc.Expected)
+		}
+	}
+	if v := LogFactorial(-1); !math.IsNaN(v) {
+		t.Errorf("log(-1!) = %f, expected NaN", v)
+	}
+}
+
+func TestFactorial(t *testing.T) {
+	if v := Factorial(20); v.Int64() != 2432902008176640000 {
+		t.Errorf("20! = %s", v)
+	}
+	// 21! overflows int64
+	if v := Factorial(21); v.String() != "51090942171709440000" {
+		t.Errorf("21! = %s", v)
+	}
+	if v := Factorial(0); v.Int64() != 1 {
+		t.Errorf("0! = %s", v)
+	}
+	if v := Factorial(-1); v != nil {
+		t.Errorf("-1! = %s, expected nil", v)
+	}
+}
+
+func TestPolygamma(t *testing.T) {
+	// ψ(1) = -γ, ψ1(1) = π^2/6, ψ1(1/2) = π^2/2
+	const euler = 0.57721566490153286
+	if v := Digamma(1); math.Abs(v+euler) > 1e-14 {
+		t.Errorf("ψ(1) = %.15f, expected %.15f", v, -euler)
+	}
+	if v := Trigamma(1); math.Abs(v-math.Pi*math.Pi/6) > 1e-14 {
+		t.Errorf("ψ1(1) = %.15f, expected %.15f", v, math.Pi*math.Pi/6)
+	}
+	if v := Trigamma(.5); math.Abs(v-math.Pi*math.Pi/2) > 1e-14 {
+		t.Errorf("ψ1(1/2) = %.15f, expected %.15f", v, math.Pi*math.Pi/2)
+	}
+	// ψ1(x) = ψ1(x + 1) + 1/x^2 across the switch to the asymptotic series
+	for _, x := range []float64{-2.5, .1, 9.5, 25} {
+		if d := Trigamma(x) - Trigamma(x+1) - 1/(x*x); math.Abs(d) > 1e-12*Trigamma(x) {
+			t.Errorf("recurrence at %g off by %g", x, d)
+		}
+	}
+	if v := Trigamma(-3); !math.IsNaN(v) {
+		t.Errorf("ψ1(-3) = %f, expected NaN", v)
+	}
+}
+
+func TestZeta(t *testing.T) {
+	testCases := []struct {
+		S        float64
+		Expected float64
+	}{
+		{2, math.Pi * math.Pi / 6},
+		{4, math.Pow(math.Pi, 4) / 90},
+		{3, 1.2020569031595942},
+		{40, 1 + math.Pow(2, -40)},
+	}
+	for _, tc := range testCases {
+		if v := Zeta(tc.S); math.Abs(v-tc.Expected) > 1e-15*tc.Expected {
+			t.Errorf("ζ(%g) = %.16g, expected %.16g", tc.S, v, tc.Expected)
+		}
+	}
+	// ψ1(1) = ζ(2)
+	if v := Trigamma(1); math.Abs(v-Zeta(2)) > 1e-14 {
+		t.Errorf("ψ1(1) = %.15f, expected ζ(2) = %.15f", v, Zeta(2))
+	}
+	if v := Zeta(1); !math.IsInf(v, 1) {
+		t.Errorf("ζ(1) = %f, expected +Inf", v)
+	}
+	if v := Zeta(.5); !math.IsNaN(v) {
+		t.Errorf("ζ(1/2) = %f, expected NaN", v)
+	}
+}
+
+func TestGammaIncReg(t *testing.T) {
+	testCases := []struct {
+		A, X float64
+	}{
+		{.5, .1}, {2, 1}, {5, 3}, {5, 12}, {30, 10}, {30, 60}, {200, 250},
+	}
+	for _, tc := range testCases {
+		p, q := GammaIncReg(tc.A, tc.X), GammaIncRegComp(tc.A, tc.X)
+		if math.Abs(p+q-1) > 1e-14 {
+			t.Errorf("P(%g, %g) + Q(%g, %g) = %.15f", tc.A, tc.X, tc.A, tc.X, p+q)
+		}
+		if v := math.Exp(LogGammaIncReg(tc.A, tc.X)); math.Abs(v-p) > 1e-12*p {
+			t.Errorf("exp(log P(%g, %g)) = %g, expected %g", tc.A, tc.X, v, p)
+		}
+		if v := math.Exp(LogGammaIncRegComp(tc.A, tc.X)); math.Abs(v-q) > 1e-12*q {
+			t.Errorf("exp(log Q(%g, %g)) = %g, expected %g", tc.A, tc.X, v, q)
+		}
+		if x := GammaIncRegInv(tc.A, p); math.Abs(x-tc.X) > 1e-9*tc.X {
+			t.Errorf("P^-1(%g, %g) = %g, expected %g", tc.A, p, x, tc.X)
+		}
+		if x := GammaIncRegCompInv(tc.A, q); math.Abs(x-tc.X) > 1e-9*tc.X {
+			t.Errorf("Q^-1(%g, %g) = %g, expected %g", tc.A, q, x, tc.X)
+		}
+	}
+
+	// Tails that underflow: Q(a, x) ~ x^(a-1) e^(-x) / Γ(a) as x -> ∞
+	a, x := 3.0, 1000.0
+	expected := (a-1)*math.Log(x) - x - LogGamma(a) + math.Log1p((a-1)/x+(a-1)*(a-2)/(x*x))
+	if v := LogGammaIncRegComp(a, x); math.Abs(v-expected) > 1e-9*math.Abs(expected) {
+		t.Errorf("log Q(%g, %g) = %.12g, expected %.12g", a, x, v, expected)
+	}
+	// P(a, x) ~ x^a / Γ(a + 1) as x -> 0
+	a, x = 4, 1e-90
+	expected = a*math.Log(x) - LogGamma(a+1)
+	if v := LogGammaIncReg(a, x); math.Abs(v-expected) > 1e-9*math.Abs(expected) {
+		t.Errorf("log P(%g, %g) = %.12g, expected %.12g", a, x, v, expected)
+	}
+}
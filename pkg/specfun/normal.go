@@ -0,0 +1,49 @@
+package specfun
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mathext"
+)
+
+// NormalCDF returns Φ(z), the cdf of N(0, 1)
+//		Φ(z) = erfc(-z/√2) / 2
+//
+// which, unlike (1 + erf(z/√2)) / 2, keeps its relative accuracy in the
+// lower tail.
+func NormalCDF(z float64) float64 {
+	return math.Erfc(-z/math.Sqrt2) / 2
+}
+
+// NormalSurvival returns 1 - Φ(z) = erfc(z/√2) / 2
+func NormalSurvival(z float64) float64 {
+	return math.Erfc(z/math.Sqrt2) / 2
+}
+
+// LogNormalCDF returns log(Φ(z)) where Φ is the cdf of N(0, 1).
+// Algorithm:
+//		z > 0		: log1p(-erfc(z/√2)/2)
+//		-37 < z <= 0 : log(erfc(-z/√2)/2)
+//		z <= -37	: asymptotic expansion of Mills ratio
+//			-z^2/2 - log(-z) - log(2π)/2 + log(1 - 1/z^2 + 3/z^4 - 15/z^6)
+//
+func LogNormalCDF(z float64) float64 {
+	if z > 0 {
+		return math.Log1p(-NormalSurvival(z))
+	}
+	if z > -37 {
+		return math.Log(NormalCDF(z))
+	}
+	z2 := 1 / (z * z)
+	return -z*z/2 - math.Log(-z) - math.Log(2*math.Pi)/2 + math.Log1p(-z2*(1-z2*(3-15*z2)))
+}
+
+// LogNormalSurvival returns log(1 - Φ(z)) = log(Φ(-z))
+func LogNormalSurvival(z float64) float64 {
+	return LogNormalCDF(-z)
+}
+
+// NormalQuantile returns Φ^(-1)(p), the quantile function of N(0, 1)
+func NormalQuantile(p float64) float64 {
+	return mathext.NormalQuantile(p)
+}
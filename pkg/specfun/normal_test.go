@@ -0,0 +1,46 @@
+package specfun
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNormal(t *testing.T) {
+	testCases := []struct {
+		Z, CDF float64
+	}{
+		{0, .5},
+		{1.959963984540054, .975},
+		{-1.959963984540054, .025},
+		// Relative accuracy of the lower tail, lost by 1 - Φ(-z)
+		{-10, 7.619853024160527e-24},
+	}
+	for _, tc := range testCases {
+		if v := NormalCDF(tc.Z); math.Abs(v-tc.CDF) > 1e-14*tc.CDF {
+			t.Errorf("Φ(%g) = %g, expected %g", tc.Z, v, tc.CDF)
+		}
+		if v := NormalSurvival(-tc.Z); math.Abs(v-tc.CDF) > 1e-14*tc.CDF {
+			t.Errorf("1 - Φ(%g) = %g, expected %g", -tc.Z, v, tc.CDF)
+		}
+		if v := LogNormalCDF(tc.Z); math.Abs(v-math.Log(tc.CDF)) > 1e-13*math.Abs(math.Log(tc.CDF)) {
+			t.Errorf("log Φ(%g) = %g, expected %g", tc.Z, v, math.Log(tc.CDF))
+		}
+		if v := LogNormalSurvival(-tc.Z); math.Abs(v-math.Log(tc.CDF)) > 1e-13*math.Abs(math.Log(tc.CDF)) {
+			t.Errorf("log(1 - Φ(%g)) = %g, expected %g", -tc.Z, v, math.Log(tc.CDF))
+		}
+		if tc.CDF > 1e-20 {
+			if z := NormalQuantile(tc.CDF); math.Abs(z-tc.Z) > 1e-12 {
+				t.Errorf("Φ^-1(%g) = %.15f, expected %.15f", tc.CDF, z, tc.Z)
+			}
+		}
+	}
+
+	// Past the underflow of Φ, the asymptotic expansion continues the erfc branch
+	lo, hi := LogNormalCDF(-37-1e-9), LogNormalCDF(-37+1e-9)
+	if math.IsInf(lo, 0) || math.Abs(lo-hi) > 1e-6 {
+		t.Errorf("log Φ discontinuous at -37: %.12g, %.12g", lo, hi)
+	}
+	if v := LogNormalCDF(-1e3); math.Abs(v+5e5+math.Log(1e3)+math.Log(2*math.Pi)/2+1e-6) > 1e-9 {
+		t.Errorf("log Φ(-1000) = %.12g", v)
+	}
+}
@@ -0,0 +1,14 @@
+// Package specfun implements the special functions used by the
+// distributions of the dist package: log-space combinatorics, the gamma and
+// beta families with their incomplete and inverse forms, and the tails of
+// the standard normal distribution. The functions remain accurate where
+// their naive counterparts overflow, underflow or cancel, which is where
+// the tails of the distributions are evaluated.
+package specfun
+
+const (
+	epsilon = 2.220446049250313e-16
+	maxIter = 1000
+	// tiny replaces the zero denominators of Lentz's method
+	tiny = 1e-300
+)
@@ -22,8 +22,8 @@ var (
 	// ErrGeometricParam is returned when the probability parameter is not within the [0, 1] range for the Geometric distribution to be initialized
 	ErrGeometricParam = errors.New("Invalid parameters, p ∊ [0, 1]")
 
-	// ErrPolyaParam is returned when the probability parameter is not within the [0, 1] range and r is not greater than 0 for the Polya (negative binomial) distribution to be initialized
-	ErrPolyaParam = errors.New("Invalid parameters, p ∊ [0, 1], r > 0")
+	// ErrPolyaParam is returned when the probability parameter is not within the [0, 1) range and r is not greater than 0 for the Polya (negative binomial) distribution to be initialized
+	ErrPolyaParam = errors.New("Invalid parameters, p ∊ [0, 1), r > 0")
 
 	// ErrTriangularParam is returned when the three points do not follow the b >= c >= a (with b != a) for the Triangular distribution to be initialized
 	ErrTriangularParam = errors.New("Invalid parameters, b >= c >= a, b > a")
@@ -31,6 +31,114 @@ var (
 	// ErrUniformParam is returned when the upper limit is not strictly higher than the lower for the Uniform distribution to be initialized
 	ErrUniformParam = errors.New("Invalid parameters, b > a")
 
+	// ErrBetaBinomialParam is returned when the number of trials is not a non negative integer or the α and β parameter are not greater than 0 for the Beta-Binomial distribution to be initialized
+	ErrBetaBinomialParam = errors.New("Invalid parameters, n ∊ {0, 1, ...}, α > 0, β > 0")
+
 	// ErrNormalParam is returned when the variance is not greater than 0 for the Normal distribution to be initialized
 	ErrNormalParam = errors.New("Invalid parameters, σ^2 > 0")
+
+	// ErrChisqParam is returned when the degrees of freedom are not greater than 0 for the Chi squared distribution to be initialized
+	ErrChisqParam = errors.New("Invalid parameters, k > 0")
+
+	// ErrNormalInverseGammaParam is returned when the pseudo counts λ, α and the scale β are not greater than 0 for the Normal-inverse-Gamma prior to be initialized
+	ErrNormalInverseGammaParam = errors.New("Invalid parameters, λ > 0, α > 0, β > 0")
+
+	// ErrStudentTParam is returned when the degrees of freedom are not greater than 0 for the Student's t distribution to be initialized
+	ErrStudentTParam = errors.New("Invalid parameters, ν > 0")
+
+	// ErrLocationScaleTParam is returned when the degrees of freedom or the scale are not greater than 0 for the location-scale Student's t distribution to be initialized
+	ErrLocationScaleTParam = errors.New("Invalid parameters, ν > 0, σ > 0")
+
+	// ErrFisherFParam is returned when the degrees of freedom are not greater than 0 for the Fisher F distribution to be initialized
+	ErrFisherFParam = errors.New("Invalid parameters, d1 > 0, d2 > 0")
+
+	// ErrBetaParam is returned when the α and β parameter are not greater than 0 for the Beta distribution to be initialized
+	ErrBetaParam = errors.New("Invalid parameters, α > 0, β > 0")
+
+	// ErrLogNormalParam is returned when σ is not greater than 0 for the LogNormal distribution to be initialized
+	ErrLogNormalParam = errors.New("Invalid parameters, σ > 0")
+
+	// ErrWeibullParam is returned when the shape k and the scale λ are not greater than 0 for the Weibull distribution to be initialized
+	ErrWeibullParam = errors.New("Invalid parameters, k > 0, λ > 0")
+
+	// ErrParetoParam is returned when the scale xm and the shape α are not greater than 0 for the Pareto distribution to be initialized
+	ErrParetoParam = errors.New("Invalid parameters, xm > 0, α > 0")
+
+	// ErrLomaxParam is returned when the shape α and the scale λ are not greater than 0 for the Lomax distribution to be initialized
+	ErrLomaxParam = errors.New("Invalid parameters, α > 0, λ > 0")
+
+	// ErrCauchyParam is returned when the scale is not greater than 0 for the Cauchy distribution to be initialized
+	ErrCauchyParam = errors.New("Invalid parameters, σ > 0")
+
+	// ErrLaplaceParam is returned when the scale is not greater than 0 for the Laplace distribution to be initialized
+	ErrLaplaceParam = errors.New("Invalid parameters, σ > 0")
+
+	// ErrLogisticParam is returned when the scale is not greater than 0 for the Logistic distribution to be initialized
+	ErrLogisticParam = errors.New("Invalid parameters, σ > 0")
+
+	// ErrGumbelParam is returned when the scale is not greater than 0 for the Gumbel distribution to be initialized
+	ErrGumbelParam = errors.New("Invalid parameters, σ > 0")
+
+	// ErrNoncentralTParam is returned when the degrees of freedom are not greater than 0 for the noncentral Student's t distribution to be initialized
+	ErrNoncentralTParam = errors.New("Invalid parameters, ν > 0")
+
+	// ErrHypergeometricParam is returned when the population, number of successes and draws are not integers such that 0 <= K <= N, 0 <= n <= N for the Hypergeometric distribution to be initialized
+	ErrHypergeometricParam = errors.New("Invalid parameters, N > 0, K ∊ {0, ..., N}, n ∊ {0, ..., N}")
+
+	// ErrDiscreteUniformParam is returned when the bounds are not integers with b >= a for the discrete Uniform distribution to be initialized
+	ErrDiscreteUniformParam = errors.New("Invalid parameters, a, b integers, b >= a")
+
+	// ErrCategoricalParam is returned when a weight is negative or all weights are null for the Categorical distribution to be initialized
+	ErrCategoricalParam = errors.New("Invalid parameters, w >= 0, Σ w > 0")
+
+	// ErrMultinomialParam is returned when the number of trials is not a non negative integer or the probabilities are not non negative with a positive sum for the Multinomial distribution to be initialized
+	ErrMultinomialParam = errors.New("Invalid parameters, n ∊ {0, 1, ...}, p >= 0, Σ p > 0")
+
+	// ErrDirichletParam is returned when the concentration parameters are not greater than 0 for the Dirichlet distribution to be initialized
+	ErrDirichletParam = errors.New("Invalid parameters, k >= 2, α > 0")
+
+	// ErrMultivariateNormalParam is returned when the covariance matrix is not a symmetric positive definite matrix of the dimension of the mean for the multivariate Normal distribution to be initialized
+	ErrMultivariateNormalParam = errors.New("Invalid parameters, Σ symmetric positive definite, dim(Σ) = dim(μ)")
+
+	// ErrMixtureParam is returned when the components and weights do not match or the weights are not non negative with a positive sum for the Mixture distribution to be initialized
+	ErrMixtureParam = errors.New("Invalid parameters, k >= 1, w >= 0, Σ w > 0")
+
+	// ErrTruncatedParam is returned when the bounds are not ordered or hold no probability mass for the Truncated distribution to be initialized
+	ErrTruncatedParam = errors.New("Invalid parameters, lower <= upper, P(lower <= X <= upper) > 0")
+
+	// ErrAffineParam is returned when the scale is null, the parameters are not finite, or not integers for a discrete distribution, for the Affine distribution to be initialized
+	ErrAffineParam = errors.New("Invalid parameters, a != 0, a and b finite, integers for discrete distributions")
+
+	// ErrExtremeParam is returned when the number of variables is not a positive integer for the Maximum and Minimum distributions to be initialized
+	ErrExtremeParam = errors.New("Invalid parameters, n ∊ {1, 2, ...}")
+
+	// ErrSumParam is returned when the sum of two distributions has no closed form and they are not both integer valued discrete distributions
+	ErrSumParam = errors.New("Invalid parameters, the sum has no closed form and X, Y are not integer valued")
+
+	// ErrCensoredParam is returned when the lower bound is not strictly lower than the upper one for the Censored distribution to be initialized
+	ErrCensoredParam = errors.New("Invalid parameters, lower < upper")
+
+	// ErrKDEParam is returned when the kernel or the bandwidth rule is missing, or the bandwidth is not greater than 0 for the kernel density estimation to be initialized
+	ErrKDEParam = errors.New("Invalid parameters, h > 0")
+
+	// ErrDimension is returned when vectors or indices do not match the dimension of a multivariate distribution
+	ErrDimension = errors.New("Invalid dimensions")
+
+	// ErrEmptyArray is returned when a distribution is fitted on an array holding too few observations
+	ErrEmptyArray = errors.New("Invalid data, not enough observations")
+
+	// ErrFitSupport is returned when a distribution is fitted on observations lying outside of its domain
+	ErrFitSupport = errors.New("Invalid data, observations outside of the distribution domain")
+
+	// ErrFitConvergence is returned when the numerical solver of a maximum likelihood estimation does not converge
+	ErrFitConvergence = errors.New("Maximum likelihood estimation did not converge")
+
+	// ErrSpecSyntax is returned when a distribution specification is neither of the form family(name=value, ...) nor a JSON object
+	ErrSpecSyntax = errors.New("Invalid specification, expected family(name=value, ...) or {\"family\": ...}")
+
+	// ErrSpecFamily is returned when a distribution specification names a family missing from the registry
+	ErrSpecFamily = errors.New("Invalid specification, unknown family")
+
+	// ErrSpecParam is returned when a distribution specification holds an unknown, duplicated, missing or non finite parameter
+	ErrSpecParam = errors.New("Invalid specification, unknown, duplicated, missing or non finite parameter")
 )